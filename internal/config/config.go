@@ -58,13 +58,20 @@ type Timings struct {
 	ShowVotingScreenFor     time.Duration
 	AllReadyToNextScreenFor time.Duration
 	ShowRevealScreenFor     time.Duration
+	ShowRevoteScreenFor     time.Duration
 	ShowScoreScreenFor      time.Duration
 	ShowWinnerScreenFor     time.Duration
+	// DisconnectGracePeriod is how long a player can be disconnected mid-game before they are marked as inactive.
+	DisconnectGracePeriod time.Duration
 }
 
 type Scoring struct {
 	GuessFibber        int
 	FibberEvadeCapture int
+	SpeedBonus         int
+	StreakBonus        int
+	CatchUpPercent     int
+	DodgedVote         int
 }
 
 type In struct {
@@ -95,11 +102,17 @@ type In struct {
 	ShowVotingScreenFor     time.Duration `env:"SHOW_VOTING_SCREEN_FOR, default=60s"`
 	AllReadyToNextScreenFor time.Duration `env:"ALL_READY_TO_NEXT_SCREEN_FOR, default=2s"`
 	ShowRevealScreenFor     time.Duration `env:"SHOW_REVEAL_SCREEN_FOR, default=15s"`
+	ShowRevoteScreenFor     time.Duration `env:"SHOW_REVOTE_SCREEN_FOR, default=20s"`
 	ShowScoreScreenFor      time.Duration `env:"SHOW_SCORE_SCREEN_FOR, default=15s"`
 	ShowWinnerScoreFor      time.Duration `env:"SHOW_SCORE_SCREEN_FOR, default=15s"`
+	DisconnectGracePeriod   time.Duration `env:"DISCONNECT_GRACE_PERIOD, default=30s"`
 
 	GuessFibber        int `env:"GUESS_FIBBER, default=100"`
 	FibberEvadeCapture int `env:"FIBBER_EVADE_CAPTURE, default=150"`
+	SpeedBonus         int `env:"SPEED_BONUS, default=50"`
+	StreakBonus        int `env:"STREAK_BONUS, default=25"`
+	CatchUpPercent     int `env:"CATCH_UP_PERCENT, default=10"`
+	DodgedVote         int `env:"DODGED_VOTE, default=25"`
 }
 
 func LoadConfig(ctx context.Context) (Config, error) {
@@ -153,12 +166,18 @@ func LoadConfig(ctx context.Context) (Config, error) {
 			ShowVotingScreenFor:     input.ShowVotingScreenFor,
 			AllReadyToNextScreenFor: input.AllReadyToNextScreenFor,
 			ShowRevealScreenFor:     input.ShowRevealScreenFor,
+			ShowRevoteScreenFor:     input.ShowRevoteScreenFor,
 			ShowScoreScreenFor:      input.ShowScoreScreenFor,
 			ShowWinnerScreenFor:     input.ShowWinnerScoreFor,
+			DisconnectGracePeriod:   input.DisconnectGracePeriod,
 		},
 		Scoring: Scoring{
 			GuessFibber:        input.GuessFibber,
 			FibberEvadeCapture: input.FibberEvadeCapture,
+			SpeedBonus:         input.SpeedBonus,
+			StreakBonus:        input.StreakBonus,
+			CatchUpPercent:     input.CatchUpPercent,
+			DodgedVote:         input.DodgedVote,
 		},
 	}
 
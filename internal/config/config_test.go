@@ -26,7 +26,8 @@ func TestLoadConfig(t *testing.T) {
 			"BANTERBUS_AUTO_RECONNECT", "BANTERBUS_DISABLE_TELEMETRY",
 			"BANTERBUS_JWKS_URL", "BANTERBUS_JWT_ADMIN_GROUP", "SHOW_QUESTION_SCREEN_FOR",
 			"SHOW_VOTING_SCREEN_FOR", "ALL_READY_TO_NEXT_SCREEN_FOR", "SHOW_REVEAL_SCREEN_FOR",
-			"SHOW_SCORE_SCREEN_FOR", "GUESS_FIBBER", "FIBBER_EVADE_CAPTURE",
+			"SHOW_REVOTE_SCREEN_FOR", "SHOW_SCORE_SCREEN_FOR", "DISCONNECT_GRACE_PERIOD", "GUESS_FIBBER",
+			"FIBBER_EVADE_CAPTURE", "SPEED_BONUS", "STREAK_BONUS", "CATCH_UP_PERCENT", "DODGED_VOTE",
 		}
 
 		originalValues := make(map[string]string)
@@ -75,12 +76,18 @@ func TestLoadConfig(t *testing.T) {
 				ShowVotingScreenFor:     time.Second * 60,
 				AllReadyToNextScreenFor: time.Second * 2,
 				ShowRevealScreenFor:     time.Second * 15,
+				ShowRevoteScreenFor:     time.Second * 20,
 				ShowScoreScreenFor:      time.Second * 15,
 				ShowWinnerScreenFor:     time.Second * 15,
+				DisconnectGracePeriod:   time.Second * 30,
 			},
 			Scoring: config.Scoring{
 				GuessFibber:        100,
 				FibberEvadeCapture: 150,
+				SpeedBonus:         50,
+				StreakBonus:        25,
+				CatchUpPercent:     10,
+				DodgedVote:         25,
 			},
 		}
 
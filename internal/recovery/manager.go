@@ -31,7 +31,7 @@ type RecoveryStore interface {
 
 type StateTransitioner interface {
 	StartStateMachine(ctx context.Context, gameStateID uuid.UUID, state statemachine.State)
-	NewStateDependencies() (*statemachine.StateDependencies, error)
+	NewStateDependencies(ctx context.Context, gameStateID uuid.UUID) (*statemachine.StateDependencies, error)
 }
 
 type MessagePublisher interface {
@@ -194,7 +194,7 @@ func (m *Manager) recoverGame(ctx context.Context, game db.GetActiveGamesRow) er
 	now := time.Now()
 	timeRemaining := deadline.Sub(now)
 
-	deps, err := m.transitioner.NewStateDependencies()
+	deps, err := m.transitioner.NewStateDependencies(ctx, game.GameStateID)
 	if err != nil {
 		return fmt.Errorf("failed to create state dependencies: %w", err)
 	}
@@ -365,12 +365,14 @@ func (m *Manager) transitionAfterScoring(
 		return err
 	}
 
-	shouldEndGame := scoreState.TotalRounds >= 3 || scoreState.RoundType == service.RoundTypeMostLikely
+	shouldEndGame := scoreState.IsLastRoundType
 
 	m.logger.InfoContext(ctx, "scoring recovery transition decision",
 		slog.Int("round_number", scoreState.RoundNumber),
 		slog.Int("total_rounds", scoreState.TotalRounds),
+		slog.Int("max_rounds", scoreState.MaxRounds),
 		slog.String("round_type", scoreState.RoundType),
+		slog.Bool("last_round_type", scoreState.IsLastRoundType),
 		slog.Bool("should_end_game", shouldEndGame),
 		slog.String("game_state_id", gameStateID.String()))
 
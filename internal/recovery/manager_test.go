@@ -121,7 +121,7 @@ func TestRecoverActiveGames(t *testing.T) {
 			return games, nil
 		}).Once()
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockStore.EXPECT().GetAllPlayersInRoom(ctx, roomID).Return([]db.GetAllPlayersInRoomRow{}, nil)
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.QuestionState"))
 		mockStore.EXPECT().ReleaseGameLock(ctx, gameStateID.String()).Return(nil)
@@ -252,7 +252,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockStore.EXPECT().GetAllPlayersInRoom(ctx, roomID).Return([]db.GetAllPlayersInRoomRow{}, nil)
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.QuestionState"))
 		mockStore.EXPECT().ReleaseGameLock(ctx, gameStateID.String()).Return(nil)
@@ -304,7 +304,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockRoundService.EXPECT().UpdateStateToVoting(ctx, gameStateID, mock.AnythingOfType("time.Time")).
 			Return(service.VotingState{}, nil)
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.VotingState"))
@@ -357,7 +357,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockStore.EXPECT().GetAllPlayersInRoom(ctx, roomID).Return([]db.GetAllPlayersInRoomRow{}, nil)
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.VotingState"))
 		mockStore.EXPECT().ReleaseGameLock(ctx, gameStateID.String()).Return(nil)
@@ -409,7 +409,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockRoundService.EXPECT().UpdateStateToReveal(ctx, gameStateID, mock.AnythingOfType("time.Time")).
 			Return(service.RevealRoleState{}, nil)
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.RevealState"))
@@ -462,7 +462,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockStore.EXPECT().GetAllPlayersInRoom(ctx, roomID).Return([]db.GetAllPlayersInRoomRow{}, nil)
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.RevealState"))
 		mockStore.EXPECT().ReleaseGameLock(ctx, gameStateID.String()).Return(nil)
@@ -515,7 +515,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockRoundService.EXPECT().UpdateStateToScore(ctx, gameStateID, mock.AnythingOfType("time.Time"), deps.Scoring).
 			Return(service.ScoreState{}, nil)
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.ScoringState"))
@@ -568,7 +568,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockStore.EXPECT().GetAllPlayersInRoom(ctx, roomID).Return([]db.GetAllPlayersInRoomRow{}, nil)
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.ScoringState"))
 		mockStore.EXPECT().ReleaseGameLock(ctx, gameStateID.String()).Return(nil)
@@ -621,12 +621,14 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockRoundService.EXPECT().UpdateStateToScore(ctx, gameStateID, mock.AnythingOfType("time.Time"), deps.Scoring).
 			Return(service.ScoreState{
-				TotalRounds: 3,
-				RoundNumber: 3,
-				RoundType:   "free_form",
+				TotalRounds:     3,
+				RoundNumber:     3,
+				MaxRounds:       3,
+				RoundType:       "most_likely",
+				IsLastRoundType: true,
 			}, nil)
 		mockRoundService.EXPECT().UpdateStateToWinner(ctx, gameStateID, mock.AnythingOfType("time.Time")).
 			Return(service.WinnerState{}, nil)
@@ -681,12 +683,14 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockRoundService.EXPECT().UpdateStateToScore(ctx, gameStateID, mock.AnythingOfType("time.Time"), deps.Scoring).
 			Return(service.ScoreState{
-				TotalRounds: 2,
-				RoundNumber: 2,
-				RoundType:   "free_form",
+				TotalRounds:     3,
+				RoundNumber:     3,
+				MaxRounds:       3,
+				RoundType:       "free_form",
+				IsLastRoundType: false,
 			}, nil)
 		mockRoundService.EXPECT().UpdateStateToQuestion(ctx, gameStateID, mock.AnythingOfType("time.Time"), true).
 			Return(service.QuestionState{}, nil)
@@ -740,7 +744,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockStore.EXPECT().GetAllPlayersInRoom(ctx, roomID).Return([]db.GetAllPlayersInRoomRow{}, nil)
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.WinnerState"))
 		mockStore.EXPECT().ReleaseGameLock(ctx, gameStateID.String()).Return(nil)
@@ -792,7 +796,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockStore.EXPECT().ReleaseGameLock(ctx, gameStateID.String()).Return(nil)
 
 		err := manager.RecoverActiveGames(ctx)
@@ -842,7 +846,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockStore.EXPECT().GetAllPlayersInRoom(ctx, roomID).Return(nil, errors.New("player fetch error"))
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.QuestionState"))
 		mockStore.EXPECT().ReleaseGameLock(ctx, gameStateID.String()).Return(nil)
@@ -901,7 +905,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockStore.EXPECT().GetAllPlayersInRoom(ctx, roomID).Return(players, nil)
 		mockPublisher.EXPECT().Publish(ctx, playerID1, mock.AnythingOfType("[]uint8")).Return(nil)
 		mockPublisher.EXPECT().Publish(ctx, playerID2, mock.AnythingOfType("[]uint8")).Return(nil)
@@ -955,7 +959,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(deps, nil)
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(deps, nil)
 		mockStore.EXPECT().GetAllPlayersInRoom(ctx, roomID).Return([]db.GetAllPlayersInRoomRow{}, nil)
 		mockTransitioner.EXPECT().StartStateMachine(ctx, gameStateID, mock.AnythingOfType("*statemachine.QuestionState"))
 		mockStore.EXPECT().ReleaseGameLock(ctx, gameStateID.String()).Return(errors.New("lock release error"))
@@ -997,7 +1001,7 @@ func TestRecoverActiveGames(t *testing.T) {
 
 		mockStore.EXPECT().GetActiveGames(ctx).Return(games, nil)
 		mockStore.EXPECT().TryAcquireGameLock(ctx, gameStateID.String()).Return(true, nil)
-		mockTransitioner.EXPECT().NewStateDependencies().Return(nil, errors.New("deps error"))
+		mockTransitioner.EXPECT().NewStateDependencies(ctx, gameStateID).Return(nil, errors.New("deps error"))
 		mockStore.EXPECT().ReleaseGameLock(ctx, gameStateID.String()).Return(nil)
 
 		err := manager.RecoverActiveGames(ctx)
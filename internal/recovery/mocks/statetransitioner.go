@@ -40,8 +40,8 @@ func (_m *MockStateTransitioner) EXPECT() *MockStateTransitioner_Expecter {
 }
 
 // NewStateDependencies provides a mock function for the type MockStateTransitioner
-func (_mock *MockStateTransitioner) NewStateDependencies() (*statemachine.StateDependencies, error) {
-	ret := _mock.Called()
+func (_mock *MockStateTransitioner) NewStateDependencies(ctx context.Context, gameStateID uuid.UUID) (*statemachine.StateDependencies, error) {
+	ret := _mock.Called(ctx, gameStateID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewStateDependencies")
@@ -49,18 +49,18 @@ func (_mock *MockStateTransitioner) NewStateDependencies() (*statemachine.StateD
 
 	var r0 *statemachine.StateDependencies
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() (*statemachine.StateDependencies, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*statemachine.StateDependencies, error)); ok {
+		return returnFunc(ctx, gameStateID)
 	}
-	if returnFunc, ok := ret.Get(0).(func() *statemachine.StateDependencies); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *statemachine.StateDependencies); ok {
+		r0 = returnFunc(ctx, gameStateID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*statemachine.StateDependencies)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, gameStateID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,13 +73,26 @@ type MockStateTransitioner_NewStateDependencies_Call struct {
 }
 
 // NewStateDependencies is a helper method to define mock.On call
-func (_e *MockStateTransitioner_Expecter) NewStateDependencies() *MockStateTransitioner_NewStateDependencies_Call {
-	return &MockStateTransitioner_NewStateDependencies_Call{Call: _e.mock.On("NewStateDependencies")}
+//   - ctx context.Context
+//   - gameStateID uuid.UUID
+func (_e *MockStateTransitioner_Expecter) NewStateDependencies(ctx interface{}, gameStateID interface{}) *MockStateTransitioner_NewStateDependencies_Call {
+	return &MockStateTransitioner_NewStateDependencies_Call{Call: _e.mock.On("NewStateDependencies", ctx, gameStateID)}
 }
 
-func (_c *MockStateTransitioner_NewStateDependencies_Call) Run(run func()) *MockStateTransitioner_NewStateDependencies_Call {
+func (_c *MockStateTransitioner_NewStateDependencies_Call) Run(run func(ctx context.Context, gameStateID uuid.UUID)) *MockStateTransitioner_NewStateDependencies_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
@@ -89,7 +102,7 @@ func (_c *MockStateTransitioner_NewStateDependencies_Call) Return(stateDependenc
 	return _c
 }
 
-func (_c *MockStateTransitioner_NewStateDependencies_Call) RunAndReturn(run func() (*statemachine.StateDependencies, error)) *MockStateTransitioner_NewStateDependencies_Call {
+func (_c *MockStateTransitioner_NewStateDependencies_Call) RunAndReturn(run func(ctx context.Context, gameStateID uuid.UUID) (*statemachine.StateDependencies, error)) *MockStateTransitioner_NewStateDependencies_Call {
 	_c.Call.Return(run)
 	return _c
 }
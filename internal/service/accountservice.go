@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+type AccountStore interface {
+	GetAccountBySubject(ctx context.Context, subject string) (db.Account, error)
+	UpsertAccount(ctx context.Context, arg db.UpsertAccountParams) (db.Account, error)
+	UpdateAccountProfile(ctx context.Context, arg db.UpdateAccountProfileParams) (db.Account, error)
+	LinkPlayerToAccount(ctx context.Context, arg db.LinkPlayerToAccountParams) error
+	GetAccountStats(ctx context.Context, accountID uuid.NullUUID) (db.GetAccountStatsRow, error)
+}
+
+var ErrAccountNotFound = errors.New("account not found")
+
+// AccountService manages the optional accounts of signed in players. Accounts are identified by the subject of the
+// player's JWT, and are linked to the players they play as in each game so we can keep stats across games.
+type AccountService struct {
+	store      AccountStore
+	randomizer Randomizer
+}
+
+func NewAccountService(store AccountStore, randomizer Randomizer) *AccountService {
+	return &AccountService{store: store, randomizer: randomizer}
+}
+
+// SignIn gets the account for the subject, creating it the first time the player signs in.
+func (a *AccountService) SignIn(ctx context.Context, subject string) (Account, error) {
+	id, err := a.randomizer.GetID()
+	if err != nil {
+		return Account{}, err
+	}
+
+	account, err := a.store.UpsertAccount(ctx, db.UpsertAccountParams{
+		ID:      id,
+		Subject: subject,
+	})
+	if err != nil {
+		return Account{}, err
+	}
+
+	return newAccount(account), nil
+}
+
+// GetAccountBySubject gets the account for the subject without creating it, so pages that only show the player's
+// saved details don't sign them in.
+func (a *AccountService) GetAccountBySubject(ctx context.Context, subject string) (Account, error) {
+	account, err := a.store.GetAccountBySubject(ctx, subject)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return Account{}, ErrAccountNotFound
+		}
+		return Account{}, err
+	}
+
+	return newAccount(account), nil
+}
+
+// GetAccount gets the account for the subject along with its stats. Like GetAccountBySubject it never creates the
+// account, so it returns ErrAccountNotFound if the player has never signed in.
+func (a *AccountService) GetAccount(ctx context.Context, subject string) (Account, error) {
+	account, err := a.GetAccountBySubject(ctx, subject)
+	if err != nil {
+		return Account{}, err
+	}
+
+	stats, err := a.store.GetAccountStats(ctx, uuid.NullUUID{UUID: account.ID, Valid: true})
+	if err != nil {
+		return Account{}, err
+	}
+
+	account.Stats = AccountStats{
+		GamesPlayed:     int(stats.GamesPlayed),
+		Wins:            int(stats.Wins),
+		FibberRounds:    int(stats.FibberRounds),
+		FibberSuccesses: int(stats.FibberSuccesses),
+	}
+	if stats.FibberRounds > 0 {
+		account.Stats.FibberSuccessRate = float64(stats.FibberSuccesses) / float64(stats.FibberRounds)
+	}
+
+	return account, nil
+}
+
+func (a *AccountService) UpdateProfile(ctx context.Context, subject string, profile AccountProfile) (Account, error) {
+	account, err := a.SignIn(ctx, subject)
+	if err != nil {
+		return Account{}, err
+	}
+
+	updated, err := a.store.UpdateAccountProfile(ctx, db.UpdateAccountProfileParams{
+		Nickname: profile.Nickname,
+		Avatar:   profile.Avatar,
+		Locale:   pgtype.Text{String: profile.Locale, Valid: profile.Locale != ""},
+		ID:       account.ID,
+	})
+	if err != nil {
+		return Account{}, err
+	}
+
+	return newAccount(updated), nil
+}
+
+// LinkPlayer marks the player as being played by the account, which also swaps in the account's saved avatar.
+func (a *AccountService) LinkPlayer(ctx context.Context, accountID uuid.UUID, playerID uuid.UUID) error {
+	return a.store.LinkPlayerToAccount(ctx, db.LinkPlayerToAccountParams{
+		ID:   accountID,
+		ID_2: playerID,
+	})
+}
+
+func newAccount(account db.Account) Account {
+	return Account{
+		ID:       account.ID,
+		Nickname: account.Nickname,
+		Avatar:   account.Avatar,
+		Locale:   account.Locale.String,
+	}
+}
@@ -0,0 +1,187 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	mockService "gitlab.com/hmajid2301/banterbus/internal/service/mocks"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+var (
+	accountID      = uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8f1"))
+	accountSubject = "auth0|123456"
+
+	upsertAccountParams = db.UpsertAccountParams{ID: accountID, Subject: accountSubject}
+)
+
+func TestAccountServiceGetAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully get account with stats", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAccountStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAccountService(mockStore, mockRandom)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetAccountBySubject(ctx, accountSubject).Return(db.Account{
+			ID:       accountID,
+			Subject:  accountSubject,
+			Nickname: "Majiy",
+			Locale:   pgtype.Text{String: "de-DE", Valid: true},
+		}, nil)
+		mockStore.EXPECT().GetAccountStats(ctx, uuid.NullUUID{UUID: accountID, Valid: true}).Return(
+			db.GetAccountStatsRow{GamesPlayed: 4, Wins: 1, FibberRounds: 4, FibberSuccesses: 3},
+			nil,
+		)
+
+		account, err := srv.GetAccount(ctx, accountSubject)
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.Account{
+			ID:       accountID,
+			Nickname: "Majiy",
+			Locale:   "de-DE",
+			Stats: service.AccountStats{
+				GamesPlayed:       4,
+				Wins:              1,
+				FibberRounds:      4,
+				FibberSuccesses:   3,
+				FibberSuccessRate: 0.75,
+			},
+		}, account)
+	})
+
+	t.Run("Should fail to get account, DB fails to get stats", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAccountStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAccountService(mockStore, mockRandom)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetAccountBySubject(ctx, accountSubject).Return(db.Account{
+			ID:      accountID,
+			Subject: accountSubject,
+		}, nil)
+		mockStore.EXPECT().GetAccountStats(ctx, uuid.NullUUID{UUID: accountID, Valid: true}).Return(
+			db.GetAccountStatsRow{},
+			errors.New("failed to get stats"),
+		)
+
+		_, err := srv.GetAccount(ctx, accountSubject)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail to get account without creating it, player has never signed in", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAccountStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAccountService(mockStore, mockRandom)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetAccountBySubject(ctx, accountSubject).Return(db.Account{}, pgx.ErrNoRows)
+
+		_, err := srv.GetAccount(ctx, accountSubject)
+		assert.ErrorIs(t, err, service.ErrAccountNotFound)
+	})
+}
+
+func TestAccountServiceGetAccountBySubject(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully get account without creating it", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAccountStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAccountService(mockStore, mockRandom)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetAccountBySubject(ctx, accountSubject).Return(db.Account{
+			ID:       accountID,
+			Subject:  accountSubject,
+			Nickname: "Majiy",
+		}, nil)
+
+		account, err := srv.GetAccountBySubject(ctx, accountSubject)
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.Account{ID: accountID, Nickname: "Majiy"}, account)
+	})
+
+	t.Run("Should fail to get account, player has never signed in", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAccountStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAccountService(mockStore, mockRandom)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetAccountBySubject(ctx, accountSubject).Return(db.Account{}, pgx.ErrNoRows)
+
+		_, err := srv.GetAccountBySubject(ctx, accountSubject)
+		assert.ErrorIs(t, err, service.ErrAccountNotFound)
+	})
+}
+
+func TestAccountServiceUpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully update profile", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAccountStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAccountService(mockStore, mockRandom)
+
+		ctx := t.Context()
+		mockRandom.EXPECT().GetID().Return(accountID, nil)
+		mockStore.EXPECT().UpsertAccount(ctx, upsertAccountParams).Return(db.Account{
+			ID:      accountID,
+			Subject: accountSubject,
+		}, nil)
+		mockStore.EXPECT().UpdateAccountProfile(ctx, db.UpdateAccountProfileParams{
+			Nickname: "Majiy",
+			Avatar:   "avatar",
+			Locale:   pgtype.Text{},
+			ID:       accountID,
+		}).Return(db.Account{
+			ID:       accountID,
+			Subject:  accountSubject,
+			Nickname: "Majiy",
+			Avatar:   "avatar",
+		}, nil)
+
+		account, err := srv.UpdateProfile(ctx, accountSubject, service.AccountProfile{
+			Nickname: "Majiy",
+			Avatar:   "avatar",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.Account{ID: accountID, Nickname: "Majiy", Avatar: "avatar"}, account)
+	})
+}
+
+func TestAccountServiceLinkPlayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully link player to account", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAccountStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAccountService(mockStore, mockRandom)
+
+		ctx := t.Context()
+		mockStore.EXPECT().LinkPlayerToAccount(ctx, db.LinkPlayerToAccountParams{
+			ID:   accountID,
+			ID_2: playerID,
+		}).Return(nil)
+
+		err := srv.LinkPlayer(ctx, accountID, playerID)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	MinAnswerOptions = 2
+	MaxAnswerOptions = 10
+)
+
+var ErrInvalidAnswerOptions = errors.New("invalid answer options")
+
+// DefaultAnswerOptions is the scale multiple choice questions use when they don't have answer options of their own.
+func DefaultAnswerOptions() []string {
+	return []string{"Strongly Agree", "Agree", "Neutral", "Disagree", "Strongly Disagree"}
+}
+
+// validateQuestionAnswerOptions checks the answer options suit the round type of the question, as well as being valid.
+func validateQuestionAnswerOptions(roundType string, answerOptions []string) error {
+	if len(answerOptions) > 0 && roundType != RoundTypeMultipleChoice && roundType != RoundTypeRanking {
+		return fmt.Errorf(
+			"%w: only multiple choice and ranking questions have answer options",
+			ErrInvalidAnswerOptions,
+		)
+	}
+
+	if len(answerOptions) == 0 && roundType == RoundTypeRanking {
+		return fmt.Errorf("%w: ranking questions need items to rank", ErrInvalidAnswerOptions)
+	}
+
+	return validateAnswerOptions(answerOptions)
+}
+
+func validateAnswerOptions(answerOptions []string) error {
+	if len(answerOptions) == 0 {
+		return nil
+	}
+
+	if len(answerOptions) < MinAnswerOptions || len(answerOptions) > MaxAnswerOptions {
+		return fmt.Errorf(
+			"%w: must have between %d and %d options",
+			ErrInvalidAnswerOptions,
+			MinAnswerOptions,
+			MaxAnswerOptions,
+		)
+	}
+
+	for i, option := range answerOptions {
+		if strings.TrimSpace(option) == "" {
+			return fmt.Errorf("%w: option %d is empty", ErrInvalidAnswerOptions, i+1)
+		}
+		if len(option) > MaxAnswerLength {
+			return fmt.Errorf("%w: option %d is longer than %d characters", ErrInvalidAnswerOptions, i+1, MaxAnswerLength)
+		}
+		if slices.Contains(answerOptions[:i], option) {
+			return fmt.Errorf("%w: option %q is repeated", ErrInvalidAnswerOptions, option)
+		}
+	}
+
+	return nil
+}
+
+// getAnswerOptions stores an empty list rather than NULL, for questions which use the default scale.
+func getAnswerOptions(answerOptions []string) []string {
+	if answerOptions == nil {
+		return []string{}
+	}
+	return answerOptions
+}
+
+// getMultipleChoiceAnswers falls back to the default scale when a question has no answer options.
+func getMultipleChoiceAnswers(answerOptions []string) []string {
+	if len(answerOptions) == 0 {
+		return DefaultAnswerOptions()
+	}
+	return answerOptions
+}
+
+// getPlayerAnswerOptions gets the answer options for the question the player is answering, in the player's locale,
+// falling back to the default locale. In ranking rounds everyone gets the normal question's items.
+func (r *RoundService) getPlayerAnswerOptions(ctx context.Context, playerID uuid.UUID) ([]string, error) {
+	localeOptions, err := r.store.GetAnswerOptionsByPlayerID(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get answer options: %w", err)
+	}
+
+	var answerOptions []string
+	found := false
+	for _, localeOption := range localeOptions {
+		if localeOption.Locale == localeOption.PlayerLocale.String {
+			answerOptions = localeOption.AnswerOptions
+			found = true
+		} else if !found && localeOption.Locale == r.defaultLocale {
+			answerOptions = localeOption.AnswerOptions
+		}
+	}
+
+	return answerOptions, nil
+}
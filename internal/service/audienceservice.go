@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/invopop/ctxi18n"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+var ErrAudienceMemberNotFound = errors.New("audience member not found")
+var ErrGameNotPlaying = errors.New("game has not started, join as a player instead")
+
+type AudienceStore interface {
+	GetRoomByCode(ctx context.Context, roomCode string) (db.Room, error)
+	GetRoomByPlayerID(ctx context.Context, playerID uuid.UUID) (db.Room, error)
+	UpsertAudienceMember(ctx context.Context, arg db.UpsertAudienceMemberParams) (db.AudienceMember, error)
+	GetAudienceMemberByID(ctx context.Context, id uuid.UUID) (db.GetAudienceMemberByIDRow, error)
+	GetAudienceByGameStateID(ctx context.Context, id uuid.UUID) ([]db.AudienceMember, error)
+	GetGameStateByPlayerID(ctx context.Context, playerID uuid.UUID) (db.GameState, error)
+	GetAllPlayersInRoom(ctx context.Context, playerID uuid.UUID) ([]db.GetAllPlayersInRoomRow, error)
+	GetLatestRoundByPlayerID(ctx context.Context, playerID uuid.UUID) (db.GetLatestRoundByPlayerIDRow, error)
+	GetFibbersByRoundID(ctx context.Context, roundID uuid.UUID) ([]db.FibbingItPlayerRole, error)
+	SubmitAudienceVote(ctx context.Context, arg db.SubmitAudienceVoteArgs) error
+}
+
+// AudienceService lets people who arrive after a game has started watch it and vote, without being players.
+type AudienceService struct {
+	store         AudienceStore
+	randomizer    Randomizer
+	defaultLocale string
+}
+
+func NewAudienceService(store AudienceStore, randomizer Randomizer, defaultLocale string) *AudienceService {
+	return &AudienceService{store: store, randomizer: randomizer, defaultLocale: defaultLocale}
+}
+
+func (a *AudienceService) Join(
+	ctx context.Context,
+	roomCode string,
+	audienceID uuid.UUID,
+	nickname string,
+) (AudienceMember, error) {
+	if nickname == "" {
+		return AudienceMember{}, fmt.Errorf("nickname cannot be empty")
+	}
+
+	room, err := a.store.GetRoomByCode(ctx, roomCode)
+	if err != nil {
+		return AudienceMember{}, err
+	}
+
+	if room.RoomState != db.Playing.String() && room.RoomState != db.Paused.String() {
+		return AudienceMember{}, ErrGameNotPlaying
+	}
+
+	existingRoom, err := a.store.GetRoomByPlayerID(ctx, audienceID)
+	if err == nil && existingRoom.ID == room.ID {
+		return AudienceMember{}, ErrPlayerAlreadyInRoom
+	}
+
+	locale := a.defaultLocale
+	if l := ctxi18n.Locale(ctx); l != nil {
+		locale = l.Code().String()
+	}
+
+	member, err := a.store.UpsertAudienceMember(ctx, db.UpsertAudienceMemberParams{
+		ID:       audienceID,
+		RoomID:   room.ID,
+		Nickname: nickname,
+		Avatar:   a.randomizer.GetAvatar(nickname),
+		Locale:   pgtype.Text{String: locale, Valid: true},
+	})
+	if err != nil {
+		return AudienceMember{}, fmt.Errorf("failed to join audience: %w", err)
+	}
+
+	return AudienceMember{
+		ID:           member.ID,
+		Nickname:     member.Nickname,
+		Avatar:       member.Avatar,
+		Locale:       member.Locale.String,
+		RoomCode:     room.RoomCode,
+		HostPlayerID: room.HostPlayer,
+	}, nil
+}
+
+func (a *AudienceService) GetAudienceMember(ctx context.Context, audienceID uuid.UUID) (AudienceMember, error) {
+	member, err := a.store.GetAudienceMemberByID(ctx, audienceID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return AudienceMember{}, ErrAudienceMemberNotFound
+		}
+		return AudienceMember{}, err
+	}
+
+	if member.RoomState != db.Playing.String() && member.RoomState != db.Paused.String() {
+		return AudienceMember{}, ErrGameNotPlaying
+	}
+
+	return AudienceMember{
+		ID:           member.ID,
+		Nickname:     member.Nickname,
+		Avatar:       member.Avatar,
+		Locale:       member.Locale.String,
+		RoomCode:     member.RoomCode,
+		HostPlayerID: member.HostPlayer,
+	}, nil
+}
+
+func (a *AudienceService) GetAudience(ctx context.Context, gameStateID uuid.UUID) ([]AudienceMember, error) {
+	members, err := a.store.GetAudienceByGameStateID(ctx, gameStateID)
+	if err != nil {
+		return nil, err
+	}
+
+	audience := []AudienceMember{}
+	for _, member := range members {
+		audience = append(audience, AudienceMember{
+			ID:       member.ID,
+			Nickname: member.Nickname,
+			Avatar:   member.Avatar,
+			Locale:   member.Locale.String,
+		})
+	}
+
+	return audience, nil
+}
+
+// SubmitVote records who the audience member thinks the fibber is. Audience votes are only shown on the reveal
+// screen, they never count towards the players' scores.
+func (a *AudienceService) SubmitVote(
+	ctx context.Context,
+	audienceID uuid.UUID,
+	votedNickname string,
+	submittedAt time.Time,
+) error {
+	member, err := a.GetAudienceMember(ctx, audienceID)
+	if err != nil {
+		return err
+	}
+
+	// INFO: The audience are not in the room's players, so we look the game up through the host.
+	gameState, err := a.store.GetGameStateByPlayerID(ctx, member.HostPlayerID)
+	if err != nil {
+		return err
+	}
+
+	if gameState.State != db.FibbingItVoting.String() {
+		return ErrNotInVotingState
+	}
+
+	players, err := a.store.GetAllPlayersInRoom(ctx, member.HostPlayerID)
+	if err != nil {
+		return err
+	}
+
+	var votedPlayerID uuid.UUID
+	for _, p := range players {
+		if p.Nickname == votedNickname {
+			votedPlayerID = p.ID
+		}
+	}
+
+	if votedPlayerID == uuid.Nil {
+		return fmt.Errorf("player with nickname %s not found", votedNickname)
+	}
+
+	round, err := a.store.GetLatestRoundByPlayerID(ctx, member.HostPlayerID)
+	if err != nil {
+		return err
+	}
+
+	if submittedAt.After(round.SubmitDeadline.Time) {
+		return errors.New("answer submission deadline has passed")
+	}
+
+	if len(round.RevoteCandidates) > 0 && !slices.Contains(round.RevoteCandidates, votedPlayerID.String()) {
+		return ErrNotRevoteCandidate
+	}
+
+	fibbers, err := a.store.GetFibbersByRoundID(ctx, round.ID)
+	if err != nil {
+		return err
+	}
+
+	voteID, err := a.randomizer.GetID()
+	if err != nil {
+		return err
+	}
+
+	return a.store.SubmitAudienceVote(ctx, db.SubmitAudienceVoteArgs{
+		ID:               voteID,
+		AudienceMemberID: member.ID,
+		VotedForPlayerID: votedPlayerID,
+		RoundID:          round.ID,
+		// INFO: In a revote the audience only vote for the spots that weren't already clearly won, like players.
+		MaxVotes: len(fibbers) - len(round.RevoteWinners),
+	})
+}
@@ -0,0 +1,262 @@
+package service_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	mockService "gitlab.com/hmajid2301/banterbus/internal/service/mocks"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+var audienceID = uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d6"))
+
+func TestAudienceServiceJoin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully join the audience of a game being played", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAudienceStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAudienceService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{
+			ID:         roomID,
+			RoomCode:   roomCode,
+			RoomState:  db.Playing.String(),
+			HostPlayer: defaultHostPlayerID,
+		}, nil)
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, audienceID).Return(db.Room{}, sql.ErrNoRows)
+		mockRandom.EXPECT().GetAvatar("Watcher").Return(avatarURL)
+		mockStore.EXPECT().UpsertAudienceMember(ctx, db.UpsertAudienceMemberParams{
+			ID:       audienceID,
+			RoomID:   roomID,
+			Nickname: "Watcher",
+			Avatar:   avatarURL,
+			Locale:   pgtype.Text{String: "en-GB", Valid: true},
+		}).Return(db.AudienceMember{
+			ID:       audienceID,
+			RoomID:   roomID,
+			Nickname: "Watcher",
+			Avatar:   avatarURL,
+			Locale:   pgtype.Text{String: "en-GB", Valid: true},
+		}, nil)
+
+		member, err := srv.Join(ctx, roomCode, audienceID, "Watcher")
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.AudienceMember{
+			ID:           audienceID,
+			Nickname:     "Watcher",
+			Avatar:       avatarURL,
+			Locale:       "en-GB",
+			RoomCode:     roomCode,
+			HostPlayerID: defaultHostPlayerID,
+		}, member)
+	})
+
+	t.Run("Should fail to join the audience because the game has not started", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAudienceStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAudienceService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{
+			ID:        roomID,
+			RoomState: db.Created.String(),
+		}, nil)
+
+		_, err := srv.Join(ctx, roomCode, audienceID, "Watcher")
+		assert.ErrorIs(t, err, service.ErrGameNotPlaying)
+	})
+
+	t.Run("Should fail to join the audience because they are a player in the game", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAudienceStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAudienceService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{
+			ID:        roomID,
+			RoomState: db.Playing.String(),
+		}, nil)
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{ID: roomID}, nil)
+
+		_, err := srv.Join(ctx, roomCode, playerID, "Watcher")
+		assert.ErrorIs(t, err, service.ErrPlayerAlreadyInRoom)
+	})
+
+	t.Run("Should fail to join the audience because nickname is empty", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAudienceStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAudienceService(mockStore, mockRandom, "en-GB")
+
+		_, err := srv.Join(t.Context(), roomCode, audienceID, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestAudienceServiceGetAudienceMember(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should fail to get audience member who never joined", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAudienceStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAudienceService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetAudienceMemberByID(ctx, audienceID).Return(db.GetAudienceMemberByIDRow{}, sql.ErrNoRows)
+
+		_, err := srv.GetAudienceMember(ctx, audienceID)
+		assert.ErrorIs(t, err, service.ErrAudienceMemberNotFound)
+	})
+
+	t.Run("Should fail to get audience member when the game has finished", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAudienceStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAudienceService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetAudienceMemberByID(ctx, audienceID).Return(db.GetAudienceMemberByIDRow{
+			ID:        audienceID,
+			RoomState: db.Finished.String(),
+		}, nil)
+
+		_, err := srv.GetAudienceMember(ctx, audienceID)
+		assert.ErrorIs(t, err, service.ErrGameNotPlaying)
+	})
+}
+
+func TestAudienceServiceSubmitVote(t *testing.T) {
+	t.Parallel()
+
+	setupMember := func(t *testing.T, mockStore *mockService.MockAudienceStore) {
+		t.Helper()
+		mockStore.EXPECT().GetAudienceMemberByID(t.Context(), audienceID).Return(db.GetAudienceMemberByIDRow{
+			ID:         audienceID,
+			Nickname:   "Watcher",
+			RoomCode:   roomCode,
+			RoomState:  db.Playing.String(),
+			HostPlayer: defaultHostPlayerID,
+		}, nil)
+	}
+
+	t.Run("Should successfully submit audience vote, one for every fibber", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAudienceStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAudienceService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		now := time.Now()
+
+		setupMember(t, mockStore)
+		mockStore.EXPECT().GetGameStateByPlayerID(ctx, defaultHostPlayerID).Return(db.GameState{
+			State: db.FibbingItVoting.String(),
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, defaultHostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{ID: defaultHostPlayerID, Nickname: defaultHostNickname},
+			{ID: defaultOtherPlayerID, Nickname: defaultOtherPlayerNickname},
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, defaultHostPlayerID).Return(db.GetLatestRoundByPlayerIDRow{
+			ID:             roundID,
+			SubmitDeadline: pgtype.Timestamp{Time: now.Add(time.Minute)},
+		}, nil)
+
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{
+			{PlayerID: defaultHostPlayerID},
+			{PlayerID: defaultOtherPlayerID},
+		}, nil)
+
+		voteID, err := uuid.NewV7()
+		require.NoError(t, err)
+		mockRandom.EXPECT().GetID().Return(voteID, nil)
+		mockStore.EXPECT().SubmitAudienceVote(ctx, db.SubmitAudienceVoteArgs{
+			ID:               voteID,
+			AudienceMemberID: audienceID,
+			VotedForPlayerID: defaultOtherPlayerID,
+			RoundID:          roundID,
+			MaxVotes:         2,
+		}).Return(nil)
+
+		err = srv.SubmitVote(ctx, audienceID, defaultOtherPlayerNickname, now)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should fail to submit audience vote because game is not in voting state", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAudienceStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAudienceService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		setupMember(t, mockStore)
+		mockStore.EXPECT().GetGameStateByPlayerID(ctx, defaultHostPlayerID).Return(db.GameState{
+			State: db.FibbingITQuestion.String(),
+		}, nil)
+
+		err := srv.SubmitVote(ctx, audienceID, defaultOtherPlayerNickname, time.Now())
+		assert.ErrorIs(t, err, service.ErrNotInVotingState)
+	})
+
+	t.Run("Should fail to submit audience vote because nickname not found", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAudienceStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAudienceService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		setupMember(t, mockStore)
+		mockStore.EXPECT().GetGameStateByPlayerID(ctx, defaultHostPlayerID).Return(db.GameState{
+			State: db.FibbingItVoting.String(),
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, defaultHostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{ID: defaultHostPlayerID, Nickname: defaultHostNickname},
+		}, nil)
+
+		err := srv.SubmitVote(ctx, audienceID, "Nobody", time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail to submit audience vote because deadline has passed", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockAudienceStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewAudienceService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		now := time.Now()
+
+		setupMember(t, mockStore)
+		mockStore.EXPECT().GetGameStateByPlayerID(ctx, defaultHostPlayerID).Return(db.GameState{
+			State: db.FibbingItVoting.String(),
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, defaultHostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{ID: defaultOtherPlayerID, Nickname: defaultOtherPlayerNickname},
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, defaultHostPlayerID).Return(db.GetLatestRoundByPlayerIDRow{
+			ID:             roundID,
+			SubmitDeadline: pgtype.Timestamp{Time: now.Add(-time.Minute)},
+		}, nil)
+
+		err := srv.SubmitVote(ctx, audienceID, defaultOtherPlayerNickname, now)
+		assert.Error(t, err)
+	})
+}
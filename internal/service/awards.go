@@ -0,0 +1,145 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+const (
+	// AwardMasterFibber goes to the fibber who dodged the most votes.
+	AwardMasterFibber = "master_fibber"
+	// AwardDetective goes to the normal player who voted for a fibber the most times.
+	AwardDetective = "detective"
+	// AwardMostSuspicious goes to the normal player who got the most votes.
+	AwardMostSuspicious = "most_suspicious"
+	// AwardFastestAnswer goes to the player who submitted an answer the quickest.
+	AwardFastestAnswer = "fastest_answer"
+)
+
+type awardPlayer struct {
+	nickname string
+	avatar   string
+}
+
+type roundPlayer struct {
+	roundID  uuid.UUID
+	playerID uuid.UUID
+}
+
+// getAwards works out the end of game awards from the answers and votes stored for each round. An award is only
+// given if someone earned it, so a game where no one voted for a fibber has no detective.
+func getAwards(answers []db.GetRecapAnswersByGameStateIDRow, votes []db.GetRecapVotesByGameStateIDRow) []Award {
+	players := map[uuid.UUID]awardPlayer{}
+	roles := map[roundPlayer]string{}
+	fastestAnswerMs := map[uuid.UUID]int{}
+
+	for _, answer := range answers {
+		players[answer.PlayerID] = awardPlayer{nickname: answer.Nickname, avatar: answer.Avatar}
+		roles[roundPlayer{roundID: answer.RoundID, playerID: answer.PlayerID}] = answer.Role
+
+		if !answer.AnsweredAt.Valid || !answer.RoundStartedAt.Valid {
+			continue
+		}
+
+		answeredIn := int(answer.AnsweredAt.Time.Sub(answer.RoundStartedAt.Time).Milliseconds())
+		if fastest, ok := fastestAnswerMs[answer.PlayerID]; !ok || answeredIn < fastest {
+			fastestAnswerMs[answer.PlayerID] = answeredIn
+		}
+	}
+
+	votesInRound := map[uuid.UUID]int{}
+	votesFor := map[roundPlayer]int{}
+	correctVotes := map[uuid.UUID]int{}
+	suspicion := map[uuid.UUID]int{}
+
+	for _, vote := range votes {
+		votesInRound[vote.RoundID]++
+		votesFor[roundPlayer{roundID: vote.RoundID, playerID: vote.VotedForID}]++
+
+		voterRole := roles[roundPlayer{roundID: vote.RoundID, playerID: vote.VoterID}]
+		votedForRole := roles[roundPlayer{roundID: vote.RoundID, playerID: vote.VotedForID}]
+		if votedForRole == FibberRole && voterRole == NormalRole {
+			correctVotes[vote.VoterID]++
+		} else if votedForRole == NormalRole {
+			suspicion[vote.VotedForID]++
+		}
+	}
+
+	dodged := map[uuid.UUID]int{}
+	for rp, role := range roles {
+		if role != FibberRole {
+			continue
+		}
+
+		if votesDodged := votesInRound[rp.roundID] - votesFor[rp]; votesDodged > 0 {
+			dodged[rp.playerID] += votesDodged
+		}
+	}
+
+	awards := []Award{}
+	mostAwards := []struct {
+		name   string
+		scores map[uuid.UUID]int
+	}{
+		{name: AwardMasterFibber, scores: dodged},
+		{name: AwardDetective, scores: correctVotes},
+		{name: AwardMostSuspicious, scores: suspicion},
+	}
+	for _, a := range mostAwards {
+		if award, ok := bestAward(a.name, a.scores, players, func(score, best int) bool { return score > best }); ok {
+			awards = append(awards, award)
+		}
+	}
+
+	fastest, ok := bestAward(
+		AwardFastestAnswer,
+		fastestAnswerMs,
+		players,
+		func(score, best int) bool { return score < best },
+	)
+	if ok {
+		awards = append(awards, fastest)
+	}
+
+	return awards
+}
+
+// bestAward picks the player with the best score, only players who earned the award are in scores. Ties go to the
+// player whose nickname comes first so the awards don't change each time they're worked out.
+func bestAward(
+	name string,
+	scores map[uuid.UUID]int,
+	players map[uuid.UUID]awardPlayer,
+	isBetter func(score, best int) bool,
+) (Award, bool) {
+	playerIDs := []uuid.UUID{}
+	for playerID := range scores {
+		playerIDs = append(playerIDs, playerID)
+	}
+
+	if len(playerIDs) == 0 {
+		return Award{}, false
+	}
+
+	sort.Slice(playerIDs, func(i, j int) bool {
+		return players[playerIDs[i]].nickname < players[playerIDs[j]].nickname
+	})
+
+	best := playerIDs[0]
+	for _, playerID := range playerIDs[1:] {
+		if isBetter(scores[playerID], scores[best]) {
+			best = playerID
+		}
+	}
+
+	return Award{
+		Name:     name,
+		PlayerID: best,
+		Nickname: players[best].nickname,
+		Avatar:   players[best].avatar,
+		Score:    scores[best],
+	}, true
+}
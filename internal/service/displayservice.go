@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/invopop/ctxi18n"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+var ErrDisplayNotFound = errors.New("display not found")
+var ErrRoomNotActive = errors.New("room is not active")
+var ErrDisplayIsPlayer = errors.New("players cannot connect as a display")
+
+type DisplayStore interface {
+	GetPlayerByID(ctx context.Context, id uuid.UUID) (db.Player, error)
+	GetRoomByCode(ctx context.Context, roomCode string) (db.Room, error)
+	UpsertRoomDisplay(ctx context.Context, arg db.UpsertRoomDisplayParams) (db.RoomDisplay, error)
+	GetRoomDisplayByID(ctx context.Context, id uuid.UUID) (db.GetRoomDisplayByIDRow, error)
+	GetRoomDisplaysInLobby(ctx context.Context, roomCode string) ([]db.RoomDisplay, error)
+	GetRoomDisplaysByGameStateID(ctx context.Context, id uuid.UUID) ([]db.RoomDisplay, error)
+}
+
+// DisplayService manages the shared screens, which show the room on a TV while the players use their phones as
+// controllers.
+type DisplayService struct {
+	store         DisplayStore
+	defaultLocale string
+}
+
+func NewDisplayService(store DisplayStore, defaultLocale string) *DisplayService {
+	return &DisplayService{store: store, defaultLocale: defaultLocale}
+}
+
+func (d *DisplayService) Connect(ctx context.Context, roomCode string, displayID uuid.UUID) (Display, error) {
+	// INFO: Displays are sent every player's role and answers, so a player connecting as one could see who the
+	// fibber is.
+	_, err := d.store.GetPlayerByID(ctx, displayID)
+	if err == nil {
+		return Display{}, ErrDisplayIsPlayer
+	} else if !errors.Is(err, sql.ErrNoRows) && !errors.Is(err, pgx.ErrNoRows) {
+		return Display{}, err
+	}
+
+	room, err := d.store.GetRoomByCode(ctx, roomCode)
+	if err != nil {
+		return Display{}, err
+	}
+
+	roomState, err := db.ParseRoomState(room.RoomState)
+	if err != nil {
+		return Display{}, err
+	}
+
+	if !isActiveRoom(roomState) {
+		return Display{}, ErrRoomNotActive
+	}
+
+	locale := d.defaultLocale
+	if l := ctxi18n.Locale(ctx); l != nil {
+		locale = l.Code().String()
+	}
+
+	display, err := d.store.UpsertRoomDisplay(ctx, db.UpsertRoomDisplayParams{
+		ID:     displayID,
+		RoomID: room.ID,
+		Locale: pgtype.Text{String: locale, Valid: true},
+	})
+	if err != nil {
+		return Display{}, fmt.Errorf("failed to connect display: %w", err)
+	}
+
+	return Display{
+		ID:           display.ID,
+		Locale:       display.Locale.String,
+		RoomCode:     room.RoomCode,
+		RoomState:    roomState,
+		HostPlayerID: room.HostPlayer,
+	}, nil
+}
+
+func (d *DisplayService) GetDisplay(ctx context.Context, displayID uuid.UUID) (Display, error) {
+	display, err := d.store.GetRoomDisplayByID(ctx, displayID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return Display{}, ErrDisplayNotFound
+		}
+		return Display{}, err
+	}
+
+	roomState, err := db.ParseRoomState(display.RoomState)
+	if err != nil {
+		return Display{}, err
+	}
+
+	if !isActiveRoom(roomState) {
+		return Display{}, ErrRoomNotActive
+	}
+
+	return Display{
+		ID:           display.ID,
+		Locale:       display.Locale.String,
+		RoomCode:     display.RoomCode,
+		RoomState:    roomState,
+		HostPlayerID: display.HostPlayer,
+	}, nil
+}
+
+func (d *DisplayService) GetDisplaysInLobby(ctx context.Context, roomCode string) ([]Display, error) {
+	displays, err := d.store.GetRoomDisplaysInLobby(ctx, roomCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDisplays(displays), nil
+}
+
+func (d *DisplayService) GetDisplays(ctx context.Context, gameStateID uuid.UUID) ([]Display, error) {
+	displays, err := d.store.GetRoomDisplaysByGameStateID(ctx, gameStateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDisplays(displays), nil
+}
+
+func toDisplays(roomDisplays []db.RoomDisplay) []Display {
+	displays := []Display{}
+	for _, display := range roomDisplays {
+		displays = append(displays, Display{
+			ID:     display.ID,
+			Locale: display.Locale.String,
+		})
+	}
+	return displays
+}
+
+func isActiveRoom(roomState db.RoomState) bool {
+	return roomState == db.Created || roomState == db.Playing || roomState == db.Paused
+}
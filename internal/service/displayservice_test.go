@@ -0,0 +1,225 @@
+package service_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	mockService "gitlab.com/hmajid2301/banterbus/internal/service/mocks"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+var displayID = uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d7"))
+
+func TestDisplayServiceConnect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully connect display to room in lobby", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockDisplayStore(t)
+		srv := service.NewDisplayService(mockStore, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetPlayerByID(ctx, displayID).Return(db.Player{}, pgx.ErrNoRows)
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{
+			ID:         roomID,
+			RoomCode:   roomCode,
+			RoomState:  db.Created.String(),
+			HostPlayer: defaultHostPlayerID,
+		}, nil)
+		mockStore.EXPECT().UpsertRoomDisplay(ctx, db.UpsertRoomDisplayParams{
+			ID:     displayID,
+			RoomID: roomID,
+			Locale: pgtype.Text{String: "en-GB", Valid: true},
+		}).Return(db.RoomDisplay{
+			ID:     displayID,
+			RoomID: roomID,
+			Locale: pgtype.Text{String: "en-GB", Valid: true},
+		}, nil)
+
+		display, err := srv.Connect(ctx, roomCode, displayID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.Display{
+			ID:           displayID,
+			Locale:       "en-GB",
+			RoomCode:     roomCode,
+			RoomState:    db.Created,
+			HostPlayerID: defaultHostPlayerID,
+		}, display)
+	})
+
+	t.Run("Should successfully connect display to game being played", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockDisplayStore(t)
+		srv := service.NewDisplayService(mockStore, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetPlayerByID(ctx, displayID).Return(db.Player{}, pgx.ErrNoRows)
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{
+			ID:         roomID,
+			RoomCode:   roomCode,
+			RoomState:  db.Playing.String(),
+			HostPlayer: defaultHostPlayerID,
+		}, nil)
+		mockStore.EXPECT().UpsertRoomDisplay(ctx, db.UpsertRoomDisplayParams{
+			ID:     displayID,
+			RoomID: roomID,
+			Locale: pgtype.Text{String: "en-GB", Valid: true},
+		}).Return(db.RoomDisplay{
+			ID:     displayID,
+			RoomID: roomID,
+			Locale: pgtype.Text{String: "en-GB", Valid: true},
+		}, nil)
+
+		display, err := srv.Connect(ctx, roomCode, displayID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, db.Playing, display.RoomState)
+	})
+
+	t.Run("Should fail to connect display because game has finished", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockDisplayStore(t)
+		srv := service.NewDisplayService(mockStore, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetPlayerByID(ctx, displayID).Return(db.Player{}, pgx.ErrNoRows)
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{
+			ID:        roomID,
+			RoomState: db.Finished.String(),
+		}, nil)
+
+		_, err := srv.Connect(ctx, roomCode, displayID)
+		assert.ErrorIs(t, err, service.ErrRoomNotActive)
+	})
+
+	t.Run("Should fail to connect display because room does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockDisplayStore(t)
+		srv := service.NewDisplayService(mockStore, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetPlayerByID(ctx, displayID).Return(db.Player{}, pgx.ErrNoRows)
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{}, sql.ErrNoRows)
+
+		_, err := srv.Connect(ctx, roomCode, displayID)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail to connect display because it is a player", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockDisplayStore(t)
+		srv := service.NewDisplayService(mockStore, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetPlayerByID(ctx, defaultOtherPlayerID).Return(db.Player{
+			ID: defaultOtherPlayerID,
+		}, nil)
+
+		_, err := srv.Connect(ctx, roomCode, defaultOtherPlayerID)
+		assert.ErrorIs(t, err, service.ErrDisplayIsPlayer)
+	})
+}
+
+func TestDisplayServiceGetDisplay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully get display", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockDisplayStore(t)
+		srv := service.NewDisplayService(mockStore, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomDisplayByID(ctx, displayID).Return(db.GetRoomDisplayByIDRow{
+			ID:         displayID,
+			Locale:     pgtype.Text{String: "de-DE", Valid: true},
+			RoomCode:   roomCode,
+			RoomState:  db.Playing.String(),
+			HostPlayer: defaultHostPlayerID,
+		}, nil)
+
+		display, err := srv.GetDisplay(ctx, displayID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.Display{
+			ID:           displayID,
+			Locale:       "de-DE",
+			RoomCode:     roomCode,
+			RoomState:    db.Playing,
+			HostPlayerID: defaultHostPlayerID,
+		}, display)
+	})
+
+	t.Run("Should fail to get display that never connected", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockDisplayStore(t)
+		srv := service.NewDisplayService(mockStore, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomDisplayByID(ctx, displayID).Return(db.GetRoomDisplayByIDRow{}, sql.ErrNoRows)
+
+		_, err := srv.GetDisplay(ctx, displayID)
+		assert.ErrorIs(t, err, service.ErrDisplayNotFound)
+	})
+
+	t.Run("Should fail to get display when the room was abandoned", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockDisplayStore(t)
+		srv := service.NewDisplayService(mockStore, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomDisplayByID(ctx, displayID).Return(db.GetRoomDisplayByIDRow{
+			ID:        displayID,
+			RoomState: db.Abandoned.String(),
+		}, nil)
+
+		_, err := srv.GetDisplay(ctx, displayID)
+		assert.ErrorIs(t, err, service.ErrRoomNotActive)
+	})
+}
+
+func TestDisplayServiceGetDisplays(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully get displays in lobby", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockDisplayStore(t)
+		srv := service.NewDisplayService(mockStore, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomDisplaysInLobby(ctx, roomCode).Return([]db.RoomDisplay{
+			{ID: displayID, RoomID: roomID, Locale: pgtype.Text{String: "en-GB", Valid: true}},
+		}, nil)
+
+		displays, err := srv.GetDisplaysInLobby(ctx, roomCode)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []service.Display{{ID: displayID, Locale: "en-GB"}}, displays)
+	})
+
+	t.Run("Should return no displays when game has none", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockDisplayStore(t)
+		srv := service.NewDisplayService(mockStore, "en-GB")
+
+		ctx := t.Context()
+		gameStateID := uuid.Must(uuid.NewV7())
+		mockStore.EXPECT().GetRoomDisplaysByGameStateID(ctx, gameStateID).Return(nil, nil)
+
+		displays, err := srv.GetDisplays(ctx, gameStateID)
+
+		assert.NoError(t, err)
+		assert.Empty(t, displays)
+	})
+}
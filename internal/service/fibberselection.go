@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+const (
+	FibberSelectionFair   = "fair"
+	FibberSelectionRandom = "random"
+
+	FibberRotationRoundType = "round_type"
+	FibberRotationQuestion  = "question"
+)
+
+func FibberSelections() []string {
+	return []string{FibberSelectionFair, FibberSelectionRandom}
+}
+
+func FibberRotations() []string {
+	return []string{FibberRotationRoundType, FibberRotationQuestion}
+}
+
+// getFibberSelection falls back to fair, so players who have not been the fibber yet are picked first.
+func getFibberSelection(selection string) string {
+	if selection == "" {
+		return FibberSelectionFair
+	}
+	return selection
+}
+
+func getFibberRotation(rotation string) string {
+	if rotation == "" {
+		return FibberRotationRoundType
+	}
+	return rotation
+}
+
+// getFibberLocs picks which players are the fibbers in the next round, fair selection picks the players who have
+// been the fibber the fewest times so far in the game.
+func (r *RoundService) getFibberLocs(
+	ctx context.Context,
+	gameStateID uuid.UUID,
+	players []db.GetAllPlayersByGameStateIDRow,
+	fibbers int,
+	selection string,
+) ([]int, error) {
+	if getFibberSelection(selection) == FibberSelectionRandom {
+		return r.randomizer.GetFibberIndexes(len(players), fibbers), nil
+	}
+
+	fibberCounts, err := r.store.GetFibberCountsByGameStateID(ctx, gameStateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get how often players have been the fibber: %w", err)
+	}
+
+	timesFibber := make([]int, len(players))
+	for i, player := range players {
+		for _, fibberCount := range fibberCounts {
+			if fibberCount.PlayerID == player.ID {
+				timesFibber[i] = int(fibberCount.FibberCount)
+			}
+		}
+	}
+
+	return r.randomizer.GetFairFibberIndexes(timesFibber, fibbers), nil
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+const (
+	FibbingItGameName = "fibbing_it"
+	// PlayersPerFibber is how many players there are for every fibber, when the host lets the lobby size decide.
+	PlayersPerFibber = 5
+)
+
+type fibbingItStore interface {
+	questionFetcher
+	StartGame(ctx context.Context, arg db.StartGameArgs) error
+}
+
+// FibbingIt is the default game, every round one player gets a different question and the others try to find them.
+type FibbingIt struct {
+	store         fibbingItStore
+	randomizer    Randomizer
+	defaultLocale string
+}
+
+func NewFibbingIt(store fibbingItStore, randomizer Randomizer, defaultLocale string) *FibbingIt {
+	return &FibbingIt{store: store, randomizer: randomizer, defaultLocale: defaultLocale}
+}
+
+func (f *FibbingIt) Name() string {
+	return FibbingItGameName
+}
+
+func (f *FibbingIt) LobbyRules() LobbyRules {
+	return LobbyRules{MinimumPlayers: MinimumPlayers}
+}
+
+func (f *FibbingIt) Start(ctx context.Context, args StartGameArgs) (QuestionState, error) {
+	roundTypes := getRoundTypes(args.Settings.RoundTypes)
+	roundType := roundTypes[0]
+	// INFO: A new game hasn't asked any questions yet, so only the players' recent games can have used some.
+	used := usedQuestions{}
+	if args.Settings.RecentGames > 0 {
+		playerIDs := []uuid.UUID{}
+		for _, player := range args.Players {
+			playerIDs = append(playerIDs, player.ID)
+		}
+
+		var err error
+		used, err = getUsedQuestions(ctx, f.store, uuid.Nil, playerIDs, args.Settings.RecentGames)
+		if err != nil {
+			return QuestionState{}, err
+		}
+	}
+
+	normalsQuestions, fibberQuestions, err := getQuestions(ctx, f.store, args.Room.GameName, roundType, used)
+	if err != nil {
+		return QuestionState{}, err
+	}
+
+	// INFO: Nobody has been a fibber yet, so a fair pick is the same as a random one.
+	fibberLocs := f.randomizer.GetFibberIndexes(
+		len(args.Players),
+		getFibberCount(args.Settings.Fibbers, len(args.Players)),
+	)
+
+	gameStateID, err := f.randomizer.GetID()
+	if err != nil {
+		return QuestionState{}, err
+	}
+	err = f.store.StartGame(ctx, db.StartGameArgs{
+		GameStateID:       gameStateID,
+		RoomID:            args.Room.ID,
+		NormalsQuestionID: normalsQuestions[0].QuestionID,
+		FibberQuestionID:  fibberQuestions[0].QuestionID,
+		Players:           args.Players,
+		FibberLocs:        fibberLocs,
+		MaxRounds:         args.Settings.MaxRounds,
+		RoundTypes:        roundTypes,
+		QuestionSeconds:   int(args.Settings.Timings.ShowQuestionScreenFor.Seconds()),
+		VotingSeconds:     int(args.Settings.Timings.ShowVotingScreenFor.Seconds()),
+		RevealSeconds:     int(args.Settings.Timings.ShowRevealScreenFor.Seconds()),
+		RevoteSeconds:     int(args.Settings.Timings.ShowRevoteScreenFor.Seconds()),
+		ScoreSeconds:      int(args.Settings.Timings.ShowScoreScreenFor.Seconds()),
+		WinnerSeconds:     int(args.Settings.Timings.ShowWinnerScreenFor.Seconds()),
+		Scorers:           getScorerNames(args.Settings.Scorers),
+		RevealRule:        getRevealRule(args.Settings.RevealRule),
+		TieBreak:          getTieBreak(args.Settings.TieBreak),
+		Fibbers:           args.Settings.Fibbers,
+		FibberSelection:   getFibberSelection(args.Settings.FibberSelection),
+		FibberRotation:    getFibberRotation(args.Settings.FibberRotation),
+		SeriesID:          args.Room.SeriesID,
+		SeriesGames:       args.Settings.SeriesGames,
+		RecentGames:       args.Settings.RecentGames,
+		Deadline:          args.Deadline,
+	})
+	if err != nil {
+		return QuestionState{}, err
+	}
+
+	nicknames := []string{}
+	for _, player := range args.Players {
+		nicknames = append(nicknames, player.Nickname)
+	}
+
+	players := []PlayerWithRole{}
+	for i, player := range args.Players {
+		role := NormalRole
+
+		var question string
+		var answerOptions []string
+		for _, localeQuestion := range normalsQuestions {
+			if localeQuestion.Locale == player.Locale.String {
+				question = localeQuestion.Question
+				answerOptions = localeQuestion.AnswerOptions
+			} else if question == "" && localeQuestion.Locale == f.defaultLocale {
+				question = localeQuestion.Question
+				answerOptions = localeQuestion.AnswerOptions
+			}
+		}
+
+		if slices.Contains(fibberLocs, i) {
+			question = ""
+			role = FibberRole
+			// INFO: Everyone picks from the normal question's options, otherwise the fibber's options give them away.
+			// In ranking rounds everyone ranks the same items, only the fibber's criterion is different.
+			for _, localeQuestion := range fibberQuestions {
+				if localeQuestion.Locale == player.Locale.String {
+					question = localeQuestion.Question
+				} else if question == "" && localeQuestion.Locale == f.defaultLocale {
+					question = localeQuestion.Question
+				}
+			}
+		}
+
+		players = append(players, PlayerWithRole{
+			ID:              player.ID,
+			Role:            role,
+			Question:        question,
+			IsAnswerReady:   false,
+			PossibleAnswers: getPossibleAnswers(roundType, nicknames, answerOptions),
+		})
+	}
+
+	timeLeft := time.Until(args.Deadline)
+
+	gameState := QuestionState{
+		GameStateID: gameStateID,
+		Players:     players,
+		Round:       1,
+		RoundType:   roundType,
+		Deadline:    timeLeft,
+	}
+	return gameState, nil
+}
+
+// getFibberCount works out how many fibbers to pick for a round, there are always more normal players than fibbers.
+func getFibberCount(fibbers int, playersLen int) int {
+	if fibbers == 0 {
+		fibbers = min(playersLen/PlayersPerFibber, MaxFibbers)
+	}
+
+	return max(min(fibbers, (playersLen-1)/2), 1)
+}
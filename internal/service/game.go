@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+var ErrGameNotFound = errors.New("game not found")
+var ErrGameAlreadyRegistered = errors.New("game already registered")
+
+// Game is implemented by every game a room can host. It owns the rules the lobby enforces before starting and how
+// the first round is set up. The rounds after that are still run by the round service, which asks for questions of the
+// room's game, but the state sequence and views are Fibbing It's, so a game with different phases needs its own.
+type Game interface {
+	Name() string
+	LobbyRules() LobbyRules
+	Start(ctx context.Context, args StartGameArgs) (QuestionState, error)
+}
+
+type LobbyRules struct {
+	MinimumPlayers int
+	// MaximumPlayers of 0 means there is no upper limit.
+	MaximumPlayers int
+}
+
+type StartGameArgs struct {
+	Room     db.Room
+	Players  []db.GetAllPlayersInRoomRow
+	Settings RoomSettings
+	Deadline time.Time
+}
+
+type GameRegistry struct {
+	mu    sync.RWMutex
+	games map[string]Game
+}
+
+func NewGameRegistry(games ...Game) (*GameRegistry, error) {
+	registry := &GameRegistry{games: map[string]Game{}}
+	for _, game := range games {
+		if err := registry.Register(game); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+func (g *GameRegistry) Register(game Game) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.games[game.Name()]; ok {
+		return fmt.Errorf("%w: %s", ErrGameAlreadyRegistered, game.Name())
+	}
+
+	g.games[game.Name()] = game
+	return nil
+}
+
+func (g *GameRegistry) Get(name string) (Game, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	game, ok := g.games[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrGameNotFound, name)
+	}
+
+	return game, nil
+}
+
+func (g *GameRegistry) Names() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	names := make([]string, 0, len(g.games))
+	for name := range g.games {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+func (l LobbyRules) Validate(playerCount int) error {
+	if playerCount < l.MinimumPlayers {
+		return fmt.Errorf(
+			"%w: at least %d players are needed to start the game",
+			ErrNotEnoughPlayers,
+			l.MinimumPlayers,
+		)
+	}
+
+	if l.MaximumPlayers > 0 && playerCount > l.MaximumPlayers {
+		return fmt.Errorf("%w: at most %d players can play this game", ErrTooManyPlayers, l.MaximumPlayers)
+	}
+
+	return nil
+}
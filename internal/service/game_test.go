@@ -0,0 +1,128 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	mockService "gitlab.com/hmajid2301/banterbus/internal/service/mocks"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+type stubGame struct {
+	name string
+}
+
+func (s stubGame) Name() string {
+	return s.name
+}
+
+func (s stubGame) LobbyRules() service.LobbyRules {
+	return service.LobbyRules{MinimumPlayers: 3, MaximumPlayers: 4}
+}
+
+func (s stubGame) Start(_ context.Context, _ service.StartGameArgs) (service.QuestionState, error) {
+	return service.QuestionState{}, nil
+}
+
+func TestGameRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should register and get game", func(t *testing.T) {
+		t.Parallel()
+		registry, err := service.NewGameRegistry(stubGame{name: "stub"})
+		require.NoError(t, err)
+
+		game, err := registry.Get("stub")
+		assert.NoError(t, err)
+		assert.Equal(t, "stub", game.Name())
+		assert.Equal(t, []string{"stub"}, registry.Names())
+	})
+
+	t.Run("Should fail to register the same game twice", func(t *testing.T) {
+		t.Parallel()
+		_, err := service.NewGameRegistry(stubGame{name: "stub"}, stubGame{name: "stub"})
+		assert.ErrorIs(t, err, service.ErrGameAlreadyRegistered)
+	})
+
+	t.Run("Should fail to get game that is not registered", func(t *testing.T) {
+		t.Parallel()
+		registry, err := service.NewGameRegistry()
+		require.NoError(t, err)
+
+		_, err = registry.Get("stub")
+		assert.ErrorIs(t, err, service.ErrGameNotFound)
+	})
+}
+
+func TestLobbyRulesValidate(t *testing.T) {
+	t.Parallel()
+
+	rules := stubGame{}.LobbyRules()
+
+	assert.ErrorIs(t, rules.Validate(2), service.ErrNotEnoughPlayers)
+	assert.NoError(t, rules.Validate(3))
+	assert.NoError(t, rules.Validate(4))
+	assert.ErrorIs(t, rules.Validate(5), service.ErrTooManyPlayers)
+}
+
+func TestLobbyServiceRegisterGame(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should register a second game next to fibbing_it", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		err := srv.RegisterGame(stubGame{name: "stub"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{service.FibbingItGameName, "stub"}, srv.Games())
+	})
+
+	t.Run("Should fail to create room for a game that is not registered", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		_, err := srv.Create(t.Context(), "stub", defaultNewHostPlayer)
+		assert.ErrorIs(t, err, service.ErrGameNotFound)
+	})
+}
+
+func TestLobbyServiceGetLobbyRules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should get the lobby rules of the room's game", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+		err := srv.RegisterGame(stubGame{name: "stub"})
+		require.NoError(t, err)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{ID: roomID, GameName: "stub"}, nil)
+
+		rules, err := srv.GetLobbyRules(ctx, roomCode)
+		assert.NoError(t, err)
+		assert.Equal(t, service.LobbyRules{MinimumPlayers: 3, MaximumPlayers: 4}, rules)
+	})
+
+	t.Run("Should fail to get lobby rules because the game is not registered", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{ID: roomID, GameName: "stub"}, nil)
+
+		_, err := srv.GetLobbyRules(ctx, roomCode)
+		assert.ErrorIs(t, err, service.ErrGameNotFound)
+	})
+}
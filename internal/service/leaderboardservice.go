@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+const (
+	LeaderboardDaily   = "daily"
+	LeaderboardWeekly  = "weekly"
+	LeaderboardAllTime = "all_time"
+
+	// MaxLeaderboardEntries is how many players are shown on a leaderboard.
+	MaxLeaderboardEntries = 50
+)
+
+var ErrInvalidLeaderboard = errors.New("invalid leaderboard")
+
+type LeaderboardStore interface {
+	GetLeaderboard(ctx context.Context, arg db.GetLeaderboardParams) ([]db.GetLeaderboardRow, error)
+}
+
+// LeaderboardService ranks players by the points they scored across every finished game, rather than a single game
+// like the score and winner screens.
+type LeaderboardService struct {
+	store LeaderboardStore
+}
+
+func NewLeaderboardService(store LeaderboardStore) *LeaderboardService {
+	return &LeaderboardService{store: store}
+}
+
+func LeaderboardPeriods() []string {
+	return []string{LeaderboardDaily, LeaderboardWeekly, LeaderboardAllTime}
+}
+
+// GetLeaderboard gets the leaderboard for the period, only counting rounds of roundType. If roundType is empty all
+// round types are counted.
+func (l *LeaderboardService) GetLeaderboard(ctx context.Context, period string, roundType string) (Leaderboard, error) {
+	if roundType != "" && !slices.Contains(AllRoundTypes(), roundType) {
+		return Leaderboard{}, ErrInvalidLeaderboard
+	}
+
+	since, err := leaderboardStart(period, time.Now().UTC())
+	if err != nil {
+		return Leaderboard{}, err
+	}
+
+	rows, err := l.store.GetLeaderboard(ctx, db.GetLeaderboardParams{
+		Since:      pgtype.Timestamp{Time: since, Valid: true},
+		RoundType:  roundType,
+		MaxEntries: MaxLeaderboardEntries,
+	})
+	if err != nil {
+		return Leaderboard{}, err
+	}
+
+	leaderboard := Leaderboard{
+		Period:    period,
+		RoundType: roundType,
+		Entries:   []LeaderboardEntry{},
+	}
+
+	for i, row := range rows {
+		entry := LeaderboardEntry{
+			Rank:        i + 1,
+			Nickname:    row.Nickname,
+			Avatar:      row.Avatar,
+			Score:       int(row.TotalScore),
+			GamesPlayed: int(row.GamesPlayed),
+		}
+		if row.AccountID.Valid {
+			entry.AccountID = &row.AccountID.UUID
+		}
+
+		// INFO: Players on the same score share a rank, so the next player after a tie skips ahead.
+		if i > 0 && leaderboard.Entries[i-1].Score == entry.Score {
+			entry.Rank = leaderboard.Entries[i-1].Rank
+		}
+
+		leaderboard.Entries = append(leaderboard.Entries, entry)
+	}
+
+	return leaderboard, nil
+}
+
+// leaderboardStart is when scores start counting towards the leaderboard, daily and weekly leaderboards are rolling
+// so they never start off empty.
+func leaderboardStart(period string, now time.Time) (time.Time, error) {
+	switch period {
+	case LeaderboardDaily:
+		return now.Add(-24 * time.Hour), nil
+	case LeaderboardWeekly:
+		return now.AddDate(0, 0, -7), nil
+	case LeaderboardAllTime:
+		return time.Time{}, nil
+	default:
+		return time.Time{}, ErrInvalidLeaderboard
+	}
+}
@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	mockService "gitlab.com/hmajid2301/banterbus/internal/service/mocks"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+func TestLeaderboardServiceGetLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully get all time leaderboard", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLeaderboardStore(t)
+		srv := service.NewLeaderboardService(mockStore)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetLeaderboard(ctx, mock.MatchedBy(func(arg db.GetLeaderboardParams) bool {
+			return arg.Since.Time.IsZero() && arg.RoundType == "" && arg.MaxEntries == service.MaxLeaderboardEntries
+		})).Return([]db.GetLeaderboardRow{
+			{
+				AccountID:   uuid.NullUUID{UUID: accountID, Valid: true},
+				Nickname:    defaultHostNickname,
+				TotalScore:  300,
+				GamesPlayed: 2,
+			},
+			{Nickname: defaultOtherPlayerNickname, TotalScore: 300, GamesPlayed: 1},
+			{Nickname: "Third", TotalScore: 100, GamesPlayed: 1},
+		}, nil)
+
+		leaderboard, err := srv.GetLeaderboard(ctx, service.LeaderboardAllTime, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.Leaderboard{
+			Period: service.LeaderboardAllTime,
+			Entries: []service.LeaderboardEntry{
+				{Rank: 1, AccountID: &accountID, Nickname: defaultHostNickname, Score: 300, GamesPlayed: 2},
+				{Rank: 1, Nickname: defaultOtherPlayerNickname, Score: 300, GamesPlayed: 1},
+				{Rank: 3, Nickname: "Third", Score: 100, GamesPlayed: 1},
+			},
+		}, leaderboard)
+	})
+
+	t.Run("Should only count scores from the last week for a round type", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLeaderboardStore(t)
+		srv := service.NewLeaderboardService(mockStore)
+
+		ctx := t.Context()
+		weekAgo := time.Now().UTC().AddDate(0, 0, -7)
+		mockStore.EXPECT().GetLeaderboard(ctx, mock.MatchedBy(func(arg db.GetLeaderboardParams) bool {
+			return arg.Since.Time.Sub(weekAgo).Abs() < time.Minute && arg.RoundType == "free_form"
+		})).Return([]db.GetLeaderboardRow{}, nil)
+
+		leaderboard, err := srv.GetLeaderboard(ctx, service.LeaderboardWeekly, "free_form")
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.Leaderboard{
+			Period:    service.LeaderboardWeekly,
+			RoundType: "free_form",
+			Entries:   []service.LeaderboardEntry{},
+		}, leaderboard)
+	})
+
+	t.Run("Should fail to get leaderboard, invalid period", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLeaderboardStore(t)
+		srv := service.NewLeaderboardService(mockStore)
+
+		_, err := srv.GetLeaderboard(t.Context(), "monthly", "")
+		assert.ErrorIs(t, err, service.ErrInvalidLeaderboard)
+	})
+
+	t.Run("Should fail to get leaderboard, invalid round type", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLeaderboardStore(t)
+		srv := service.NewLeaderboardService(mockStore)
+
+		_, err := srv.GetLeaderboard(t.Context(), service.LeaderboardDaily, "not_a_round_type")
+		assert.ErrorIs(t, err, service.ErrInvalidLeaderboard)
+	})
+
+	t.Run("Should fail to get leaderboard, DB fails", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLeaderboardStore(t)
+		srv := service.NewLeaderboardService(mockStore)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetLeaderboard(ctx, mock.AnythingOfType("db.GetLeaderboardParams")).Return(
+			nil,
+			errors.New("failed to get leaderboard"),
+		)
+
+		_, err := srv.GetLeaderboard(ctx, service.LeaderboardDaily, "")
+		assert.Error(t, err)
+	})
+}
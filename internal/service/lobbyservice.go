@@ -5,10 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/invopop/ctxi18n"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"gitlab.com/hmajid2301/banterbus/internal/store/db"
@@ -23,37 +25,87 @@ type LobbyStore interface {
 	JoinRoom(ctx context.Context, arg db.JoinRoomArgs) (db.JoinRoomResult, error)
 	ReassignHostPlayer(ctx context.Context, arg db.ReassignHostPlayerParams) (db.Room, error)
 	RemovePlayerFromRoom(ctx context.Context, playerID uuid.UUID) (db.RoomsPlayer, error)
+	SetPlayerDisconnected(ctx context.Context, id uuid.UUID) error
 	StartGame(ctx context.Context, arg db.StartGameArgs) error
+	GetGameStateByPlayerID(ctx context.Context, playerID uuid.UUID) (db.GameState, error)
+	Rematch(ctx context.Context, arg db.RematchArgs) error
+	GetRoomSettings(ctx context.Context, roomID uuid.UUID) (db.RoomSetting, error)
+	UpsertRoomSettings(ctx context.Context, arg db.UpsertRoomSettingsParams) (db.RoomSetting, error)
 	GetRandomQuestionByRound(ctx context.Context, arg db.GetRandomQuestionByRoundParams) ([]db.GetRandomQuestionByRoundRow, error)
 	GetRandomQuestionInGroup(ctx context.Context, arg db.GetRandomQuestionInGroupParams) ([]db.GetRandomQuestionInGroupRow, error)
+	GetRandomQuestionPair(ctx context.Context, arg db.GetRandomQuestionPairParams) (db.QuestionPair, error)
+	GetQuestionWithLocalesById(ctx context.Context, id uuid.UUID) ([]db.GetQuestionWithLocalesByIdRow, error)
+	GetUsedQuestionIDs(ctx context.Context, arg db.GetUsedQuestionIDsParams) ([]db.GetUsedQuestionIDsRow, error)
 }
 
 type questionFetcher interface {
 	GetRandomQuestionByRound(ctx context.Context, arg db.GetRandomQuestionByRoundParams) ([]db.GetRandomQuestionByRoundRow, error)
 	GetRandomQuestionInGroup(ctx context.Context, arg db.GetRandomQuestionInGroupParams) ([]db.GetRandomQuestionInGroupRow, error)
+	GetRandomQuestionPair(ctx context.Context, arg db.GetRandomQuestionPairParams) (db.QuestionPair, error)
+	GetQuestionWithLocalesById(ctx context.Context, id uuid.UUID) ([]db.GetQuestionWithLocalesByIdRow, error)
+	GetUsedQuestionIDs(ctx context.Context, arg db.GetUsedQuestionIDsParams) ([]db.GetUsedQuestionIDsRow, error)
 }
 
 type LobbyService struct {
-	store         LobbyStore
-	randomizer    Randomizer
-	defaultLocale string
-	metrics       *telemetry.Recorder
+	store           LobbyStore
+	randomizer      Randomizer
+	defaultLocale   string
+	defaultSettings RoomSettings
+	metrics         *telemetry.Recorder
+	games           *GameRegistry
 }
 
 const MinimumPlayers = 2
 
 var ErrNotEnoughPlayers = errors.New("not enough players to start the game")
+var ErrTooManyPlayers = errors.New("too many players to start the game")
 var ErrNicknameExists = errors.New("nickname already exists in room")
 var ErrPlayerAlreadyInRoom = errors.New("player is already in the room")
 var ErrPlayerNotInGame = errors.New("player is not currently in any game")
-
-func NewLobbyService(store LobbyStore, randomizer Randomizer, defaultLocale string) *LobbyService {
+var ErrNewHostNotFound = errors.New("new host is not in the room")
+var ErrNewHostInactive = errors.New("cannot hand host over to an inactive player")
+var ErrGameNotOver = errors.New("game has not finished yet")
+
+func NewLobbyService(
+	store LobbyStore,
+	randomizer Randomizer,
+	defaultLocale string,
+	defaultSettings RoomSettings,
+) *LobbyService {
+	// INFO: fibbing_it is the only game registered here, so registering it can't fail.
+	games, _ := NewGameRegistry(NewFibbingIt(store, randomizer, defaultLocale))
 	return &LobbyService{
-		store:         store,
-		randomizer:    randomizer,
-		defaultLocale: defaultLocale,
-		metrics:       telemetry.NewRecorder(),
+		store:           store,
+		randomizer:      randomizer,
+		defaultLocale:   defaultLocale,
+		defaultSettings: defaultSettings,
+		metrics:         telemetry.NewRecorder(),
+		games:           games,
+	}
+}
+
+// RegisterGame makes a new game available to rooms, fibbing_it is always registered.
+func (r *LobbyService) RegisterGame(game Game) error {
+	return r.games.Register(game)
+}
+
+func (r *LobbyService) Games() []string {
+	return r.games.Names()
+}
+
+// GetLobbyRules returns the rules of the game the room is hosting, e.g. how many players it needs to start.
+func (r *LobbyService) GetLobbyRules(ctx context.Context, roomCode string) (LobbyRules, error) {
+	room, err := r.store.GetRoomByCode(ctx, roomCode)
+	if err != nil {
+		return LobbyRules{}, err
+	}
+
+	game, err := r.games.Get(room.GameName)
+	if err != nil {
+		return LobbyRules{}, err
 	}
+
+	return game.LobbyRules(), nil
 }
 
 func (r *LobbyService) Create(
@@ -61,20 +113,12 @@ func (r *LobbyService) Create(
 	gameName string,
 	newHostPlayer NewHostPlayer,
 ) (LobbyCreationResult, error) {
-	validGameTypes := []string{"fibbing_it"}
-	isValidGame := false
-	for _, validType := range validGameTypes {
-		if gameName == validType {
-			isValidGame = true
-			break
-		}
-	}
-	if !isValidGame {
-		return LobbyCreationResult{}, fmt.Errorf("invalid game type: %s", gameName)
+	_, err := r.games.Get(gameName)
+	if err != nil {
+		return LobbyCreationResult{}, fmt.Errorf("invalid game type: %w", err)
 	}
 
 	var newPlayerID uuid.UUID
-	var err error
 
 	if newHostPlayer.ID != uuid.Nil {
 		newPlayerID = newHostPlayer.ID
@@ -221,7 +265,12 @@ func (r *LobbyService) HandlePlayerDisconnect(
 		return err
 	}
 
-	if room.RoomState != db.Created.String() {
+	switch room.RoomState {
+	case db.Created.String():
+	case db.Playing.String(), db.Paused.String():
+		// INFO: Players stay in a game they drop out of, they only become inactive if they don't reconnect in time.
+		return r.store.SetPlayerDisconnected(ctx, playerID)
+	default:
 		return nil
 	}
 
@@ -318,13 +367,114 @@ func (r *LobbyService) KickPlayer(
 	return lobby, playerToKickID, nil
 }
 
+// TransferHost lets the host hand over to another player in the room, both in the lobby and during a game.
+func (r *LobbyService) TransferHost(
+	ctx context.Context,
+	roomCode string,
+	playerID uuid.UUID,
+	newHostNickname string,
+) (uuid.UUID, error) {
+	room, err := r.store.GetRoomByCode(ctx, roomCode)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if room.HostPlayer != playerID {
+		return uuid.Nil, ErrNotHost
+	}
+
+	roomState, err := db.ParseRoomState(room.RoomState)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if !isActiveRoom(roomState) {
+		return uuid.Nil, ErrRoomNotActive
+	}
+
+	playersInRoom, err := r.store.GetAllPlayersInRoom(ctx, playerID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	for _, p := range playersInRoom {
+		if p.Nickname != newHostNickname || p.ID == playerID {
+			continue
+		}
+
+		if p.InactiveAt.Valid {
+			return uuid.Nil, ErrNewHostInactive
+		}
+
+		_, err = r.store.ReassignHostPlayer(ctx, db.ReassignHostPlayerParams{
+			ID:         room.ID,
+			HostPlayer: p.ID,
+		})
+		return p.ID, err
+	}
+
+	return uuid.Nil, ErrNewHostNotFound
+}
+
+// MigrateHost hands host over to another player if this player is the host of a game they have dropped out of.
+// Returns the new host, or uuid.Nil if host didn't change.
+func (r *LobbyService) MigrateHost(ctx context.Context, playerID uuid.UUID) (uuid.UUID, error) {
+	room, err := r.store.GetRoomByPlayerID(ctx, playerID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if room.HostPlayer != playerID {
+		return uuid.Nil, nil
+	}
+
+	if room.RoomState != db.Playing.String() && room.RoomState != db.Paused.String() {
+		return uuid.Nil, nil
+	}
+
+	playersInRoom, err := r.store.GetAllPlayersInRoom(ctx, playerID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	// INFO: Prefer players who are still connected, over players who have only just dropped out as well.
+	var newHostPlayerID uuid.UUID
+	for _, p := range playersInRoom {
+		if p.ID == playerID || p.InactiveAt.Valid {
+			continue
+		}
+
+		if !p.DisconnectedAt.Valid {
+			newHostPlayerID = p.ID
+			break
+		}
+
+		if newHostPlayerID == uuid.Nil {
+			newHostPlayerID = p.ID
+		}
+	}
+
+	if newHostPlayerID == uuid.Nil {
+		return uuid.Nil, nil
+	}
+
+	_, err = r.store.ReassignHostPlayer(ctx, db.ReassignHostPlayerParams{
+		ID:         room.ID,
+		HostPlayer: newHostPlayerID,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return newHostPlayerID, nil
+}
+
 func (r *LobbyService) Start(
 	ctx context.Context,
 	roomCode string,
 	playerID uuid.UUID,
 	deadline time.Time,
 ) (QuestionState, error) {
-	var gameStateID uuid.UUID
 	room, err := r.store.GetRoomByCode(ctx, roomCode)
 	if err != nil {
 		return QuestionState{}, err
@@ -343,8 +493,14 @@ func (r *LobbyService) Start(
 		return QuestionState{}, err
 	}
 
-	if len(playersInRoom) < MinimumPlayers {
-		return QuestionState{}, fmt.Errorf("%w: at least %d players are needed to start the game", ErrNotEnoughPlayers, MinimumPlayers)
+	game, err := r.games.Get(room.GameName)
+	if err != nil {
+		return QuestionState{}, err
+	}
+
+	err = game.LobbyRules().Validate(len(playersInRoom))
+	if err != nil {
+		return QuestionState{}, err
 	}
 
 	for _, player := range playersInRoom {
@@ -353,73 +509,62 @@ func (r *LobbyService) Start(
 		}
 	}
 
-	normalsQuestions, fibberQuestions, err := getQuestions(ctx, r.store, room.GameName, RoundTypeFreeForm)
-	if err != nil {
-		return QuestionState{}, errors.New(err.Error())
-	}
-
-	randomFibberLoc := r.randomizer.GetFibberIndex(len(playersInRoom))
-
-	gameStateID, err = r.randomizer.GetID()
+	settings, err := r.getRoomSettings(ctx, room.ID)
 	if err != nil {
 		return QuestionState{}, err
 	}
-	err = r.store.StartGame(ctx, db.StartGameArgs{
-		GameStateID:       gameStateID,
-		RoomID:            room.ID,
-		NormalsQuestionID: normalsQuestions[0].QuestionID,
-		FibberQuestionID:  fibberQuestions[0].QuestionID,
-		Players:           playersInRoom,
-		FibberLoc:         randomFibberLoc,
-		Deadline:          deadline,
+
+	return game.Start(ctx, StartGameArgs{
+		Room:     room,
+		Players:  playersInRoom,
+		Settings: settings,
+		Deadline: deadline,
 	})
+}
+
+// Rematch moves everyone from a game that has ended into a new lobby with the same room code, so they can play again
+// without sharing a new code. Only the host can start a rematch.
+func (r *LobbyService) Rematch(ctx context.Context, playerID uuid.UUID) (Lobby, error) {
+	room, err := r.store.GetRoomByPlayerID(ctx, playerID)
 	if err != nil {
-		return QuestionState{}, err
+		return Lobby{}, err
 	}
 
-	players := []PlayerWithRole{}
-	for i, player := range playersInRoom {
-		role := NormalRole
+	if room.HostPlayer != playerID {
+		return Lobby{}, ErrNotHost
+	}
 
-		var question string
-		for _, localeQuestion := range normalsQuestions {
-			if localeQuestion.Locale == player.Locale.String {
-				question = localeQuestion.Question
-			} else if question == "" && localeQuestion.Locale == r.defaultLocale {
-				question = localeQuestion.Question
-			}
+	switch room.RoomState {
+	case db.Finished.String():
+	case db.Playing.String():
+		// INFO: The room is only marked as finished once the winner screen times out, players can rematch before then.
+		gameState, err := r.store.GetGameStateByPlayerID(ctx, playerID)
+		if err != nil {
+			return Lobby{}, err
 		}
 
-		if i == randomFibberLoc {
-			question = ""
-			role = FibberRole
-			for _, localeQuestion := range fibberQuestions {
-				if localeQuestion.Locale == player.Locale.String {
-					question = localeQuestion.Question
-				} else if question == "" && localeQuestion.Locale == r.defaultLocale {
-					question = localeQuestion.Question
-				}
-			}
+		if gameState.State != db.FibbingItWinner.String() {
+			return Lobby{}, ErrGameNotOver
 		}
-
-		players = append(players, PlayerWithRole{
-			ID:            player.ID,
-			Role:          role,
-			Question:      question,
-			IsAnswerReady: false,
-		})
+	default:
+		return Lobby{}, ErrGameNotOver
 	}
 
-	timeLeft := time.Until(deadline)
+	newRoomID, err := r.randomizer.GetID()
+	if err != nil {
+		return Lobby{}, err
+	}
 
-	gameState := QuestionState{
-		GameStateID: gameStateID,
-		Players:     players,
-		Round:       1,
-		RoundType:   RoundTypeFreeForm,
-		Deadline:    timeLeft,
+	err = r.store.Rematch(ctx, db.RematchArgs{
+		RoomID:    room.ID,
+		NewRoomID: newRoomID,
+		PlayerID:  playerID,
+	})
+	if err != nil {
+		return Lobby{}, err
 	}
-	return gameState, nil
+
+	return r.GetLobby(ctx, playerID)
 }
 
 func (r *LobbyService) GetRoomState(ctx context.Context, playerID uuid.UUID) (db.RoomState, error) {
@@ -462,71 +607,180 @@ func (r *LobbyService) getNewPlayer(playerNickname string, playerID uuid.UUID) N
 	return newPlayer
 }
 
-// TODO: add unit tests for this
+// usedQuestions are the questions which have already been asked, so shouldn't be picked again.
+type usedQuestions struct {
+	// game is every question asked in the current game.
+	game []uuid.UUID
+	// recent is every question asked in the players' recent games.
+	recent []uuid.UUID
+}
+
+// getUsedQuestions finds the questions asked so far in the game, and in the last recentGames games any of the players
+// played in.
+func getUsedQuestions(
+	ctx context.Context,
+	store questionFetcher,
+	gameStateID uuid.UUID,
+	playerIDs []uuid.UUID,
+	recentGames int,
+) (usedQuestions, error) {
+	used := usedQuestions{game: []uuid.UUID{}, recent: []uuid.UUID{}}
+	rows, err := store.GetUsedQuestionIDs(ctx, db.GetUsedQuestionIDsParams{
+		GameStateID: gameStateID,
+		PlayerIds:   playerIDs,
+		RecentGames: int32(recentGames),
+	})
+	if err != nil {
+		return usedQuestions{}, fmt.Errorf("failed to get used questions: %w", err)
+	}
+
+	for _, row := range rows {
+		if row.GameStateID == gameStateID {
+			used.game = append(used.game, row.NormalQuestionID, row.FibberQuestionID)
+		} else {
+			used.recent = append(used.recent, row.NormalQuestionID, row.FibberQuestionID)
+		}
+	}
+
+	return used, nil
+}
+
+// getQuestions picks the normal and fibber questions for a round, avoiding questions which have already been used.
+// When the question bank runs low, questions from recent games are allowed again and then questions from this game,
+// so a small question bank repeats questions rather than failing to start the round.
 func getQuestions(
 	ctx context.Context,
 	store questionFetcher,
 	gameName string,
 	roundType string,
+	used usedQuestions,
 ) ([]db.GetRandomQuestionByRoundRow, []db.GetRandomQuestionInGroupRow, error) {
-	maxRetries := 3
+	exclusions := [][]uuid.UUID{}
+	if len(used.recent) > 0 {
+		exclusions = append(exclusions, slices.Concat(used.game, used.recent))
+	}
+	if len(used.game) > 0 {
+		exclusions = append(exclusions, used.game)
+	}
+	exclusions = append(exclusions, []uuid.UUID{})
 
-	normalsQuestions, err := store.GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-		GameName:  gameName,
-		RoundType: roundType,
+	var err error
+	for _, excluded := range exclusions {
+		var normalsQuestions []db.GetRandomQuestionByRoundRow
+		var fibberQuestions []db.GetRandomQuestionInGroupRow
+		normalsQuestions, fibberQuestions, err = getUnusedQuestions(ctx, store, gameName, roundType, excluded)
+		if !errors.Is(err, ErrNoNormalQuestions) && !errors.Is(err, ErrNoFibberQuestions) {
+			return normalsQuestions, fibberQuestions, err
+		}
+	}
+
+	return nil, nil, err
+}
+
+// getUnusedQuestions uses a curated pair when the question bank has one for the round type, otherwise the fibber
+// question is another question from the same group as the normal one.
+func getUnusedQuestions(
+	ctx context.Context,
+	store questionFetcher,
+	gameName string,
+	roundType string,
+	excluded []uuid.UUID,
+) ([]db.GetRandomQuestionByRoundRow, []db.GetRandomQuestionInGroupRow, error) {
+	pair, err := store.GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+		GameName:            gameName,
+		RoundType:           roundType,
+		ExcludedQuestionIds: excluded,
 	})
+	if err == nil {
+		return getPairQuestions(ctx, store, pair)
+	} else if !errors.Is(err, sql.ErrNoRows) && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, fmt.Errorf("failed to get question pair: %w", err)
+	}
+
+	return getGroupQuestions(ctx, store, gameName, roundType, excluded)
+}
+
+func getPairQuestions(
+	ctx context.Context,
+	store questionFetcher,
+	pair db.QuestionPair,
+) ([]db.GetRandomQuestionByRoundRow, []db.GetRandomQuestionInGroupRow, error) {
+	normals, err := store.GetQuestionWithLocalesById(ctx, pair.NormalQuestionID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get normal questions: %w", err)
+		return nil, nil, fmt.Errorf("failed to get normal question in pair: %w", err)
 	}
 
-	if len(normalsQuestions) == 0 {
-		return nil, nil, fmt.Errorf("no normal questions found")
+	fibbers, err := store.GetQuestionWithLocalesById(ctx, pair.FibberQuestionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get fibber question in pair: %w", err)
 	}
 
-	fibberQuestions, err := store.GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
-		GroupType:          "",
-		GroupID:            normalsQuestions[0].GroupID,
-		ExcludedQuestionID: normalsQuestions[0].QuestionID,
-		RoundType:          roundType,
-	})
+	if len(normals) == 0 || len(fibbers) == 0 {
+		return nil, nil, fmt.Errorf("question pair %s has no translations", pair.ID)
+	}
 
-	if err == sql.ErrNoRows {
-		for i := 0; i < maxRetries; i++ {
-			newNormals, err := store.GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-				GameName:  gameName,
-				RoundType: roundType,
-			})
-			if err != nil {
-				return nil, nil, fmt.Errorf("retry %d: failed to get normal questions: %w", i+1, err)
-			}
-			if len(newNormals) == 0 {
-				continue
-			}
+	normalsQuestions := []db.GetRandomQuestionByRoundRow{}
+	for _, question := range normals {
+		normalsQuestions = append(normalsQuestions, db.GetRandomQuestionByRoundRow(question))
+	}
 
-			newFibber, err := store.GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
-				GroupType:          "",
-				GroupID:            newNormals[0].GroupID,
-				ExcludedQuestionID: newNormals[0].QuestionID,
-				RoundType:          roundType,
-			})
+	fibberQuestions := []db.GetRandomQuestionInGroupRow{}
+	for _, question := range fibbers {
+		fibberQuestions = append(fibberQuestions, db.GetRandomQuestionInGroupRow{
+			ID:            question.ID,
+			CreatedAt:     question.CreatedAt,
+			UpdatedAt:     question.UpdatedAt,
+			Question:      question.Question,
+			Locale:        question.Locale,
+			QuestionID:    question.QuestionID,
+			AnswerOptions: question.AnswerOptions,
+			ID_2:          question.ID_2,
+		})
+	}
 
-			if err == nil {
-				normalsQuestions = newNormals
-				fibberQuestions = newFibber
-				break
-			} else if err == sql.ErrNoRows {
-				continue
-			}
+	return normalsQuestions, fibberQuestions, nil
+}
 
-			return nil, nil, fmt.Errorf("retry %d: failed to get fibber questions: %w", i+1, err)
+// getGroupQuestions is the fallback for round types without curated pairs, it picks a random normal question and then
+// another question from its group for the fibber. The normal question is picked again if it is alone in its group.
+func getGroupQuestions(
+	ctx context.Context,
+	store questionFetcher,
+	gameName string,
+	roundType string,
+	excluded []uuid.UUID,
+) ([]db.GetRandomQuestionByRoundRow, []db.GetRandomQuestionInGroupRow, error) {
+	maxRetries := 3
+
+	for i := 0; i <= maxRetries; i++ {
+		normalsQuestions, err := store.GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
+			GameName:            gameName,
+			RoundType:           roundType,
+			ExcludedQuestionIds: excluded,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get normal questions: %w", err)
+		}
+
+		if len(normalsQuestions) == 0 {
+			return nil, nil, ErrNoNormalQuestions
 		}
 
-		if len(fibberQuestions) == 0 {
-			return nil, nil, fmt.Errorf("no fibber questions found after %d retries", maxRetries)
+		fibberQuestions, err := store.GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
+			GroupType:           "",
+			GroupID:             normalsQuestions[0].GroupID,
+			ExcludedQuestionID:  normalsQuestions[0].QuestionID,
+			ExcludedQuestionIds: excluded,
+			RoundType:           roundType,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, fmt.Errorf("failed to get fibber questions: %w", err)
+		}
+
+		if len(fibberQuestions) > 0 {
+			return normalsQuestions, fibberQuestions, nil
 		}
-	} else if err != nil {
-		return nil, nil, fmt.Errorf("initial fibber question fetch failed: %w", err)
 	}
 
-	return normalsQuestions, fibberQuestions, nil
+	return nil, nil, fmt.Errorf("%w after %d retries", ErrNoFibberQuestions, maxRetries)
 }
@@ -35,7 +35,7 @@ func TestIntegrationLobbyCreate(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := srv.Create(ctx, "fibbing_it", newPlayer)
 
 		assert.NoError(t, err)
@@ -60,7 +60,7 @@ func TestIntegrationLobbyJoin(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := createRoom(ctx, srv)
 		assert.NoError(t, err)
 
@@ -84,7 +84,7 @@ func TestIntegrationLobbyJoin(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		_, err = createRoom(ctx, srv)
 		assert.NoError(t, err)
 
@@ -104,7 +104,7 @@ func TestIntegrationLobbyJoin(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := createRoom(ctx, srv)
 		assert.NoError(t, err)
 
@@ -135,7 +135,7 @@ func TestIntegrationLobbyKickPlayer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
 
@@ -158,7 +158,7 @@ func TestIntegrationLobbyKickPlayer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		_, err = lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
 
@@ -178,7 +178,7 @@ func TestIntegrationLobbyKickPlayer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
 
@@ -198,7 +198,7 @@ func TestIntegrationLobbyKickPlayer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
 
@@ -225,7 +225,7 @@ func TestIntegrationLobbyKickPlayer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
 
@@ -245,7 +245,7 @@ func TestIntegrationLobbyKickPlayer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
 
@@ -274,7 +274,7 @@ func TestIntegrationLobbyKickPlayer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
 
@@ -307,7 +307,7 @@ func TestIntegrationLobbyStart(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		plySrv := service.NewPlayerService(str, randomizer)
 		lobby, err := lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
@@ -359,7 +359,7 @@ func TestIntegrationLobbyStart(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		plySrv := service.NewPlayerService(str, randomizer)
 		_, err = lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
@@ -386,7 +386,7 @@ func TestIntegrationLobbyStart(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		plySrv := service.NewPlayerService(str, randomizer)
 		lobby, err := lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
@@ -413,7 +413,7 @@ func TestIntegrationLobbyStart(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		plySrv := service.NewPlayerService(str, randomizer)
 		lobby, err := lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
@@ -447,7 +447,7 @@ func TestIntegrationLobbyStart(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := createRoom(ctx, srv)
 		assert.NoError(t, err)
 
@@ -468,7 +468,7 @@ func TestIntegrationLobbyStart(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		plySrv := service.NewPlayerService(str, randomizer)
 		lobby, err := lobbyWithTwoPlayers(ctx, srv)
 		assert.NoError(t, err)
@@ -503,7 +503,7 @@ func TestIntegrationLobbyGetRoomState(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		_, err = srv.Create(ctx, "fibbing_it", newPlayer)
 		require.NoError(t, err)
 
@@ -530,7 +530,7 @@ func TestIntegrationLobbyGetRoomState(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		_, err = srv.Create(ctx, "fibbing_it", newPlayer)
 		require.NoError(t, err)
 
@@ -550,7 +550,7 @@ func TestIntegrationLobbyGetLobby(t *testing.T) {
 		baseDelay := (time.Millisecond * 100)
 		str := db.NewDB(pool, 3, baseDelay)
 		randomizer := randomizer.NewUserRandomizer()
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 
 		id, err := uuid.NewV4()
 		require.NoError(t, err)
@@ -583,6 +583,38 @@ func TestIntegrationLobbyGetLobby(t *testing.T) {
 	})
 }
 
+func TestIntegrationLobbyRematch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should move a disconnected player into the rematch lobby", func(t *testing.T) {
+		t.Parallel()
+		pool, teardown := setupSubtest(t)
+		t.Cleanup(teardown)
+
+		baseDelay := (time.Millisecond * 100)
+		str := db.NewDB(pool, 3, baseDelay)
+		randomizer := randomizer.NewUserRandomizer()
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
+
+		ctx, err := getI18nCtx(t.Context())
+		require.NoError(t, err)
+
+		lobby, err := lobbyWithTwoPlayers(ctx, srv)
+		require.NoError(t, err)
+
+		_, err = pool.Exec(ctx, "UPDATE rooms SET room_state = 'FINISHED' WHERE room_code = $1", lobby.Code)
+		require.NoError(t, err)
+
+		_, err = pool.Exec(ctx, "UPDATE players SET disconnected_at = NOW() WHERE id = $1", defaultOtherPlayerID)
+		require.NoError(t, err)
+
+		rematch, err := srv.Rematch(ctx, defaultHostPlayerID)
+		assert.NoError(t, err)
+		assert.Equal(t, lobby.Code, rematch.Code)
+		assert.Len(t, rematch.Players, 2)
+	})
+}
+
 // Cross-service error propagation and consistency tests
 func TestIntegrationCrossServiceErrorPropagation(t *testing.T) {
 	t.Parallel()
@@ -595,7 +627,7 @@ func TestIntegrationCrossServiceErrorPropagation(t *testing.T) {
 		baseDelay := (time.Millisecond * 100)
 		str := db.NewDB(pool, 3, baseDelay)
 		randomizer := randomizer.NewUserRandomizer()
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		playerService := service.NewPlayerService(str, randomizer)
 		roundService := service.NewRoundService(str, randomizer, "en-GB")
 
@@ -651,7 +683,7 @@ func TestIntegrationCrossServiceErrorPropagation(t *testing.T) {
 		baseDelay := (time.Millisecond * 100)
 		str := db.NewDB(pool, 3, baseDelay)
 		randomizer := randomizer.NewUserRandomizer()
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		playerService := service.NewPlayerService(str, randomizer)
 
 		ctx, err := getI18nCtx(t.Context())
@@ -699,7 +731,7 @@ func TestIntegrationCrossServiceDatabaseResilience(t *testing.T) {
 		baseDelay := (time.Millisecond * 1)
 		str := db.NewDB(pool, 1, baseDelay) // Only 1 retry, 1ms delay
 		randomizer := randomizer.NewUserRandomizer()
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		playerService := service.NewPlayerService(str, randomizer)
 
 		ctx, err := getI18nCtx(t.Context())
@@ -728,7 +760,7 @@ func TestIntegrationCrossServiceDatabaseResilience(t *testing.T) {
 
 		// Verify system remains stable with normal context
 		normalStr := db.NewDB(pool, 3, time.Millisecond*100)
-		normalLobbyService := service.NewLobbyService(normalStr, randomizer, "en-GB")
+		normalLobbyService := service.NewLobbyService(normalStr, randomizer, "en-GB", defaultRoomSettings)
 
 		newHostID := uuid.Must(uuid.NewV4())
 		newNormalPlayer := service.NewHostPlayer{
@@ -753,7 +785,7 @@ func TestIntegrationLobbyConstraintViolations(t *testing.T) {
 		baseDelay := (time.Millisecond * 100)
 		str := db.NewDB(pool, 3, baseDelay)
 		randomizer := randomizer.NewUserRandomizer()
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
@@ -774,7 +806,7 @@ func TestIntegrationLobbyConstraintViolations(t *testing.T) {
 		baseDelay := (time.Millisecond * 100)
 		str := db.NewDB(pool, 3, baseDelay)
 		randomizer := randomizer.NewUserRandomizer()
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
@@ -808,7 +840,7 @@ func TestIntegrationLobbyConstraintViolations(t *testing.T) {
 		baseDelay := (time.Millisecond * 100)
 		str := db.NewDB(pool, 3, baseDelay)
 		randomizer := randomizer.NewUserRandomizer()
-		srv := service.NewLobbyService(str, randomizer, "en-GB")
+		srv := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
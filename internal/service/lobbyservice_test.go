@@ -8,6 +8,7 @@ import (
 	"errors"
 	"github.com/gofrs/uuid/v5"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,21 @@ var (
 		ID:       defaultHostPlayerID,
 		Nickname: "Host Player",
 	}
+
+	defaultRoomSettings = service.RoomSettings{
+		MaxRounds:  3,
+		RoundTypes: service.RoundTypes(),
+		Timings:    defaultTimings,
+	}
+
+	defaultTimings = service.Timings{
+		ShowQuestionScreenFor: 15 * time.Second,
+		ShowVotingScreenFor:   60 * time.Second,
+		ShowRevealScreenFor:   15 * time.Second,
+		ShowRevoteScreenFor:   20 * time.Second,
+		ShowScoreScreenFor:    15 * time.Second,
+		ShowWinnerScreenFor:   15 * time.Second,
+	}
 )
 
 var hostPlayer = service.NewHostPlayer{
@@ -60,7 +76,7 @@ func TestLobbyServiceCreate(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
@@ -98,7 +114,7 @@ func TestLobbyServiceCreate(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		newHostPlayer := service.NewHostPlayer{
 			Nickname: "MyNickname",
@@ -147,7 +163,7 @@ func TestLobbyServiceCreate(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
@@ -192,7 +208,7 @@ func TestLobbyServiceCreate(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
@@ -243,7 +259,7 @@ func TestLobbyServiceJoin(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
@@ -330,7 +346,7 @@ func TestLobbyServiceJoin(t *testing.T) {
 
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
@@ -398,7 +414,7 @@ func TestLobbyServiceJoin(t *testing.T) {
 
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{RoomCode: roomCode}, nil).Once()
@@ -411,7 +427,7 @@ func TestLobbyServiceJoin(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		generatedPlayerID := uuid.Must(uuid.NewV4())
@@ -440,7 +456,7 @@ func TestLobbyServiceJoin(t *testing.T) {
 
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		generatedPlayerID := uuid.Must(uuid.NewV4())
@@ -466,7 +482,7 @@ func TestLobbyServiceJoin(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
@@ -495,7 +511,7 @@ func TestLobbyServiceJoin(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
@@ -534,7 +550,7 @@ func TestLobbyServiceKickPlayer(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -576,7 +592,7 @@ func TestLobbyServiceKickPlayer(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{}, errors.New("failed to get room by code"))
@@ -588,7 +604,7 @@ func TestLobbyServiceKickPlayer(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -602,7 +618,7 @@ func TestLobbyServiceKickPlayer(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -616,7 +632,7 @@ func TestLobbyServiceKickPlayer(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -634,7 +650,7 @@ func TestLobbyServiceKickPlayer(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -656,7 +672,7 @@ func TestLobbyServiceKickPlayer(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -683,6 +699,349 @@ func TestLobbyServiceKickPlayer(t *testing.T) {
 	})
 }
 
+func TestLobbyServiceTransferHost(t *testing.T) {
+	t.Parallel()
+
+	newHostID := uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64ca"))
+	playersInRoom := []db.GetAllPlayersInRoomRow{
+		{ID: hostPlayerID, Nickname: "EmotionalTiger", HostPlayer: hostPlayerID},
+		{ID: newHostID, Nickname: "Hello", HostPlayer: hostPlayerID},
+	}
+
+	t.Run("Should transfer host to another player during a game", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByCode(ctx, roomCode).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Playing.String()}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return(playersInRoom, nil)
+		mockStore.EXPECT().ReassignHostPlayer(ctx, db.ReassignHostPlayerParams{
+			ID:         roomID,
+			HostPlayer: newHostID,
+		}).Return(db.Room{}, nil)
+
+		newHost, err := srv.TransferHost(ctx, roomCode, hostPlayerID, "Hello")
+		assert.NoError(t, err)
+		assert.Equal(t, newHostID, newHost)
+	})
+
+	t.Run("Should fail to transfer host because player is not host", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByCode(ctx, roomCode).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Playing.String()}, nil)
+
+		_, err := srv.TransferHost(ctx, roomCode, newHostID, "EmotionalTiger")
+		assert.ErrorIs(t, err, service.ErrNotHost)
+	})
+
+	t.Run("Should fail to transfer host because game has finished", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByCode(ctx, roomCode).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Finished.String()}, nil)
+
+		_, err := srv.TransferHost(ctx, roomCode, hostPlayerID, "Hello")
+		assert.ErrorIs(t, err, service.ErrRoomNotActive)
+	})
+
+	t.Run("Should fail to transfer host because new host is inactive", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByCode(ctx, roomCode).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Playing.String()}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{ID: hostPlayerID, Nickname: "EmotionalTiger", HostPlayer: hostPlayerID},
+			{
+				ID:         newHostID,
+				Nickname:   "Hello",
+				HostPlayer: hostPlayerID,
+				InactiveAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+			},
+		}, nil)
+
+		_, err := srv.TransferHost(ctx, roomCode, hostPlayerID, "Hello")
+		assert.ErrorIs(t, err, service.ErrNewHostInactive)
+	})
+
+	t.Run("Should fail to transfer host because new host is not in the room", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByCode(ctx, roomCode).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Created.String()}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return(playersInRoom, nil)
+
+		_, err := srv.TransferHost(ctx, roomCode, hostPlayerID, "Nobody")
+		assert.ErrorIs(t, err, service.ErrNewHostNotFound)
+	})
+}
+
+func TestLobbyServiceMigrateHost(t *testing.T) {
+	t.Parallel()
+
+	connectedPlayerID := uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64ca"))
+	disconnectedPlayerID := uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64cb"))
+
+	t.Run("Should hand host over to a connected player", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, hostPlayerID).Return(db.Room{
+			ID:         roomID,
+			HostPlayer: hostPlayerID,
+			RoomState:  db.Playing.String(),
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{ID: hostPlayerID, InactiveAt: pgtype.Timestamp{Time: time.Now(), Valid: true}},
+			{ID: disconnectedPlayerID, DisconnectedAt: pgtype.Timestamp{Time: time.Now(), Valid: true}},
+			{ID: connectedPlayerID},
+		}, nil)
+		mockStore.EXPECT().ReassignHostPlayer(ctx, db.ReassignHostPlayerParams{
+			ID:         roomID,
+			HostPlayer: connectedPlayerID,
+		}).Return(db.Room{}, nil)
+
+		newHost, err := srv.MigrateHost(ctx, hostPlayerID)
+		assert.NoError(t, err)
+		assert.Equal(t, connectedPlayerID, newHost)
+	})
+
+	t.Run("Should not change host when player is not the host", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, connectedPlayerID).Return(db.Room{
+			ID:         roomID,
+			HostPlayer: hostPlayerID,
+			RoomState:  db.Playing.String(),
+		}, nil)
+
+		newHost, err := srv.MigrateHost(ctx, connectedPlayerID)
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil, newHost)
+	})
+
+	t.Run("Should not change host when nobody else is active", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, hostPlayerID).Return(db.Room{
+			ID:         roomID,
+			HostPlayer: hostPlayerID,
+			RoomState:  db.Paused.String(),
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{ID: hostPlayerID},
+			{ID: connectedPlayerID, InactiveAt: pgtype.Timestamp{Time: time.Now(), Valid: true}},
+		}, nil)
+
+		newHost, err := srv.MigrateHost(ctx, hostPlayerID)
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.Nil, newHost)
+	})
+
+	t.Run("Should fail to hand host over because DB call fails", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, hostPlayerID).Return(db.Room{
+			ID:         roomID,
+			HostPlayer: hostPlayerID,
+			RoomState:  db.Playing.String(),
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{ID: hostPlayerID},
+			{ID: connectedPlayerID},
+		}, nil)
+		mockStore.EXPECT().ReassignHostPlayer(ctx, db.ReassignHostPlayerParams{
+			ID:         roomID,
+			HostPlayer: connectedPlayerID,
+		}).Return(db.Room{}, errors.New("failed to reassign host"))
+
+		_, err := srv.MigrateHost(ctx, hostPlayerID)
+		assert.Error(t, err)
+	})
+}
+
+func TestLobbyServiceRematch(t *testing.T) {
+	t.Parallel()
+
+	newRoomID := uuid.Must(uuid.FromString("0193a62a-6e58-7a52-9b1c-5e1a0c3f2d41"))
+	playersInRoom := []db.GetAllPlayersInRoomRow{
+		{
+			ID:         hostPlayerID,
+			Nickname:   "EmotionalTiger",
+			RoomCode:   roomCode,
+			HostPlayer: hostPlayerID,
+			IsReady:    pgtype.Bool{Bool: false, Valid: true},
+		},
+	}
+	expectedLobby := service.Lobby{
+		Code: roomCode,
+		Players: []service.LobbyPlayer{
+			{
+				ID:       hostPlayerID,
+				Nickname: "EmotionalTiger",
+				IsHost:   true,
+			},
+		},
+	}
+
+	t.Run("Should start a rematch once the game has finished", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByPlayerID(ctx, hostPlayerID).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Finished.String()}, nil)
+		mockRandom.EXPECT().GetID().Return(newRoomID, nil)
+		mockStore.EXPECT().Rematch(ctx, db.RematchArgs{
+			RoomID:    roomID,
+			NewRoomID: newRoomID,
+			PlayerID:  hostPlayerID,
+		}).Return(nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return(playersInRoom, nil)
+
+		lobby, err := srv.Rematch(ctx, hostPlayerID)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedLobby, lobby)
+	})
+
+	t.Run("Should start a rematch from the winner screen", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByPlayerID(ctx, hostPlayerID).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Playing.String()}, nil)
+		mockStore.EXPECT().
+			GetGameStateByPlayerID(ctx, hostPlayerID).
+			Return(db.GameState{State: db.FibbingItWinner.String()}, nil)
+		mockRandom.EXPECT().GetID().Return(newRoomID, nil)
+		mockStore.EXPECT().Rematch(ctx, db.RematchArgs{
+			RoomID:    roomID,
+			NewRoomID: newRoomID,
+			PlayerID:  hostPlayerID,
+		}).Return(nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return(playersInRoom, nil)
+
+		lobby, err := srv.Rematch(ctx, hostPlayerID)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedLobby, lobby)
+	})
+
+	t.Run("Should fail to start a rematch because player is not host", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByPlayerID(ctx, playerID).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Finished.String()}, nil)
+
+		_, err := srv.Rematch(ctx, playerID)
+		assert.ErrorIs(t, err, service.ErrNotHost)
+	})
+
+	t.Run("Should fail to start a rematch because game is still being played", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByPlayerID(ctx, hostPlayerID).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Playing.String()}, nil)
+		mockStore.EXPECT().
+			GetGameStateByPlayerID(ctx, hostPlayerID).
+			Return(db.GameState{State: db.FibbingItVoting.String()}, nil)
+
+		_, err := srv.Rematch(ctx, hostPlayerID)
+		assert.ErrorIs(t, err, service.ErrGameNotOver)
+	})
+
+	t.Run("Should fail to start a rematch because room is still in the lobby", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByPlayerID(ctx, hostPlayerID).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Created.String()}, nil)
+
+		_, err := srv.Rematch(ctx, hostPlayerID)
+		assert.ErrorIs(t, err, service.ErrGameNotOver)
+	})
+
+	t.Run("Should fail to start a rematch because DB transaction fails", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByPlayerID(ctx, hostPlayerID).
+			Return(db.Room{ID: roomID, HostPlayer: hostPlayerID, RoomState: db.Finished.String()}, nil)
+		mockRandom.EXPECT().GetID().Return(newRoomID, nil)
+		mockStore.EXPECT().Rematch(ctx, db.RematchArgs{
+			RoomID:    roomID,
+			NewRoomID: newRoomID,
+			PlayerID:  hostPlayerID,
+		}).Return(errors.New("rematch has already started"))
+
+		_, err := srv.Rematch(ctx, hostPlayerID)
+		assert.Error(t, err)
+	})
+}
+
 func TestLobbyServiceStart(t *testing.T) {
 	t.Parallel()
 
@@ -699,7 +1058,7 @@ func TestLobbyServiceStart(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -728,9 +1087,24 @@ func TestLobbyServiceStart(t *testing.T) {
 			},
 		}, nil)
 
+		mockStore.EXPECT().GetRoomSettings(ctx, roomID).Return(db.RoomSetting{
+			RoomID:          roomID,
+			MaxRounds:       5,
+			RoundTypes:      []string{"free_form", "free_form"},
+			QuestionSeconds: 30,
+			VotingSeconds:   90,
+			RevealSeconds:   10,
+			ScoreSeconds:    20,
+		}, nil)
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return(db.QuestionPair{}, pgx.ErrNoRows)
 		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-			GameName:  gameName,
-			RoundType: "free_form",
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
 		}).Return([]db.GetRandomQuestionByRoundRow{
 			{
 				QuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
@@ -740,17 +1114,18 @@ func TestLobbyServiceStart(t *testing.T) {
 			},
 		}, nil)
 		mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
-			GroupType:          "",
-			GroupID:            groupID,
-			ExcludedQuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
-			RoundType:          "free_form",
+			GroupType:           "",
+			GroupID:             groupID,
+			ExcludedQuestionID:  uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+			ExcludedQuestionIds: []uuid.UUID{},
+			RoundType:           "free_form",
 		}).Return([]db.GetRandomQuestionInGroupRow{
 			{
 				QuestionID: uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
 				Question:   "What is the capital of Germany?",
 			},
 		}, nil)
-		mockRandom.EXPECT().GetFibberIndex(2).Return(1)
+		mockRandom.EXPECT().GetFibberIndexes(2, 1).Return([]int{1})
 		mockRandom.EXPECT().GetID().Return(gameStateID, nil)
 		deadline := time.Now().Add(5 * time.Second)
 		mockStore.EXPECT().StartGame(ctx, db.StartGameArgs{
@@ -774,8 +1149,19 @@ func TestLobbyServiceStart(t *testing.T) {
 					RoomCode:   roomCode,
 				},
 			},
-			FibberLoc: 1,
-			Deadline:  deadline,
+			FibberLocs:      []int{1},
+			MaxRounds:       5,
+			RoundTypes:      []string{"free_form", "free_form"},
+			QuestionSeconds: 30,
+			VotingSeconds:   90,
+			RevealSeconds:   10,
+			ScoreSeconds:    20,
+			Scorers:         []string{},
+			RevealRule:      "unanimous",
+			TieBreak:        "none",
+			FibberSelection: "fair",
+			FibberRotation:  "round_type",
+			Deadline:        deadline,
 		}).Return(nil)
 
 		gameState, err := srv.Start(ctx, roomCode, hostPlayerID, deadline)
@@ -783,14 +1169,16 @@ func TestLobbyServiceStart(t *testing.T) {
 			GameStateID: gameStateID,
 			Players: []service.PlayerWithRole{
 				{
-					ID:       uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64ca")),
-					Role:     "normal",
-					Question: "What is the capital of France?",
+					ID:              uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64ca")),
+					Role:            "normal",
+					Question:        "What is the capital of France?",
+					PossibleAnswers: []string{},
 				},
 				{
-					ID:       hostPlayerID,
-					Role:     "fibber",
-					Question: "What is the capital of Germany?",
+					ID:              hostPlayerID,
+					Role:            "fibber",
+					Question:        "What is the capital of Germany?",
+					PossibleAnswers: []string{},
 				},
 			},
 			Round:     1,
@@ -804,39 +1192,489 @@ func TestLobbyServiceStart(t *testing.T) {
 		assert.LessOrEqual(t, int(gameState.Deadline.Seconds()), 5)
 	})
 
-	t.Run("Should fail to start game because host did not start game", func(t *testing.T) {
+	t.Run("Should start game without repeating questions from the players' recent games", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
+		previousGameStateID := uuid.Must(uuid.FromString("0193a629-373b-7a3e-b6c2-0e7d2f95ce44"))
+		usedNormalQuestionID := uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae8"))
+		usedFibberQuestionID := uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e10"))
 		mockStore.EXPECT().
 			GetRoomByCode(ctx, roomCode).
 			Return(
-				db.Room{ID: roomID,
+				db.Room{
+					ID:         roomID,
 					GameName:   gameName,
 					HostPlayer: hostPlayerID,
 					RoomState:  db.Created.String(),
 				}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{
+				ID:         defaultNewPlayer.ID,
+				Nickname:   "Hello",
+				HostPlayer: hostPlayerID,
+				IsReady:    pgtype.Bool{Bool: true, Valid: true},
+				RoomCode:   roomCode,
+			},
+			{
+				ID:         hostPlayerID,
+				Nickname:   "EmotionalTiger",
+				HostPlayer: hostPlayerID,
+				IsReady:    pgtype.Bool{Bool: true, Valid: true},
+				RoomCode:   roomCode,
+			},
+		}, nil)
 
+		mockStore.EXPECT().GetRoomSettings(ctx, roomID).Return(db.RoomSetting{
+			RoomID:          roomID,
+			MaxRounds:       5,
+			RoundTypes:      []string{"free_form", "free_form"},
+			QuestionSeconds: 30,
+			VotingSeconds:   90,
+			RevealSeconds:   10,
+			ScoreSeconds:    20,
+			RecentGames:     3,
+		}, nil)
+		mockStore.EXPECT().GetUsedQuestionIDs(ctx, db.GetUsedQuestionIDsParams{
+			GameStateID: uuid.Nil,
+			PlayerIds:   []uuid.UUID{defaultNewPlayer.ID, hostPlayerID},
+			RecentGames: 3,
+		}).Return([]db.GetUsedQuestionIDsRow{
+			{
+				GameStateID:      previousGameStateID,
+				NormalQuestionID: usedNormalQuestionID,
+				FibberQuestionID: usedFibberQuestionID,
+			},
+		}, nil)
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{usedNormalQuestionID, usedFibberQuestionID},
+		}).Return(db.QuestionPair{}, pgx.ErrNoRows)
+		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{usedNormalQuestionID, usedFibberQuestionID},
+		}).Return([]db.GetRandomQuestionByRoundRow{
+			{
+				QuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+				Question:   "What is the capital of France?",
+				Locale:     "en-GB",
+				GroupID:    groupID,
+			},
+		}, nil)
+		mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
+			GroupType:           "",
+			GroupID:             groupID,
+			ExcludedQuestionID:  uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+			ExcludedQuestionIds: []uuid.UUID{usedNormalQuestionID, usedFibberQuestionID},
+			RoundType:           "free_form",
+		}).Return([]db.GetRandomQuestionInGroupRow{
+			{
+				QuestionID: uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
+				Question:   "What is the capital of Germany?",
+			},
+		}, nil)
+		mockRandom.EXPECT().GetFibberIndexes(2, 1).Return([]int{1})
+		mockRandom.EXPECT().GetID().Return(gameStateID, nil)
 		deadline := time.Now().Add(5 * time.Second)
-		_, err := srv.Start(ctx, roomCode, defaultNewPlayer.ID, deadline)
-		assert.ErrorContains(t, err, "")
-	})
-
-	t.Run("Should fail to start game because room state not in CREATED state", func(t *testing.T) {
-		t.Parallel()
-		mockStore := mockService.NewMockLobbyStore(t)
-		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
-
-		ctx := t.Context()
-		mockStore.EXPECT().
-			GetRoomByCode(ctx, roomCode).
-			Return(
-				db.Room{ID: roomID,
-					GameName:   gameName,
+		mockStore.EXPECT().StartGame(ctx, db.StartGameArgs{
+			GameStateID:       gameStateID,
+			RoomID:            roomID,
+			NormalsQuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+			FibberQuestionID:  uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
+			Players: []db.GetAllPlayersInRoomRow{
+				{
+					ID:         uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64ca")),
+					Nickname:   "Hello",
+					HostPlayer: hostPlayerID,
+					IsReady:    pgtype.Bool{Bool: true, Valid: true},
+					RoomCode:   roomCode,
+				},
+				{
+					ID:         hostPlayerID,
+					Nickname:   "EmotionalTiger",
+					HostPlayer: hostPlayerID,
+					IsReady:    pgtype.Bool{Bool: true, Valid: true},
+					RoomCode:   roomCode,
+				},
+			},
+			FibberLocs:      []int{1},
+			MaxRounds:       5,
+			RoundTypes:      []string{"free_form", "free_form"},
+			QuestionSeconds: 30,
+			VotingSeconds:   90,
+			RevealSeconds:   10,
+			ScoreSeconds:    20,
+			Scorers:         []string{},
+			RevealRule:      "unanimous",
+			TieBreak:        "none",
+			FibberSelection: "fair",
+			FibberRotation:  "round_type",
+			RecentGames:     3,
+			Deadline:        deadline,
+		}).Return(nil)
+
+		gameState, err := srv.Start(ctx, roomCode, hostPlayerID, deadline)
+		expectedGameState := service.QuestionState{
+			GameStateID: gameStateID,
+			Players: []service.PlayerWithRole{
+				{
+					ID:              uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64ca")),
+					Role:            "normal",
+					Question:        "What is the capital of France?",
+					PossibleAnswers: []string{},
+				},
+				{
+					ID:              hostPlayerID,
+					Role:            "fibber",
+					Question:        "What is the capital of Germany?",
+					PossibleAnswers: []string{},
+				},
+			},
+			Round:     1,
+			RoundType: "free_form",
+		}
+
+		assert.NoError(t, err)
+
+		diffOpts := cmpopts.IgnoreFields(gameState, "Deadline")
+		PartialEqual(t, expectedGameState, gameState, diffOpts)
+		assert.LessOrEqual(t, int(gameState.Deadline.Seconds()), 5)
+	})
+
+	t.Run("Should start game successfully with a curated question pair", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByCode(ctx, roomCode).
+			Return(
+				db.Room{
+					ID:         roomID,
+					GameName:   gameName,
+					HostPlayer: hostPlayerID,
+					RoomState:  db.Created.String(),
+				}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{
+				ID:         defaultNewPlayer.ID,
+				Nickname:   "Hello",
+				HostPlayer: hostPlayerID,
+				IsReady:    pgtype.Bool{Bool: true, Valid: true},
+				RoomCode:   roomCode,
+			},
+			{
+				ID:         hostPlayerID,
+				Nickname:   "EmotionalTiger",
+				HostPlayer: hostPlayerID,
+				IsReady:    pgtype.Bool{Bool: true, Valid: true},
+				RoomCode:   roomCode,
+			},
+		}, nil)
+
+		mockStore.EXPECT().GetRoomSettings(ctx, roomID).Return(db.RoomSetting{
+			RoomID:          roomID,
+			MaxRounds:       5,
+			RoundTypes:      []string{"free_form", "free_form"},
+			QuestionSeconds: 30,
+			VotingSeconds:   90,
+			RevealSeconds:   10,
+			ScoreSeconds:    20,
+		}, nil)
+		pairID := uuid.Must(uuid.NewV7())
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return(db.QuestionPair{
+			ID:               pairID,
+			NormalQuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+			FibberQuestionID: uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
+		}, nil)
+		mockStore.EXPECT().
+			GetQuestionWithLocalesById(ctx, uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7"))).
+			Return([]db.GetQuestionWithLocalesByIdRow{
+				{
+					QuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+					Question:   "What is the capital of France?",
+					Locale:     "en-GB",
+					GroupID:    groupID,
+				},
+			}, nil)
+		mockStore.EXPECT().
+			GetQuestionWithLocalesById(ctx, uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f"))).
+			Return([]db.GetQuestionWithLocalesByIdRow{
+				{
+					QuestionID: uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
+					Question:   "What is the capital of Germany?",
+					Locale:     "en-GB",
+				},
+			}, nil)
+		mockRandom.EXPECT().GetFibberIndexes(2, 1).Return([]int{1})
+		mockRandom.EXPECT().GetID().Return(gameStateID, nil)
+		deadline := time.Now().Add(5 * time.Second)
+		mockStore.EXPECT().StartGame(ctx, db.StartGameArgs{
+			GameStateID:       gameStateID,
+			RoomID:            roomID,
+			NormalsQuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+			FibberQuestionID:  uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
+			Players: []db.GetAllPlayersInRoomRow{
+				{
+					ID:         uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64ca")),
+					Nickname:   "Hello",
+					HostPlayer: hostPlayerID,
+					IsReady:    pgtype.Bool{Bool: true, Valid: true},
+					RoomCode:   roomCode,
+				},
+				{
+					ID:         hostPlayerID,
+					Nickname:   "EmotionalTiger",
+					HostPlayer: hostPlayerID,
+					IsReady:    pgtype.Bool{Bool: true, Valid: true},
+					RoomCode:   roomCode,
+				},
+			},
+			FibberLocs:      []int{1},
+			MaxRounds:       5,
+			RoundTypes:      []string{"free_form", "free_form"},
+			QuestionSeconds: 30,
+			VotingSeconds:   90,
+			RevealSeconds:   10,
+			ScoreSeconds:    20,
+			Scorers:         []string{},
+			RevealRule:      "unanimous",
+			TieBreak:        "none",
+			FibberSelection: "fair",
+			FibberRotation:  "round_type",
+			Deadline:        deadline,
+		}).Return(nil)
+
+		gameState, err := srv.Start(ctx, roomCode, hostPlayerID, deadline)
+		expectedGameState := service.QuestionState{
+			GameStateID: gameStateID,
+			Players: []service.PlayerWithRole{
+				{
+					ID:              uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64ca")),
+					Role:            "normal",
+					Question:        "What is the capital of France?",
+					PossibleAnswers: []string{},
+				},
+				{
+					ID:              hostPlayerID,
+					Role:            "fibber",
+					Question:        "What is the capital of Germany?",
+					PossibleAnswers: []string{},
+				},
+			},
+			Round:     1,
+			RoundType: "free_form",
+		}
+
+		assert.NoError(t, err)
+
+		diffOpts := cmpopts.IgnoreFields(gameState, "Deadline")
+		PartialEqual(t, expectedGameState, gameState, diffOpts)
+		assert.LessOrEqual(t, int(gameState.Deadline.Seconds()), 5)
+	})
+
+	t.Run("Should start game successfully, picking another normal question when it has no fibber question", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByCode(ctx, roomCode).
+			Return(
+				db.Room{
+					ID:         roomID,
+					GameName:   gameName,
+					HostPlayer: hostPlayerID,
+					RoomState:  db.Created.String(),
+				}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, hostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{
+				ID:         defaultNewPlayer.ID,
+				Nickname:   "Hello",
+				HostPlayer: hostPlayerID,
+				IsReady:    pgtype.Bool{Bool: true, Valid: true},
+				RoomCode:   roomCode,
+			},
+			{
+				ID:         hostPlayerID,
+				Nickname:   "EmotionalTiger",
+				HostPlayer: hostPlayerID,
+				IsReady:    pgtype.Bool{Bool: true, Valid: true},
+				RoomCode:   roomCode,
+			},
+		}, nil)
+
+		mockStore.EXPECT().GetRoomSettings(ctx, roomID).Return(db.RoomSetting{
+			RoomID:          roomID,
+			MaxRounds:       5,
+			RoundTypes:      []string{"free_form", "free_form"},
+			QuestionSeconds: 30,
+			VotingSeconds:   90,
+			RevealSeconds:   10,
+			ScoreSeconds:    20,
+		}, nil)
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return(db.QuestionPair{}, pgx.ErrNoRows)
+		aloneQuestionID := uuid.Must(uuid.NewV7())
+		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return([]db.GetRandomQuestionByRoundRow{
+			{
+				QuestionID: aloneQuestionID,
+				Question:   "What is the capital of Spain?",
+				Locale:     "en-GB",
+				GroupID:    groupID,
+			},
+		}, nil).Once()
+		mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
+			GroupType:           "",
+			GroupID:             groupID,
+			ExcludedQuestionID:  aloneQuestionID,
+			ExcludedQuestionIds: []uuid.UUID{},
+			RoundType:           "free_form",
+		}).Return([]db.GetRandomQuestionInGroupRow{}, nil)
+		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return([]db.GetRandomQuestionByRoundRow{
+			{
+				QuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+				Question:   "What is the capital of France?",
+				Locale:     "en-GB",
+				GroupID:    groupID,
+			},
+		}, nil)
+		mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
+			GroupType:           "",
+			GroupID:             groupID,
+			ExcludedQuestionID:  uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+			ExcludedQuestionIds: []uuid.UUID{},
+			RoundType:           "free_form",
+		}).Return([]db.GetRandomQuestionInGroupRow{
+			{
+				QuestionID: uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
+				Question:   "What is the capital of Germany?",
+			},
+		}, nil)
+		mockRandom.EXPECT().GetFibberIndexes(2, 1).Return([]int{1})
+		mockRandom.EXPECT().GetID().Return(gameStateID, nil)
+		deadline := time.Now().Add(5 * time.Second)
+		mockStore.EXPECT().StartGame(ctx, db.StartGameArgs{
+			GameStateID:       gameStateID,
+			RoomID:            roomID,
+			NormalsQuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+			FibberQuestionID:  uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
+			Players: []db.GetAllPlayersInRoomRow{
+				{
+					ID:         uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64ca")),
+					Nickname:   "Hello",
+					HostPlayer: hostPlayerID,
+					IsReady:    pgtype.Bool{Bool: true, Valid: true},
+					RoomCode:   roomCode,
+				},
+				{
+					ID:         hostPlayerID,
+					Nickname:   "EmotionalTiger",
+					HostPlayer: hostPlayerID,
+					IsReady:    pgtype.Bool{Bool: true, Valid: true},
+					RoomCode:   roomCode,
+				},
+			},
+			FibberLocs:      []int{1},
+			MaxRounds:       5,
+			RoundTypes:      []string{"free_form", "free_form"},
+			QuestionSeconds: 30,
+			VotingSeconds:   90,
+			RevealSeconds:   10,
+			ScoreSeconds:    20,
+			Scorers:         []string{},
+			RevealRule:      "unanimous",
+			TieBreak:        "none",
+			FibberSelection: "fair",
+			FibberRotation:  "round_type",
+			Deadline:        deadline,
+		}).Return(nil)
+
+		gameState, err := srv.Start(ctx, roomCode, hostPlayerID, deadline)
+		expectedGameState := service.QuestionState{
+			GameStateID: gameStateID,
+			Players: []service.PlayerWithRole{
+				{
+					ID:              uuid.Must(uuid.FromString("0193a626-2586-7784-9b5b-104d927d64ca")),
+					Role:            "normal",
+					Question:        "What is the capital of France?",
+					PossibleAnswers: []string{},
+				},
+				{
+					ID:              hostPlayerID,
+					Role:            "fibber",
+					Question:        "What is the capital of Germany?",
+					PossibleAnswers: []string{},
+				},
+			},
+			Round:     1,
+			RoundType: "free_form",
+		}
+
+		assert.NoError(t, err)
+
+		diffOpts := cmpopts.IgnoreFields(gameState, "Deadline")
+		PartialEqual(t, expectedGameState, gameState, diffOpts)
+		assert.LessOrEqual(t, int(gameState.Deadline.Seconds()), 5)
+	})
+
+	t.Run("Should fail to start game because host did not start game", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByCode(ctx, roomCode).
+			Return(
+				db.Room{ID: roomID,
+					GameName:   gameName,
+					HostPlayer: hostPlayerID,
+					RoomState:  db.Created.String(),
+				}, nil)
+
+		deadline := time.Now().Add(5 * time.Second)
+		_, err := srv.Start(ctx, roomCode, defaultNewPlayer.ID, deadline)
+		assert.ErrorContains(t, err, "")
+	})
+
+	t.Run("Should fail to start game because room state not in CREATED state", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().
+			GetRoomByCode(ctx, roomCode).
+			Return(
+				db.Room{ID: roomID,
+					GameName:   gameName,
 					HostPlayer: hostPlayerID,
 					RoomState:  db.Playing.String(),
 				}, nil)
@@ -850,7 +1688,7 @@ func TestLobbyServiceStart(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -874,7 +1712,7 @@ func TestLobbyServiceStart(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -904,7 +1742,7 @@ func TestLobbyServiceStart(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -942,7 +1780,7 @@ func TestLobbyServiceStart(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -970,9 +1808,16 @@ func TestLobbyServiceStart(t *testing.T) {
 			},
 		}, nil)
 
+		mockStore.EXPECT().GetRoomSettings(ctx, roomID).Return(db.RoomSetting{}, sql.ErrNoRows)
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return(db.QuestionPair{}, pgx.ErrNoRows)
 		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-			GameName:  gameName,
-			RoundType: "free_form",
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
 		}).Return([]db.GetRandomQuestionByRoundRow{
 			{
 				QuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
@@ -991,7 +1836,7 @@ func TestLobbyServiceStart(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -1020,9 +1865,16 @@ func TestLobbyServiceStart(t *testing.T) {
 			},
 		}, nil)
 
+		mockStore.EXPECT().GetRoomSettings(ctx, roomID).Return(db.RoomSetting{}, sql.ErrNoRows)
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return(db.QuestionPair{}, pgx.ErrNoRows)
 		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-			GameName:  gameName,
-			RoundType: "free_form",
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
 		}).Return([]db.GetRandomQuestionByRoundRow{
 			{
 				QuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
@@ -1032,10 +1884,11 @@ func TestLobbyServiceStart(t *testing.T) {
 			},
 		}, nil)
 		mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
-			GroupType:          "",
-			GroupID:            groupID,
-			ExcludedQuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
-			RoundType:          "free_form",
+			GroupType:           "",
+			GroupID:             groupID,
+			ExcludedQuestionID:  uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+			ExcludedQuestionIds: []uuid.UUID{},
+			RoundType:           "free_form",
 		}).Return([]db.GetRandomQuestionInGroupRow{}, errors.New("failed to get random question for fibber"))
 
 		deadline := time.Now().Add(5 * time.Second)
@@ -1047,7 +1900,7 @@ func TestLobbyServiceStart(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().
@@ -1076,9 +1929,16 @@ func TestLobbyServiceStart(t *testing.T) {
 			},
 		}, nil)
 
+		mockStore.EXPECT().GetRoomSettings(ctx, roomID).Return(db.RoomSetting{}, sql.ErrNoRows)
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return(db.QuestionPair{}, pgx.ErrNoRows)
 		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-			GameName:  gameName,
-			RoundType: "free_form",
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
 		}).Return([]db.GetRandomQuestionByRoundRow{
 			{
 				QuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
@@ -1088,17 +1948,18 @@ func TestLobbyServiceStart(t *testing.T) {
 			},
 		}, nil)
 		mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
-			GroupType:          "",
-			GroupID:            groupID,
-			ExcludedQuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
-			RoundType:          "free_form",
+			GroupType:           "",
+			GroupID:             groupID,
+			ExcludedQuestionID:  uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+			ExcludedQuestionIds: []uuid.UUID{},
+			RoundType:           "free_form",
 		}).Return([]db.GetRandomQuestionInGroupRow{
 			{
 				QuestionID: uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
 				Question:   "What is the capital of Germany?",
 			},
 		}, nil)
-		mockRandom.EXPECT().GetFibberIndex(2).Return(1)
+		mockRandom.EXPECT().GetFibberIndexes(2, 1).Return([]int{1})
 		mockRandom.EXPECT().GetID().Return(gameStateID, nil)
 		deadline := time.Now().Add(5 * time.Second)
 		mockStore.EXPECT().StartGame(ctx, db.StartGameArgs{
@@ -1122,8 +1983,21 @@ func TestLobbyServiceStart(t *testing.T) {
 					RoomCode:   roomCode,
 				},
 			},
-			FibberLoc: 1,
-			Deadline:  deadline,
+			FibberLocs:      []int{1},
+			MaxRounds:       3,
+			RoundTypes:      []string{"free_form", "multiple_choice", "most_likely"},
+			QuestionSeconds: 15,
+			VotingSeconds:   60,
+			RevealSeconds:   15,
+			ScoreSeconds:    15,
+			RevoteSeconds:   20,
+			WinnerSeconds:   15,
+			Scorers:         []string{},
+			RevealRule:      "unanimous",
+			TieBreak:        "none",
+			FibberSelection: "fair",
+			FibberRotation:  "round_type",
+			Deadline:        deadline,
 		}).Return(errors.New("failed to start game"))
 
 		_, err := srv.Start(ctx, roomCode, hostPlayerID, deadline)
@@ -1131,6 +2005,57 @@ func TestLobbyServiceStart(t *testing.T) {
 	})
 }
 
+func TestLobbyServiceHandlePlayerDisconnect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should mark player as disconnected when game is being played", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
+			RoomState: db.Playing.String(),
+		}, nil)
+		mockStore.EXPECT().SetPlayerDisconnected(ctx, playerID).Return(nil)
+
+		err := srv.HandlePlayerDisconnect(ctx, playerID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should do nothing when game has finished", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
+			RoomState: db.Finished.String(),
+		}, nil)
+
+		err := srv.HandlePlayerDisconnect(ctx, playerID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should fail to mark player as disconnected because DB call fails", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
+			RoomState: db.Paused.String(),
+		}, nil)
+		mockStore.EXPECT().SetPlayerDisconnected(ctx, playerID).Return(errors.New("failed to update player"))
+
+		err := srv.HandlePlayerDisconnect(ctx, playerID)
+		assert.Error(t, err)
+	})
+}
+
 func TestLobbyServiceGetRoomState(t *testing.T) {
 	t.Parallel()
 
@@ -1171,7 +2096,7 @@ func TestLobbyServiceGetRoomState(t *testing.T) {
 			t.Parallel()
 			mockStore := mockService.NewMockLobbyStore(t)
 			mockRandom := mockService.NewMockRandomizer(t)
-			lobbyService := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+			lobbyService := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 			ctx := t.Context()
 			mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
@@ -1188,7 +2113,7 @@ func TestLobbyServiceGetRoomState(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(
@@ -1207,7 +2132,7 @@ func TestLobbyServiceGetLobby(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 
@@ -1241,7 +2166,7 @@ func TestLobbyServiceGetLobby(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockLobbyStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
-		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB")
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
 
 		ctx := t.Context()
 
@@ -164,6 +164,140 @@ func (_c *MockLobbyStore_GetAllPlayersInRoom_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// GetGameStateByPlayerID provides a mock function for the type MockLobbyStore
+func (_mock *MockLobbyStore) GetGameStateByPlayerID(ctx context.Context, playerID uuid.UUID) (db.GameState, error) {
+	ret := _mock.Called(ctx, playerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGameStateByPlayerID")
+	}
+
+	var r0 db.GameState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.GameState, error)); ok {
+		return returnFunc(ctx, playerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.GameState); ok {
+		r0 = returnFunc(ctx, playerID)
+	} else {
+		r0 = ret.Get(0).(db.GameState)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, playerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyStore_GetGameStateByPlayerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGameStateByPlayerID'
+type MockLobbyStore_GetGameStateByPlayerID_Call struct {
+	*mock.Call
+}
+
+// GetGameStateByPlayerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - playerID uuid.UUID
+func (_e *MockLobbyStore_Expecter) GetGameStateByPlayerID(ctx interface{}, playerID interface{}) *MockLobbyStore_GetGameStateByPlayerID_Call {
+	return &MockLobbyStore_GetGameStateByPlayerID_Call{Call: _e.mock.On("GetGameStateByPlayerID", ctx, playerID)}
+}
+
+func (_c *MockLobbyStore_GetGameStateByPlayerID_Call) Run(run func(ctx context.Context, playerID uuid.UUID)) *MockLobbyStore_GetGameStateByPlayerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyStore_GetGameStateByPlayerID_Call) Return(gameState db.GameState, err error) *MockLobbyStore_GetGameStateByPlayerID_Call {
+	_c.Call.Return(gameState, err)
+	return _c
+}
+
+func (_c *MockLobbyStore_GetGameStateByPlayerID_Call) RunAndReturn(run func(ctx context.Context, playerID uuid.UUID) (db.GameState, error)) *MockLobbyStore_GetGameStateByPlayerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuestionWithLocalesById provides a mock function for the type MockLobbyStore
+func (_mock *MockLobbyStore) GetQuestionWithLocalesById(ctx context.Context, id uuid.UUID) ([]db.GetQuestionWithLocalesByIdRow, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestionWithLocalesById")
+	}
+
+	var r0 []db.GetQuestionWithLocalesByIdRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.GetQuestionWithLocalesByIdRow, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.GetQuestionWithLocalesByIdRow); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetQuestionWithLocalesByIdRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyStore_GetQuestionWithLocalesById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionWithLocalesById'
+type MockLobbyStore_GetQuestionWithLocalesById_Call struct {
+	*mock.Call
+}
+
+// GetQuestionWithLocalesById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockLobbyStore_Expecter) GetQuestionWithLocalesById(ctx interface{}, id interface{}) *MockLobbyStore_GetQuestionWithLocalesById_Call {
+	return &MockLobbyStore_GetQuestionWithLocalesById_Call{Call: _e.mock.On("GetQuestionWithLocalesById", ctx, id)}
+}
+
+func (_c *MockLobbyStore_GetQuestionWithLocalesById_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockLobbyStore_GetQuestionWithLocalesById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyStore_GetQuestionWithLocalesById_Call) Return(getQuestionWithLocalesByIdRows []db.GetQuestionWithLocalesByIdRow, err error) *MockLobbyStore_GetQuestionWithLocalesById_Call {
+	_c.Call.Return(getQuestionWithLocalesByIdRows, err)
+	return _c
+}
+
+func (_c *MockLobbyStore_GetQuestionWithLocalesById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) ([]db.GetQuestionWithLocalesByIdRow, error)) *MockLobbyStore_GetQuestionWithLocalesById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRandomQuestionByRound provides a mock function for the type MockLobbyStore
 func (_mock *MockLobbyStore) GetRandomQuestionByRound(ctx context.Context, arg db.GetRandomQuestionByRoundParams) ([]db.GetRandomQuestionByRoundRow, error) {
 	ret := _mock.Called(ctx, arg)
@@ -300,6 +434,72 @@ func (_c *MockLobbyStore_GetRandomQuestionInGroup_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// GetRandomQuestionPair provides a mock function for the type MockLobbyStore
+func (_mock *MockLobbyStore) GetRandomQuestionPair(ctx context.Context, arg db.GetRandomQuestionPairParams) (db.QuestionPair, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRandomQuestionPair")
+	}
+
+	var r0 db.QuestionPair
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetRandomQuestionPairParams) (db.QuestionPair, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetRandomQuestionPairParams) db.QuestionPair); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.QuestionPair)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetRandomQuestionPairParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyStore_GetRandomQuestionPair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRandomQuestionPair'
+type MockLobbyStore_GetRandomQuestionPair_Call struct {
+	*mock.Call
+}
+
+// GetRandomQuestionPair is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetRandomQuestionPairParams
+func (_e *MockLobbyStore_Expecter) GetRandomQuestionPair(ctx interface{}, arg interface{}) *MockLobbyStore_GetRandomQuestionPair_Call {
+	return &MockLobbyStore_GetRandomQuestionPair_Call{Call: _e.mock.On("GetRandomQuestionPair", ctx, arg)}
+}
+
+func (_c *MockLobbyStore_GetRandomQuestionPair_Call) Run(run func(ctx context.Context, arg db.GetRandomQuestionPairParams)) *MockLobbyStore_GetRandomQuestionPair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetRandomQuestionPairParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetRandomQuestionPairParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyStore_GetRandomQuestionPair_Call) Return(questionPair db.QuestionPair, err error) *MockLobbyStore_GetRandomQuestionPair_Call {
+	_c.Call.Return(questionPair, err)
+	return _c
+}
+
+func (_c *MockLobbyStore_GetRandomQuestionPair_Call) RunAndReturn(run func(ctx context.Context, arg db.GetRandomQuestionPairParams) (db.QuestionPair, error)) *MockLobbyStore_GetRandomQuestionPair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRoomByCode provides a mock function for the type MockLobbyStore
 func (_mock *MockLobbyStore) GetRoomByCode(ctx context.Context, roomCode string) (db.Room, error) {
 	ret := _mock.Called(ctx, roomCode)
@@ -432,6 +632,140 @@ func (_c *MockLobbyStore_GetRoomByPlayerID_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// GetRoomSettings provides a mock function for the type MockLobbyStore
+func (_mock *MockLobbyStore) GetRoomSettings(ctx context.Context, roomID uuid.UUID) (db.RoomSetting, error) {
+	ret := _mock.Called(ctx, roomID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoomSettings")
+	}
+
+	var r0 db.RoomSetting
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.RoomSetting, error)); ok {
+		return returnFunc(ctx, roomID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.RoomSetting); ok {
+		r0 = returnFunc(ctx, roomID)
+	} else {
+		r0 = ret.Get(0).(db.RoomSetting)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, roomID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyStore_GetRoomSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoomSettings'
+type MockLobbyStore_GetRoomSettings_Call struct {
+	*mock.Call
+}
+
+// GetRoomSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomID uuid.UUID
+func (_e *MockLobbyStore_Expecter) GetRoomSettings(ctx interface{}, roomID interface{}) *MockLobbyStore_GetRoomSettings_Call {
+	return &MockLobbyStore_GetRoomSettings_Call{Call: _e.mock.On("GetRoomSettings", ctx, roomID)}
+}
+
+func (_c *MockLobbyStore_GetRoomSettings_Call) Run(run func(ctx context.Context, roomID uuid.UUID)) *MockLobbyStore_GetRoomSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyStore_GetRoomSettings_Call) Return(roomSetting db.RoomSetting, err error) *MockLobbyStore_GetRoomSettings_Call {
+	_c.Call.Return(roomSetting, err)
+	return _c
+}
+
+func (_c *MockLobbyStore_GetRoomSettings_Call) RunAndReturn(run func(ctx context.Context, roomID uuid.UUID) (db.RoomSetting, error)) *MockLobbyStore_GetRoomSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsedQuestionIDs provides a mock function for the type MockLobbyStore
+func (_mock *MockLobbyStore) GetUsedQuestionIDs(ctx context.Context, arg db.GetUsedQuestionIDsParams) ([]db.GetUsedQuestionIDsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsedQuestionIDs")
+	}
+
+	var r0 []db.GetUsedQuestionIDsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetUsedQuestionIDsParams) ([]db.GetUsedQuestionIDsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetUsedQuestionIDsParams) []db.GetUsedQuestionIDsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetUsedQuestionIDsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetUsedQuestionIDsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyStore_GetUsedQuestionIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsedQuestionIDs'
+type MockLobbyStore_GetUsedQuestionIDs_Call struct {
+	*mock.Call
+}
+
+// GetUsedQuestionIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetUsedQuestionIDsParams
+func (_e *MockLobbyStore_Expecter) GetUsedQuestionIDs(ctx interface{}, arg interface{}) *MockLobbyStore_GetUsedQuestionIDs_Call {
+	return &MockLobbyStore_GetUsedQuestionIDs_Call{Call: _e.mock.On("GetUsedQuestionIDs", ctx, arg)}
+}
+
+func (_c *MockLobbyStore_GetUsedQuestionIDs_Call) Run(run func(ctx context.Context, arg db.GetUsedQuestionIDsParams)) *MockLobbyStore_GetUsedQuestionIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetUsedQuestionIDsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetUsedQuestionIDsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyStore_GetUsedQuestionIDs_Call) Return(getUsedQuestionIDsRows []db.GetUsedQuestionIDsRow, err error) *MockLobbyStore_GetUsedQuestionIDs_Call {
+	_c.Call.Return(getUsedQuestionIDsRows, err)
+	return _c
+}
+
+func (_c *MockLobbyStore_GetUsedQuestionIDs_Call) RunAndReturn(run func(ctx context.Context, arg db.GetUsedQuestionIDsParams) ([]db.GetUsedQuestionIDsRow, error)) *MockLobbyStore_GetUsedQuestionIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JoinRoom provides a mock function for the type MockLobbyStore
 func (_mock *MockLobbyStore) JoinRoom(ctx context.Context, arg db.JoinRoomArgs) (db.JoinRoomResult, error) {
 	ret := _mock.Called(ctx, arg)
@@ -564,6 +898,63 @@ func (_c *MockLobbyStore_ReassignHostPlayer_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// Rematch provides a mock function for the type MockLobbyStore
+func (_mock *MockLobbyStore) Rematch(ctx context.Context, arg db.RematchArgs) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rematch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.RematchArgs) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLobbyStore_Rematch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rematch'
+type MockLobbyStore_Rematch_Call struct {
+	*mock.Call
+}
+
+// Rematch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.RematchArgs
+func (_e *MockLobbyStore_Expecter) Rematch(ctx interface{}, arg interface{}) *MockLobbyStore_Rematch_Call {
+	return &MockLobbyStore_Rematch_Call{Call: _e.mock.On("Rematch", ctx, arg)}
+}
+
+func (_c *MockLobbyStore_Rematch_Call) Run(run func(ctx context.Context, arg db.RematchArgs)) *MockLobbyStore_Rematch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.RematchArgs
+		if args[1] != nil {
+			arg1 = args[1].(db.RematchArgs)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyStore_Rematch_Call) Return(err error) *MockLobbyStore_Rematch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLobbyStore_Rematch_Call) RunAndReturn(run func(ctx context.Context, arg db.RematchArgs) error) *MockLobbyStore_Rematch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemovePlayerFromRoom provides a mock function for the type MockLobbyStore
 func (_mock *MockLobbyStore) RemovePlayerFromRoom(ctx context.Context, playerID uuid.UUID) (db.RoomsPlayer, error) {
 	ret := _mock.Called(ctx, playerID)
@@ -630,6 +1021,63 @@ func (_c *MockLobbyStore_RemovePlayerFromRoom_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// SetPlayerDisconnected provides a mock function for the type MockLobbyStore
+func (_mock *MockLobbyStore) SetPlayerDisconnected(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPlayerDisconnected")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLobbyStore_SetPlayerDisconnected_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPlayerDisconnected'
+type MockLobbyStore_SetPlayerDisconnected_Call struct {
+	*mock.Call
+}
+
+// SetPlayerDisconnected is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockLobbyStore_Expecter) SetPlayerDisconnected(ctx interface{}, id interface{}) *MockLobbyStore_SetPlayerDisconnected_Call {
+	return &MockLobbyStore_SetPlayerDisconnected_Call{Call: _e.mock.On("SetPlayerDisconnected", ctx, id)}
+}
+
+func (_c *MockLobbyStore_SetPlayerDisconnected_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockLobbyStore_SetPlayerDisconnected_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyStore_SetPlayerDisconnected_Call) Return(err error) *MockLobbyStore_SetPlayerDisconnected_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLobbyStore_SetPlayerDisconnected_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockLobbyStore_SetPlayerDisconnected_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartGame provides a mock function for the type MockLobbyStore
 func (_mock *MockLobbyStore) StartGame(ctx context.Context, arg db.StartGameArgs) error {
 	ret := _mock.Called(ctx, arg)
@@ -686,3 +1134,69 @@ func (_c *MockLobbyStore_StartGame_Call) RunAndReturn(run func(ctx context.Conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpsertRoomSettings provides a mock function for the type MockLobbyStore
+func (_mock *MockLobbyStore) UpsertRoomSettings(ctx context.Context, arg db.UpsertRoomSettingsParams) (db.RoomSetting, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertRoomSettings")
+	}
+
+	var r0 db.RoomSetting
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpsertRoomSettingsParams) (db.RoomSetting, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpsertRoomSettingsParams) db.RoomSetting); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.RoomSetting)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.UpsertRoomSettingsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyStore_UpsertRoomSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertRoomSettings'
+type MockLobbyStore_UpsertRoomSettings_Call struct {
+	*mock.Call
+}
+
+// UpsertRoomSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.UpsertRoomSettingsParams
+func (_e *MockLobbyStore_Expecter) UpsertRoomSettings(ctx interface{}, arg interface{}) *MockLobbyStore_UpsertRoomSettings_Call {
+	return &MockLobbyStore_UpsertRoomSettings_Call{Call: _e.mock.On("UpsertRoomSettings", ctx, arg)}
+}
+
+func (_c *MockLobbyStore_UpsertRoomSettings_Call) Run(run func(ctx context.Context, arg db.UpsertRoomSettingsParams)) *MockLobbyStore_UpsertRoomSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.UpsertRoomSettingsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.UpsertRoomSettingsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyStore_UpsertRoomSettings_Call) Return(roomSetting db.RoomSetting, err error) *MockLobbyStore_UpsertRoomSettings_Call {
+	_c.Call.Return(roomSetting, err)
+	return _c
+}
+
+func (_c *MockLobbyStore_UpsertRoomSettings_Call) RunAndReturn(run func(ctx context.Context, arg db.UpsertRoomSettingsParams) (db.RoomSetting, error)) *MockLobbyStore_UpsertRoomSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -171,6 +171,129 @@ func (_c *MockPlayerStore_GetPlayerByID_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// SetPlayerActive provides a mock function for the type MockPlayerStore
+func (_mock *MockPlayerStore) SetPlayerActive(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPlayerActive")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPlayerStore_SetPlayerActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPlayerActive'
+type MockPlayerStore_SetPlayerActive_Call struct {
+	*mock.Call
+}
+
+// SetPlayerActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockPlayerStore_Expecter) SetPlayerActive(ctx interface{}, id interface{}) *MockPlayerStore_SetPlayerActive_Call {
+	return &MockPlayerStore_SetPlayerActive_Call{Call: _e.mock.On("SetPlayerActive", ctx, id)}
+}
+
+func (_c *MockPlayerStore_SetPlayerActive_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockPlayerStore_SetPlayerActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlayerStore_SetPlayerActive_Call) Return(err error) *MockPlayerStore_SetPlayerActive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPlayerStore_SetPlayerActive_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockPlayerStore_SetPlayerActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPlayerInactive provides a mock function for the type MockPlayerStore
+func (_mock *MockPlayerStore) SetPlayerInactive(ctx context.Context, arg db.SetPlayerInactiveParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPlayerInactive")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.SetPlayerInactiveParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.SetPlayerInactiveParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.SetPlayerInactiveParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPlayerStore_SetPlayerInactive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPlayerInactive'
+type MockPlayerStore_SetPlayerInactive_Call struct {
+	*mock.Call
+}
+
+// SetPlayerInactive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.SetPlayerInactiveParams
+func (_e *MockPlayerStore_Expecter) SetPlayerInactive(ctx interface{}, arg interface{}) *MockPlayerStore_SetPlayerInactive_Call {
+	return &MockPlayerStore_SetPlayerInactive_Call{Call: _e.mock.On("SetPlayerInactive", ctx, arg)}
+}
+
+func (_c *MockPlayerStore_SetPlayerInactive_Call) Run(run func(ctx context.Context, arg db.SetPlayerInactiveParams)) *MockPlayerStore_SetPlayerInactive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.SetPlayerInactiveParams
+		if args[1] != nil {
+			arg1 = args[1].(db.SetPlayerInactiveParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlayerStore_SetPlayerInactive_Call) Return(n int64, err error) *MockPlayerStore_SetPlayerInactive_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPlayerStore_SetPlayerInactive_Call) RunAndReturn(run func(ctx context.Context, arg db.SetPlayerInactiveParams) (int64, error)) *MockPlayerStore_SetPlayerInactive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TogglePlayerReadyWithPlayers provides a mock function for the type MockPlayerStore
 func (_mock *MockPlayerStore) TogglePlayerReadyWithPlayers(ctx context.Context, arg db.TogglePlayerIsReadyArgs) (db.TogglePlayerIsReadyResult, error) {
 	ret := _mock.Called(ctx, arg)
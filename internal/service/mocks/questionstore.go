@@ -105,6 +105,72 @@ func (_c *MockQuestionStore_AddGroup_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// AddQuestionPair provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) AddQuestionPair(ctx context.Context, arg db.AddQuestionPairParams) (db.QuestionPair, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddQuestionPair")
+	}
+
+	var r0 db.QuestionPair
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.AddQuestionPairParams) (db.QuestionPair, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.AddQuestionPairParams) db.QuestionPair); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.QuestionPair)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.AddQuestionPairParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_AddQuestionPair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddQuestionPair'
+type MockQuestionStore_AddQuestionPair_Call struct {
+	*mock.Call
+}
+
+// AddQuestionPair is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.AddQuestionPairParams
+func (_e *MockQuestionStore_Expecter) AddQuestionPair(ctx interface{}, arg interface{}) *MockQuestionStore_AddQuestionPair_Call {
+	return &MockQuestionStore_AddQuestionPair_Call{Call: _e.mock.On("AddQuestionPair", ctx, arg)}
+}
+
+func (_c *MockQuestionStore_AddQuestionPair_Call) Run(run func(ctx context.Context, arg db.AddQuestionPairParams)) *MockQuestionStore_AddQuestionPair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.AddQuestionPairParams
+		if args[1] != nil {
+			arg1 = args[1].(db.AddQuestionPairParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_AddQuestionPair_Call) Return(questionPair db.QuestionPair, err error) *MockQuestionStore_AddQuestionPair_Call {
+	_c.Call.Return(questionPair, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_AddQuestionPair_Call) RunAndReturn(run func(ctx context.Context, arg db.AddQuestionPairParams) (db.QuestionPair, error)) *MockQuestionStore_AddQuestionPair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddQuestionTranslation provides a mock function for the type MockQuestionStore
 func (_mock *MockQuestionStore) AddQuestionTranslation(ctx context.Context, arg db.AddQuestionTranslationParams) (db.QuestionsI18n, error) {
 	ret := _mock.Called(ctx, arg)
@@ -239,23 +305,23 @@ func (_c *MockQuestionStore_CreateQuestionWithTranslation_Call) RunAndReturn(run
 	return _c
 }
 
-// DisableQuestion provides a mock function for the type MockQuestionStore
-func (_mock *MockQuestionStore) DisableQuestion(ctx context.Context, id uuid.UUID) (db.Question, error) {
+// DeleteOrArchiveGroup provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) DeleteOrArchiveGroup(ctx context.Context, id uuid.UUID) (bool, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DisableQuestion")
+		panic("no return value specified for DeleteOrArchiveGroup")
 	}
 
-	var r0 db.Question
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Question, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
 		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Question); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(db.Question)
+		r0 = ret.Get(0).(bool)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
 		r1 = returnFunc(ctx, id)
@@ -265,19 +331,19 @@ func (_mock *MockQuestionStore) DisableQuestion(ctx context.Context, id uuid.UUI
 	return r0, r1
 }
 
-// MockQuestionStore_DisableQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableQuestion'
-type MockQuestionStore_DisableQuestion_Call struct {
+// MockQuestionStore_DeleteOrArchiveGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrArchiveGroup'
+type MockQuestionStore_DeleteOrArchiveGroup_Call struct {
 	*mock.Call
 }
 
-// DisableQuestion is a helper method to define mock.On call
+// DeleteOrArchiveGroup is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockQuestionStore_Expecter) DisableQuestion(ctx interface{}, id interface{}) *MockQuestionStore_DisableQuestion_Call {
-	return &MockQuestionStore_DisableQuestion_Call{Call: _e.mock.On("DisableQuestion", ctx, id)}
+func (_e *MockQuestionStore_Expecter) DeleteOrArchiveGroup(ctx interface{}, id interface{}) *MockQuestionStore_DeleteOrArchiveGroup_Call {
+	return &MockQuestionStore_DeleteOrArchiveGroup_Call{Call: _e.mock.On("DeleteOrArchiveGroup", ctx, id)}
 }
 
-func (_c *MockQuestionStore_DisableQuestion_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockQuestionStore_DisableQuestion_Call {
+func (_c *MockQuestionStore_DeleteOrArchiveGroup_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockQuestionStore_DeleteOrArchiveGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -295,33 +361,33 @@ func (_c *MockQuestionStore_DisableQuestion_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockQuestionStore_DisableQuestion_Call) Return(question db.Question, err error) *MockQuestionStore_DisableQuestion_Call {
-	_c.Call.Return(question, err)
+func (_c *MockQuestionStore_DeleteOrArchiveGroup_Call) Return(b bool, err error) *MockQuestionStore_DeleteOrArchiveGroup_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockQuestionStore_DisableQuestion_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Question, error)) *MockQuestionStore_DisableQuestion_Call {
+func (_c *MockQuestionStore_DeleteOrArchiveGroup_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (bool, error)) *MockQuestionStore_DeleteOrArchiveGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// EnableQuestion provides a mock function for the type MockQuestionStore
-func (_mock *MockQuestionStore) EnableQuestion(ctx context.Context, id uuid.UUID) (db.Question, error) {
+// DeleteOrArchiveQuestion provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) DeleteOrArchiveQuestion(ctx context.Context, id uuid.UUID) (bool, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for EnableQuestion")
+		panic("no return value specified for DeleteOrArchiveQuestion")
 	}
 
-	var r0 db.Question
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Question, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
 		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Question); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
-		r0 = ret.Get(0).(db.Question)
+		r0 = ret.Get(0).(bool)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
 		r1 = returnFunc(ctx, id)
@@ -331,19 +397,19 @@ func (_mock *MockQuestionStore) EnableQuestion(ctx context.Context, id uuid.UUID
 	return r0, r1
 }
 
-// MockQuestionStore_EnableQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableQuestion'
-type MockQuestionStore_EnableQuestion_Call struct {
+// MockQuestionStore_DeleteOrArchiveQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrArchiveQuestion'
+type MockQuestionStore_DeleteOrArchiveQuestion_Call struct {
 	*mock.Call
 }
 
-// EnableQuestion is a helper method to define mock.On call
+// DeleteOrArchiveQuestion is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockQuestionStore_Expecter) EnableQuestion(ctx interface{}, id interface{}) *MockQuestionStore_EnableQuestion_Call {
-	return &MockQuestionStore_EnableQuestion_Call{Call: _e.mock.On("EnableQuestion", ctx, id)}
+func (_e *MockQuestionStore_Expecter) DeleteOrArchiveQuestion(ctx interface{}, id interface{}) *MockQuestionStore_DeleteOrArchiveQuestion_Call {
+	return &MockQuestionStore_DeleteOrArchiveQuestion_Call{Call: _e.mock.On("DeleteOrArchiveQuestion", ctx, id)}
 }
 
-func (_c *MockQuestionStore_EnableQuestion_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockQuestionStore_EnableQuestion_Call {
+func (_c *MockQuestionStore_DeleteOrArchiveQuestion_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockQuestionStore_DeleteOrArchiveQuestion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -361,99 +427,101 @@ func (_c *MockQuestionStore_EnableQuestion_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockQuestionStore_EnableQuestion_Call) Return(question db.Question, err error) *MockQuestionStore_EnableQuestion_Call {
-	_c.Call.Return(question, err)
+func (_c *MockQuestionStore_DeleteOrArchiveQuestion_Call) Return(b bool, err error) *MockQuestionStore_DeleteOrArchiveQuestion_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockQuestionStore_EnableQuestion_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Question, error)) *MockQuestionStore_EnableQuestion_Call {
+func (_c *MockQuestionStore_DeleteOrArchiveQuestion_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (bool, error)) *MockQuestionStore_DeleteOrArchiveQuestion_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetGroups provides a mock function for the type MockQuestionStore
-func (_mock *MockQuestionStore) GetGroups(ctx context.Context) ([]db.QuestionsGroup, error) {
-	ret := _mock.Called(ctx)
+// DeleteQuestionPair provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) DeleteQuestionPair(ctx context.Context, arg db.DeleteQuestionPairParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetGroups")
+		panic("no return value specified for DeleteQuestionPair")
 	}
 
-	var r0 []db.QuestionsGroup
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]db.QuestionsGroup, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.DeleteQuestionPairParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []db.QuestionsGroup); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.DeleteQuestionPairParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.QuestionsGroup)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.DeleteQuestionPairParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockQuestionStore_GetGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroups'
-type MockQuestionStore_GetGroups_Call struct {
+// MockQuestionStore_DeleteQuestionPair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuestionPair'
+type MockQuestionStore_DeleteQuestionPair_Call struct {
 	*mock.Call
 }
 
-// GetGroups is a helper method to define mock.On call
+// DeleteQuestionPair is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockQuestionStore_Expecter) GetGroups(ctx interface{}) *MockQuestionStore_GetGroups_Call {
-	return &MockQuestionStore_GetGroups_Call{Call: _e.mock.On("GetGroups", ctx)}
+//   - arg db.DeleteQuestionPairParams
+func (_e *MockQuestionStore_Expecter) DeleteQuestionPair(ctx interface{}, arg interface{}) *MockQuestionStore_DeleteQuestionPair_Call {
+	return &MockQuestionStore_DeleteQuestionPair_Call{Call: _e.mock.On("DeleteQuestionPair", ctx, arg)}
 }
 
-func (_c *MockQuestionStore_GetGroups_Call) Run(run func(ctx context.Context)) *MockQuestionStore_GetGroups_Call {
+func (_c *MockQuestionStore_DeleteQuestionPair_Call) Run(run func(ctx context.Context, arg db.DeleteQuestionPairParams)) *MockQuestionStore_DeleteQuestionPair_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 db.DeleteQuestionPairParams
+		if args[1] != nil {
+			arg1 = args[1].(db.DeleteQuestionPairParams)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockQuestionStore_GetGroups_Call) Return(questionsGroups []db.QuestionsGroup, err error) *MockQuestionStore_GetGroups_Call {
-	_c.Call.Return(questionsGroups, err)
+func (_c *MockQuestionStore_DeleteQuestionPair_Call) Return(n int64, err error) *MockQuestionStore_DeleteQuestionPair_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuestionStore_GetGroups_Call) RunAndReturn(run func(ctx context.Context) ([]db.QuestionsGroup, error)) *MockQuestionStore_GetGroups_Call {
+func (_c *MockQuestionStore_DeleteQuestionPair_Call) RunAndReturn(run func(ctx context.Context, arg db.DeleteQuestionPairParams) (int64, error)) *MockQuestionStore_DeleteQuestionPair_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetQuestions provides a mock function for the type MockQuestionStore
-func (_mock *MockQuestionStore) GetQuestions(ctx context.Context, arg db.GetQuestionsParams) ([]db.GetQuestionsRow, error) {
+// DeleteQuestionTranslation provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) DeleteQuestionTranslation(ctx context.Context, arg db.DeleteQuestionTranslationParams) (int64, error) {
 	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetQuestions")
+		panic("no return value specified for DeleteQuestionTranslation")
 	}
 
-	var r0 []db.GetQuestionsRow
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetQuestionsParams) ([]db.GetQuestionsRow, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.DeleteQuestionTranslationParams) (int64, error)); ok {
 		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetQuestionsParams) []db.GetQuestionsRow); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.DeleteQuestionTranslationParams) int64); ok {
 		r0 = returnFunc(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.GetQuestionsRow)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetQuestionsParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.DeleteQuestionTranslationParams) error); ok {
 		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -461,27 +529,27 @@ func (_mock *MockQuestionStore) GetQuestions(ctx context.Context, arg db.GetQues
 	return r0, r1
 }
 
-// MockQuestionStore_GetQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestions'
-type MockQuestionStore_GetQuestions_Call struct {
+// MockQuestionStore_DeleteQuestionTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuestionTranslation'
+type MockQuestionStore_DeleteQuestionTranslation_Call struct {
 	*mock.Call
 }
 
-// GetQuestions is a helper method to define mock.On call
+// DeleteQuestionTranslation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetQuestionsParams
-func (_e *MockQuestionStore_Expecter) GetQuestions(ctx interface{}, arg interface{}) *MockQuestionStore_GetQuestions_Call {
-	return &MockQuestionStore_GetQuestions_Call{Call: _e.mock.On("GetQuestions", ctx, arg)}
+//   - arg db.DeleteQuestionTranslationParams
+func (_e *MockQuestionStore_Expecter) DeleteQuestionTranslation(ctx interface{}, arg interface{}) *MockQuestionStore_DeleteQuestionTranslation_Call {
+	return &MockQuestionStore_DeleteQuestionTranslation_Call{Call: _e.mock.On("DeleteQuestionTranslation", ctx, arg)}
 }
 
-func (_c *MockQuestionStore_GetQuestions_Call) Run(run func(ctx context.Context, arg db.GetQuestionsParams)) *MockQuestionStore_GetQuestions_Call {
+func (_c *MockQuestionStore_DeleteQuestionTranslation_Call) Run(run func(ctx context.Context, arg db.DeleteQuestionTranslationParams)) *MockQuestionStore_DeleteQuestionTranslation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 db.GetQuestionsParams
+		var arg1 db.DeleteQuestionTranslationParams
 		if args[1] != nil {
-			arg1 = args[1].(db.GetQuestionsParams)
+			arg1 = args[1].(db.DeleteQuestionTranslationParams)
 		}
 		run(
 			arg0,
@@ -491,12 +559,999 @@ func (_c *MockQuestionStore_GetQuestions_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockQuestionStore_GetQuestions_Call) Return(getQuestionsRows []db.GetQuestionsRow, err error) *MockQuestionStore_GetQuestions_Call {
-	_c.Call.Return(getQuestionsRows, err)
+func (_c *MockQuestionStore_DeleteQuestionTranslation_Call) Return(n int64, err error) *MockQuestionStore_DeleteQuestionTranslation_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockQuestionStore_GetQuestions_Call) RunAndReturn(run func(ctx context.Context, arg db.GetQuestionsParams) ([]db.GetQuestionsRow, error)) *MockQuestionStore_GetQuestions_Call {
+func (_c *MockQuestionStore_DeleteQuestionTranslation_Call) RunAndReturn(run func(ctx context.Context, arg db.DeleteQuestionTranslationParams) (int64, error)) *MockQuestionStore_DeleteQuestionTranslation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableQuestion provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) DisableQuestion(ctx context.Context, id uuid.UUID) (db.Question, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableQuestion")
+	}
+
+	var r0 db.Question
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Question, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Question); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Question)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_DisableQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableQuestion'
+type MockQuestionStore_DisableQuestion_Call struct {
+	*mock.Call
+}
+
+// DisableQuestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockQuestionStore_Expecter) DisableQuestion(ctx interface{}, id interface{}) *MockQuestionStore_DisableQuestion_Call {
+	return &MockQuestionStore_DisableQuestion_Call{Call: _e.mock.On("DisableQuestion", ctx, id)}
+}
+
+func (_c *MockQuestionStore_DisableQuestion_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockQuestionStore_DisableQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_DisableQuestion_Call) Return(question db.Question, err error) *MockQuestionStore_DisableQuestion_Call {
+	_c.Call.Return(question, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_DisableQuestion_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Question, error)) *MockQuestionStore_DisableQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableQuestion provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) EnableQuestion(ctx context.Context, id uuid.UUID) (db.Question, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableQuestion")
+	}
+
+	var r0 db.Question
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Question, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Question); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Question)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_EnableQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableQuestion'
+type MockQuestionStore_EnableQuestion_Call struct {
+	*mock.Call
+}
+
+// EnableQuestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockQuestionStore_Expecter) EnableQuestion(ctx interface{}, id interface{}) *MockQuestionStore_EnableQuestion_Call {
+	return &MockQuestionStore_EnableQuestion_Call{Call: _e.mock.On("EnableQuestion", ctx, id)}
+}
+
+func (_c *MockQuestionStore_EnableQuestion_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockQuestionStore_EnableQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_EnableQuestion_Call) Return(question db.Question, err error) *MockQuestionStore_EnableQuestion_Call {
+	_c.Call.Return(question, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_EnableQuestion_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Question, error)) *MockQuestionStore_EnableQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDuplicateQuestionTranslations provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) GetDuplicateQuestionTranslations(ctx context.Context, arg db.GetDuplicateQuestionTranslationsParams) ([]db.GetDuplicateQuestionTranslationsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDuplicateQuestionTranslations")
+	}
+
+	var r0 []db.GetDuplicateQuestionTranslationsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetDuplicateQuestionTranslationsParams) ([]db.GetDuplicateQuestionTranslationsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetDuplicateQuestionTranslationsParams) []db.GetDuplicateQuestionTranslationsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetDuplicateQuestionTranslationsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetDuplicateQuestionTranslationsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_GetDuplicateQuestionTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDuplicateQuestionTranslations'
+type MockQuestionStore_GetDuplicateQuestionTranslations_Call struct {
+	*mock.Call
+}
+
+// GetDuplicateQuestionTranslations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetDuplicateQuestionTranslationsParams
+func (_e *MockQuestionStore_Expecter) GetDuplicateQuestionTranslations(ctx interface{}, arg interface{}) *MockQuestionStore_GetDuplicateQuestionTranslations_Call {
+	return &MockQuestionStore_GetDuplicateQuestionTranslations_Call{Call: _e.mock.On("GetDuplicateQuestionTranslations", ctx, arg)}
+}
+
+func (_c *MockQuestionStore_GetDuplicateQuestionTranslations_Call) Run(run func(ctx context.Context, arg db.GetDuplicateQuestionTranslationsParams)) *MockQuestionStore_GetDuplicateQuestionTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetDuplicateQuestionTranslationsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetDuplicateQuestionTranslationsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_GetDuplicateQuestionTranslations_Call) Return(getDuplicateQuestionTranslationsRows []db.GetDuplicateQuestionTranslationsRow, err error) *MockQuestionStore_GetDuplicateQuestionTranslations_Call {
+	_c.Call.Return(getDuplicateQuestionTranslationsRows, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_GetDuplicateQuestionTranslations_Call) RunAndReturn(run func(ctx context.Context, arg db.GetDuplicateQuestionTranslationsParams) ([]db.GetDuplicateQuestionTranslationsRow, error)) *MockQuestionStore_GetDuplicateQuestionTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupByID provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) GetGroupByID(ctx context.Context, id uuid.UUID) (db.QuestionsGroup, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupByID")
+	}
+
+	var r0 db.QuestionsGroup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.QuestionsGroup, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.QuestionsGroup); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.QuestionsGroup)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_GetGroupByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupByID'
+type MockQuestionStore_GetGroupByID_Call struct {
+	*mock.Call
+}
+
+// GetGroupByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockQuestionStore_Expecter) GetGroupByID(ctx interface{}, id interface{}) *MockQuestionStore_GetGroupByID_Call {
+	return &MockQuestionStore_GetGroupByID_Call{Call: _e.mock.On("GetGroupByID", ctx, id)}
+}
+
+func (_c *MockQuestionStore_GetGroupByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockQuestionStore_GetGroupByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_GetGroupByID_Call) Return(questionsGroup db.QuestionsGroup, err error) *MockQuestionStore_GetGroupByID_Call {
+	_c.Call.Return(questionsGroup, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_GetGroupByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.QuestionsGroup, error)) *MockQuestionStore_GetGroupByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupByName provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) GetGroupByName(ctx context.Context, groupName string) (db.QuestionsGroup, error) {
+	ret := _mock.Called(ctx, groupName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupByName")
+	}
+
+	var r0 db.QuestionsGroup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (db.QuestionsGroup, error)); ok {
+		return returnFunc(ctx, groupName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) db.QuestionsGroup); ok {
+		r0 = returnFunc(ctx, groupName)
+	} else {
+		r0 = ret.Get(0).(db.QuestionsGroup)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, groupName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_GetGroupByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupByName'
+type MockQuestionStore_GetGroupByName_Call struct {
+	*mock.Call
+}
+
+// GetGroupByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupName string
+func (_e *MockQuestionStore_Expecter) GetGroupByName(ctx interface{}, groupName interface{}) *MockQuestionStore_GetGroupByName_Call {
+	return &MockQuestionStore_GetGroupByName_Call{Call: _e.mock.On("GetGroupByName", ctx, groupName)}
+}
+
+func (_c *MockQuestionStore_GetGroupByName_Call) Run(run func(ctx context.Context, groupName string)) *MockQuestionStore_GetGroupByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_GetGroupByName_Call) Return(questionsGroup db.QuestionsGroup, err error) *MockQuestionStore_GetGroupByName_Call {
+	_c.Call.Return(questionsGroup, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_GetGroupByName_Call) RunAndReturn(run func(ctx context.Context, groupName string) (db.QuestionsGroup, error)) *MockQuestionStore_GetGroupByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroups provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) GetGroups(ctx context.Context) ([]db.QuestionsGroup, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroups")
+	}
+
+	var r0 []db.QuestionsGroup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]db.QuestionsGroup, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []db.QuestionsGroup); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.QuestionsGroup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_GetGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroups'
+type MockQuestionStore_GetGroups_Call struct {
+	*mock.Call
+}
+
+// GetGroups is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuestionStore_Expecter) GetGroups(ctx interface{}) *MockQuestionStore_GetGroups_Call {
+	return &MockQuestionStore_GetGroups_Call{Call: _e.mock.On("GetGroups", ctx)}
+}
+
+func (_c *MockQuestionStore_GetGroups_Call) Run(run func(ctx context.Context)) *MockQuestionStore_GetGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_GetGroups_Call) Return(questionsGroups []db.QuestionsGroup, err error) *MockQuestionStore_GetGroups_Call {
+	_c.Call.Return(questionsGroups, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_GetGroups_Call) RunAndReturn(run func(ctx context.Context) ([]db.QuestionsGroup, error)) *MockQuestionStore_GetGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuestionByID provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) GetQuestionByID(ctx context.Context, id uuid.UUID) (db.Question, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestionByID")
+	}
+
+	var r0 db.Question
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Question, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Question); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Question)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_GetQuestionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionByID'
+type MockQuestionStore_GetQuestionByID_Call struct {
+	*mock.Call
+}
+
+// GetQuestionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockQuestionStore_Expecter) GetQuestionByID(ctx interface{}, id interface{}) *MockQuestionStore_GetQuestionByID_Call {
+	return &MockQuestionStore_GetQuestionByID_Call{Call: _e.mock.On("GetQuestionByID", ctx, id)}
+}
+
+func (_c *MockQuestionStore_GetQuestionByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockQuestionStore_GetQuestionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_GetQuestionByID_Call) Return(question db.Question, err error) *MockQuestionStore_GetQuestionByID_Call {
+	_c.Call.Return(question, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_GetQuestionByID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Question, error)) *MockQuestionStore_GetQuestionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuestionPack provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) GetQuestionPack(ctx context.Context, groupName string) ([]db.GetQuestionPackRow, error) {
+	ret := _mock.Called(ctx, groupName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestionPack")
+	}
+
+	var r0 []db.GetQuestionPackRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]db.GetQuestionPackRow, error)); ok {
+		return returnFunc(ctx, groupName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []db.GetQuestionPackRow); ok {
+		r0 = returnFunc(ctx, groupName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetQuestionPackRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, groupName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_GetQuestionPack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionPack'
+type MockQuestionStore_GetQuestionPack_Call struct {
+	*mock.Call
+}
+
+// GetQuestionPack is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupName string
+func (_e *MockQuestionStore_Expecter) GetQuestionPack(ctx interface{}, groupName interface{}) *MockQuestionStore_GetQuestionPack_Call {
+	return &MockQuestionStore_GetQuestionPack_Call{Call: _e.mock.On("GetQuestionPack", ctx, groupName)}
+}
+
+func (_c *MockQuestionStore_GetQuestionPack_Call) Run(run func(ctx context.Context, groupName string)) *MockQuestionStore_GetQuestionPack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_GetQuestionPack_Call) Return(getQuestionPackRows []db.GetQuestionPackRow, err error) *MockQuestionStore_GetQuestionPack_Call {
+	_c.Call.Return(getQuestionPackRows, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_GetQuestionPack_Call) RunAndReturn(run func(ctx context.Context, groupName string) ([]db.GetQuestionPackRow, error)) *MockQuestionStore_GetQuestionPack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuestionPairs provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) GetQuestionPairs(ctx context.Context, questionID uuid.UUID) ([]db.QuestionPair, error) {
+	ret := _mock.Called(ctx, questionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestionPairs")
+	}
+
+	var r0 []db.QuestionPair
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.QuestionPair, error)); ok {
+		return returnFunc(ctx, questionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.QuestionPair); ok {
+		r0 = returnFunc(ctx, questionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.QuestionPair)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, questionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_GetQuestionPairs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionPairs'
+type MockQuestionStore_GetQuestionPairs_Call struct {
+	*mock.Call
+}
+
+// GetQuestionPairs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionID uuid.UUID
+func (_e *MockQuestionStore_Expecter) GetQuestionPairs(ctx interface{}, questionID interface{}) *MockQuestionStore_GetQuestionPairs_Call {
+	return &MockQuestionStore_GetQuestionPairs_Call{Call: _e.mock.On("GetQuestionPairs", ctx, questionID)}
+}
+
+func (_c *MockQuestionStore_GetQuestionPairs_Call) Run(run func(ctx context.Context, questionID uuid.UUID)) *MockQuestionStore_GetQuestionPairs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_GetQuestionPairs_Call) Return(questionPairs []db.QuestionPair, err error) *MockQuestionStore_GetQuestionPairs_Call {
+	_c.Call.Return(questionPairs, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_GetQuestionPairs_Call) RunAndReturn(run func(ctx context.Context, questionID uuid.UUID) ([]db.QuestionPair, error)) *MockQuestionStore_GetQuestionPairs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuestionWithLocalesById provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) GetQuestionWithLocalesById(ctx context.Context, id uuid.UUID) ([]db.GetQuestionWithLocalesByIdRow, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestionWithLocalesById")
+	}
+
+	var r0 []db.GetQuestionWithLocalesByIdRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.GetQuestionWithLocalesByIdRow, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.GetQuestionWithLocalesByIdRow); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetQuestionWithLocalesByIdRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_GetQuestionWithLocalesById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionWithLocalesById'
+type MockQuestionStore_GetQuestionWithLocalesById_Call struct {
+	*mock.Call
+}
+
+// GetQuestionWithLocalesById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockQuestionStore_Expecter) GetQuestionWithLocalesById(ctx interface{}, id interface{}) *MockQuestionStore_GetQuestionWithLocalesById_Call {
+	return &MockQuestionStore_GetQuestionWithLocalesById_Call{Call: _e.mock.On("GetQuestionWithLocalesById", ctx, id)}
+}
+
+func (_c *MockQuestionStore_GetQuestionWithLocalesById_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockQuestionStore_GetQuestionWithLocalesById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_GetQuestionWithLocalesById_Call) Return(getQuestionWithLocalesByIdRows []db.GetQuestionWithLocalesByIdRow, err error) *MockQuestionStore_GetQuestionWithLocalesById_Call {
+	_c.Call.Return(getQuestionWithLocalesByIdRows, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_GetQuestionWithLocalesById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) ([]db.GetQuestionWithLocalesByIdRow, error)) *MockQuestionStore_GetQuestionWithLocalesById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuestions provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) GetQuestions(ctx context.Context, arg db.GetQuestionsParams) ([]db.GetQuestionsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestions")
+	}
+
+	var r0 []db.GetQuestionsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetQuestionsParams) ([]db.GetQuestionsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetQuestionsParams) []db.GetQuestionsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetQuestionsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetQuestionsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_GetQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestions'
+type MockQuestionStore_GetQuestions_Call struct {
+	*mock.Call
+}
+
+// GetQuestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetQuestionsParams
+func (_e *MockQuestionStore_Expecter) GetQuestions(ctx interface{}, arg interface{}) *MockQuestionStore_GetQuestions_Call {
+	return &MockQuestionStore_GetQuestions_Call{Call: _e.mock.On("GetQuestions", ctx, arg)}
+}
+
+func (_c *MockQuestionStore_GetQuestions_Call) Run(run func(ctx context.Context, arg db.GetQuestionsParams)) *MockQuestionStore_GetQuestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetQuestionsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetQuestionsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_GetQuestions_Call) Return(getQuestionsRows []db.GetQuestionsRow, err error) *MockQuestionStore_GetQuestions_Call {
+	_c.Call.Return(getQuestionsRows, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_GetQuestions_Call) RunAndReturn(run func(ctx context.Context, arg db.GetQuestionsParams) ([]db.GetQuestionsRow, error)) *MockQuestionStore_GetQuestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportQuestionPack provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) ImportQuestionPack(ctx context.Context, arg db.ImportQuestionPackArgs) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportQuestionPack")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ImportQuestionPackArgs) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQuestionStore_ImportQuestionPack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportQuestionPack'
+type MockQuestionStore_ImportQuestionPack_Call struct {
+	*mock.Call
+}
+
+// ImportQuestionPack is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.ImportQuestionPackArgs
+func (_e *MockQuestionStore_Expecter) ImportQuestionPack(ctx interface{}, arg interface{}) *MockQuestionStore_ImportQuestionPack_Call {
+	return &MockQuestionStore_ImportQuestionPack_Call{Call: _e.mock.On("ImportQuestionPack", ctx, arg)}
+}
+
+func (_c *MockQuestionStore_ImportQuestionPack_Call) Run(run func(ctx context.Context, arg db.ImportQuestionPackArgs)) *MockQuestionStore_ImportQuestionPack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.ImportQuestionPackArgs
+		if args[1] != nil {
+			arg1 = args[1].(db.ImportQuestionPackArgs)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_ImportQuestionPack_Call) Return(err error) *MockQuestionStore_ImportQuestionPack_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQuestionStore_ImportQuestionPack_Call) RunAndReturn(run func(ctx context.Context, arg db.ImportQuestionPackArgs) error) *MockQuestionStore_ImportQuestionPack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGroupName provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) UpdateGroupName(ctx context.Context, arg db.UpdateGroupNameParams) (db.QuestionsGroup, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGroupName")
+	}
+
+	var r0 db.QuestionsGroup
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateGroupNameParams) (db.QuestionsGroup, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateGroupNameParams) db.QuestionsGroup); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.QuestionsGroup)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.UpdateGroupNameParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_UpdateGroupName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGroupName'
+type MockQuestionStore_UpdateGroupName_Call struct {
+	*mock.Call
+}
+
+// UpdateGroupName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.UpdateGroupNameParams
+func (_e *MockQuestionStore_Expecter) UpdateGroupName(ctx interface{}, arg interface{}) *MockQuestionStore_UpdateGroupName_Call {
+	return &MockQuestionStore_UpdateGroupName_Call{Call: _e.mock.On("UpdateGroupName", ctx, arg)}
+}
+
+func (_c *MockQuestionStore_UpdateGroupName_Call) Run(run func(ctx context.Context, arg db.UpdateGroupNameParams)) *MockQuestionStore_UpdateGroupName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.UpdateGroupNameParams
+		if args[1] != nil {
+			arg1 = args[1].(db.UpdateGroupNameParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_UpdateGroupName_Call) Return(questionsGroup db.QuestionsGroup, err error) *MockQuestionStore_UpdateGroupName_Call {
+	_c.Call.Return(questionsGroup, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_UpdateGroupName_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateGroupNameParams) (db.QuestionsGroup, error)) *MockQuestionStore_UpdateGroupName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateQuestion provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) UpdateQuestion(ctx context.Context, arg db.UpdateQuestionParams) (db.Question, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQuestion")
+	}
+
+	var r0 db.Question
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateQuestionParams) (db.Question, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateQuestionParams) db.Question); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.Question)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.UpdateQuestionParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_UpdateQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateQuestion'
+type MockQuestionStore_UpdateQuestion_Call struct {
+	*mock.Call
+}
+
+// UpdateQuestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.UpdateQuestionParams
+func (_e *MockQuestionStore_Expecter) UpdateQuestion(ctx interface{}, arg interface{}) *MockQuestionStore_UpdateQuestion_Call {
+	return &MockQuestionStore_UpdateQuestion_Call{Call: _e.mock.On("UpdateQuestion", ctx, arg)}
+}
+
+func (_c *MockQuestionStore_UpdateQuestion_Call) Run(run func(ctx context.Context, arg db.UpdateQuestionParams)) *MockQuestionStore_UpdateQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.UpdateQuestionParams
+		if args[1] != nil {
+			arg1 = args[1].(db.UpdateQuestionParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_UpdateQuestion_Call) Return(question db.Question, err error) *MockQuestionStore_UpdateQuestion_Call {
+	_c.Call.Return(question, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_UpdateQuestion_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateQuestionParams) (db.Question, error)) *MockQuestionStore_UpdateQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateQuestionTranslation provides a mock function for the type MockQuestionStore
+func (_mock *MockQuestionStore) UpdateQuestionTranslation(ctx context.Context, arg db.UpdateQuestionTranslationParams) (db.QuestionsI18n, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQuestionTranslation")
+	}
+
+	var r0 db.QuestionsI18n
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateQuestionTranslationParams) (db.QuestionsI18n, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateQuestionTranslationParams) db.QuestionsI18n); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(db.QuestionsI18n)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.UpdateQuestionTranslationParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockQuestionStore_UpdateQuestionTranslation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateQuestionTranslation'
+type MockQuestionStore_UpdateQuestionTranslation_Call struct {
+	*mock.Call
+}
+
+// UpdateQuestionTranslation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.UpdateQuestionTranslationParams
+func (_e *MockQuestionStore_Expecter) UpdateQuestionTranslation(ctx interface{}, arg interface{}) *MockQuestionStore_UpdateQuestionTranslation_Call {
+	return &MockQuestionStore_UpdateQuestionTranslation_Call{Call: _e.mock.On("UpdateQuestionTranslation", ctx, arg)}
+}
+
+func (_c *MockQuestionStore_UpdateQuestionTranslation_Call) Run(run func(ctx context.Context, arg db.UpdateQuestionTranslationParams)) *MockQuestionStore_UpdateQuestionTranslation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.UpdateQuestionTranslationParams
+		if args[1] != nil {
+			arg1 = args[1].(db.UpdateQuestionTranslationParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQuestionStore_UpdateQuestionTranslation_Call) Return(questionsI18n db.QuestionsI18n, err error) *MockQuestionStore_UpdateQuestionTranslation_Call {
+	_c.Call.Return(questionsI18n, err)
+	return _c
+}
+
+func (_c *MockQuestionStore_UpdateQuestionTranslation_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateQuestionTranslationParams) (db.QuestionsI18n, error)) *MockQuestionStore_UpdateQuestionTranslation_Call {
 	_c.Call.Return(run)
 	return _c
 }
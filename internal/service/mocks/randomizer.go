@@ -87,53 +87,120 @@ func (_c *MockRandomizer_GetAvatar_Call) RunAndReturn(run func(nickname string)
 	return _c
 }
 
-// GetFibberIndex provides a mock function for the type MockRandomizer
-func (_mock *MockRandomizer) GetFibberIndex(playersLen int) int {
-	ret := _mock.Called(playersLen)
+// GetFairFibberIndexes provides a mock function for the type MockRandomizer
+func (_mock *MockRandomizer) GetFairFibberIndexes(timesFibber []int, fibbers int) []int {
+	ret := _mock.Called(timesFibber, fibbers)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFibberIndex")
+		panic("no return value specified for GetFairFibberIndexes")
 	}
 
-	var r0 int
-	if returnFunc, ok := ret.Get(0).(func(int) int); ok {
-		r0 = returnFunc(playersLen)
+	var r0 []int
+	if returnFunc, ok := ret.Get(0).(func([]int, int) []int); ok {
+		r0 = returnFunc(timesFibber, fibbers)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
 	}
 	return r0
 }
 
-// MockRandomizer_GetFibberIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFibberIndex'
-type MockRandomizer_GetFibberIndex_Call struct {
+// MockRandomizer_GetFairFibberIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFairFibberIndexes'
+type MockRandomizer_GetFairFibberIndexes_Call struct {
 	*mock.Call
 }
 
-// GetFibberIndex is a helper method to define mock.On call
+// GetFairFibberIndexes is a helper method to define mock.On call
+//   - timesFibber []int
+//   - fibbers int
+func (_e *MockRandomizer_Expecter) GetFairFibberIndexes(timesFibber interface{}, fibbers interface{}) *MockRandomizer_GetFairFibberIndexes_Call {
+	return &MockRandomizer_GetFairFibberIndexes_Call{Call: _e.mock.On("GetFairFibberIndexes", timesFibber, fibbers)}
+}
+
+func (_c *MockRandomizer_GetFairFibberIndexes_Call) Run(run func(timesFibber []int, fibbers int)) *MockRandomizer_GetFairFibberIndexes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []int
+		if args[0] != nil {
+			arg0 = args[0].([]int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRandomizer_GetFairFibberIndexes_Call) Return(ints []int) *MockRandomizer_GetFairFibberIndexes_Call {
+	_c.Call.Return(ints)
+	return _c
+}
+
+func (_c *MockRandomizer_GetFairFibberIndexes_Call) RunAndReturn(run func(timesFibber []int, fibbers int) []int) *MockRandomizer_GetFairFibberIndexes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFibberIndexes provides a mock function for the type MockRandomizer
+func (_mock *MockRandomizer) GetFibberIndexes(playersLen int, fibbers int) []int {
+	ret := _mock.Called(playersLen, fibbers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFibberIndexes")
+	}
+
+	var r0 []int
+	if returnFunc, ok := ret.Get(0).(func(int, int) []int); ok {
+		r0 = returnFunc(playersLen, fibbers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+	return r0
+}
+
+// MockRandomizer_GetFibberIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFibberIndexes'
+type MockRandomizer_GetFibberIndexes_Call struct {
+	*mock.Call
+}
+
+// GetFibberIndexes is a helper method to define mock.On call
 //   - playersLen int
-func (_e *MockRandomizer_Expecter) GetFibberIndex(playersLen interface{}) *MockRandomizer_GetFibberIndex_Call {
-	return &MockRandomizer_GetFibberIndex_Call{Call: _e.mock.On("GetFibberIndex", playersLen)}
+//   - fibbers int
+func (_e *MockRandomizer_Expecter) GetFibberIndexes(playersLen interface{}, fibbers interface{}) *MockRandomizer_GetFibberIndexes_Call {
+	return &MockRandomizer_GetFibberIndexes_Call{Call: _e.mock.On("GetFibberIndexes", playersLen, fibbers)}
 }
 
-func (_c *MockRandomizer_GetFibberIndex_Call) Run(run func(playersLen int)) *MockRandomizer_GetFibberIndex_Call {
+func (_c *MockRandomizer_GetFibberIndexes_Call) Run(run func(playersLen int, fibbers int)) *MockRandomizer_GetFibberIndexes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRandomizer_GetFibberIndex_Call) Return(n int) *MockRandomizer_GetFibberIndex_Call {
-	_c.Call.Return(n)
+func (_c *MockRandomizer_GetFibberIndexes_Call) Return(ints []int) *MockRandomizer_GetFibberIndexes_Call {
+	_c.Call.Return(ints)
 	return _c
 }
 
-func (_c *MockRandomizer_GetFibberIndex_Call) RunAndReturn(run func(playersLen int) int) *MockRandomizer_GetFibberIndex_Call {
+func (_c *MockRandomizer_GetFibberIndexes_Call) RunAndReturn(run func(playersLen int, fibbers int) []int) *MockRandomizer_GetFibberIndexes_Call {
 	_c.Call.Return(run)
 	return _c
 }
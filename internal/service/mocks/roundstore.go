@@ -105,6 +105,72 @@ func (_c *MockRoundStore_CountTotalRoundsByGameStateID_Call) RunAndReturn(run fu
 	return _c
 }
 
+// CountVotesByPlayerIDAndRoundID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) CountVotesByPlayerIDAndRoundID(ctx context.Context, arg db.CountVotesByPlayerIDAndRoundIDParams) (int64, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountVotesByPlayerIDAndRoundID")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CountVotesByPlayerIDAndRoundIDParams) (int64, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.CountVotesByPlayerIDAndRoundIDParams) int64); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.CountVotesByPlayerIDAndRoundIDParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_CountVotesByPlayerIDAndRoundID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountVotesByPlayerIDAndRoundID'
+type MockRoundStore_CountVotesByPlayerIDAndRoundID_Call struct {
+	*mock.Call
+}
+
+// CountVotesByPlayerIDAndRoundID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.CountVotesByPlayerIDAndRoundIDParams
+func (_e *MockRoundStore_Expecter) CountVotesByPlayerIDAndRoundID(ctx interface{}, arg interface{}) *MockRoundStore_CountVotesByPlayerIDAndRoundID_Call {
+	return &MockRoundStore_CountVotesByPlayerIDAndRoundID_Call{Call: _e.mock.On("CountVotesByPlayerIDAndRoundID", ctx, arg)}
+}
+
+func (_c *MockRoundStore_CountVotesByPlayerIDAndRoundID_Call) Run(run func(ctx context.Context, arg db.CountVotesByPlayerIDAndRoundIDParams)) *MockRoundStore_CountVotesByPlayerIDAndRoundID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.CountVotesByPlayerIDAndRoundIDParams
+		if args[1] != nil {
+			arg1 = args[1].(db.CountVotesByPlayerIDAndRoundIDParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_CountVotesByPlayerIDAndRoundID_Call) Return(n int64, err error) *MockRoundStore_CountVotesByPlayerIDAndRoundID_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRoundStore_CountVotesByPlayerIDAndRoundID_Call) RunAndReturn(run func(ctx context.Context, arg db.CountVotesByPlayerIDAndRoundIDParams) (int64, error)) *MockRoundStore_CountVotesByPlayerIDAndRoundID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllPlayerAnswerIsReady provides a mock function for the type MockRoundStore
 func (_mock *MockRoundStore) GetAllPlayerAnswerIsReady(ctx context.Context, id uuid.UUID) (bool, error) {
 	ret := _mock.Called(ctx, id)
@@ -641,6 +707,142 @@ func (_c *MockRoundStore_GetAllVotesForRoundByGameStateID_Call) RunAndReturn(run
 	return _c
 }
 
+// GetAnswerOptionsByPlayerID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetAnswerOptionsByPlayerID(ctx context.Context, playerID uuid.UUID) ([]db.GetAnswerOptionsByPlayerIDRow, error) {
+	ret := _mock.Called(ctx, playerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnswerOptionsByPlayerID")
+	}
+
+	var r0 []db.GetAnswerOptionsByPlayerIDRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.GetAnswerOptionsByPlayerIDRow, error)); ok {
+		return returnFunc(ctx, playerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.GetAnswerOptionsByPlayerIDRow); ok {
+		r0 = returnFunc(ctx, playerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetAnswerOptionsByPlayerIDRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, playerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_GetAnswerOptionsByPlayerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnswerOptionsByPlayerID'
+type MockRoundStore_GetAnswerOptionsByPlayerID_Call struct {
+	*mock.Call
+}
+
+// GetAnswerOptionsByPlayerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - playerID uuid.UUID
+func (_e *MockRoundStore_Expecter) GetAnswerOptionsByPlayerID(ctx interface{}, playerID interface{}) *MockRoundStore_GetAnswerOptionsByPlayerID_Call {
+	return &MockRoundStore_GetAnswerOptionsByPlayerID_Call{Call: _e.mock.On("GetAnswerOptionsByPlayerID", ctx, playerID)}
+}
+
+func (_c *MockRoundStore_GetAnswerOptionsByPlayerID_Call) Run(run func(ctx context.Context, playerID uuid.UUID)) *MockRoundStore_GetAnswerOptionsByPlayerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_GetAnswerOptionsByPlayerID_Call) Return(getAnswerOptionsByPlayerIDRows []db.GetAnswerOptionsByPlayerIDRow, err error) *MockRoundStore_GetAnswerOptionsByPlayerID_Call {
+	_c.Call.Return(getAnswerOptionsByPlayerIDRows, err)
+	return _c
+}
+
+func (_c *MockRoundStore_GetAnswerOptionsByPlayerID_Call) RunAndReturn(run func(ctx context.Context, playerID uuid.UUID) ([]db.GetAnswerOptionsByPlayerIDRow, error)) *MockRoundStore_GetAnswerOptionsByPlayerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAudienceVotesByRoundID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetAudienceVotesByRoundID(ctx context.Context, roundID uuid.UUID) ([]db.GetAudienceVotesByRoundIDRow, error) {
+	ret := _mock.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudienceVotesByRoundID")
+	}
+
+	var r0 []db.GetAudienceVotesByRoundIDRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.GetAudienceVotesByRoundIDRow, error)); ok {
+		return returnFunc(ctx, roundID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.GetAudienceVotesByRoundIDRow); ok {
+		r0 = returnFunc(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetAudienceVotesByRoundIDRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_GetAudienceVotesByRoundID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAudienceVotesByRoundID'
+type MockRoundStore_GetAudienceVotesByRoundID_Call struct {
+	*mock.Call
+}
+
+// GetAudienceVotesByRoundID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockRoundStore_Expecter) GetAudienceVotesByRoundID(ctx interface{}, roundID interface{}) *MockRoundStore_GetAudienceVotesByRoundID_Call {
+	return &MockRoundStore_GetAudienceVotesByRoundID_Call{Call: _e.mock.On("GetAudienceVotesByRoundID", ctx, roundID)}
+}
+
+func (_c *MockRoundStore_GetAudienceVotesByRoundID_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockRoundStore_GetAudienceVotesByRoundID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_GetAudienceVotesByRoundID_Call) Return(getAudienceVotesByRoundIDRows []db.GetAudienceVotesByRoundIDRow, err error) *MockRoundStore_GetAudienceVotesByRoundID_Call {
+	_c.Call.Return(getAudienceVotesByRoundIDRows, err)
+	return _c
+}
+
+func (_c *MockRoundStore_GetAudienceVotesByRoundID_Call) RunAndReturn(run func(ctx context.Context, roundID uuid.UUID) ([]db.GetAudienceVotesByRoundIDRow, error)) *MockRoundStore_GetAudienceVotesByRoundID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCurrentQuestionByPlayerID provides a mock function for the type MockRoundStore
 func (_mock *MockRoundStore) GetCurrentQuestionByPlayerID(ctx context.Context, id uuid.UUID) (db.GetCurrentQuestionByPlayerIDRow, error) {
 	ret := _mock.Called(ctx, id)
@@ -707,23 +909,93 @@ func (_c *MockRoundStore_GetCurrentQuestionByPlayerID_Call) RunAndReturn(run fun
 	return _c
 }
 
-// GetFibberByRoundID provides a mock function for the type MockRoundStore
-func (_mock *MockRoundStore) GetFibberByRoundID(ctx context.Context, roundID uuid.UUID) (db.FibbingItPlayerRole, error) {
+// GetFibberCountsByGameStateID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetFibberCountsByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]db.GetFibberCountsByGameStateIDRow, error) {
+	ret := _mock.Called(ctx, gameStateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFibberCountsByGameStateID")
+	}
+
+	var r0 []db.GetFibberCountsByGameStateIDRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.GetFibberCountsByGameStateIDRow, error)); ok {
+		return returnFunc(ctx, gameStateID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.GetFibberCountsByGameStateIDRow); ok {
+		r0 = returnFunc(ctx, gameStateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetFibberCountsByGameStateIDRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, gameStateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_GetFibberCountsByGameStateID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFibberCountsByGameStateID'
+type MockRoundStore_GetFibberCountsByGameStateID_Call struct {
+	*mock.Call
+}
+
+// GetFibberCountsByGameStateID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameStateID uuid.UUID
+func (_e *MockRoundStore_Expecter) GetFibberCountsByGameStateID(ctx interface{}, gameStateID interface{}) *MockRoundStore_GetFibberCountsByGameStateID_Call {
+	return &MockRoundStore_GetFibberCountsByGameStateID_Call{Call: _e.mock.On("GetFibberCountsByGameStateID", ctx, gameStateID)}
+}
+
+func (_c *MockRoundStore_GetFibberCountsByGameStateID_Call) Run(run func(ctx context.Context, gameStateID uuid.UUID)) *MockRoundStore_GetFibberCountsByGameStateID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_GetFibberCountsByGameStateID_Call) Return(getFibberCountsByGameStateIDRows []db.GetFibberCountsByGameStateIDRow, err error) *MockRoundStore_GetFibberCountsByGameStateID_Call {
+	_c.Call.Return(getFibberCountsByGameStateIDRows, err)
+	return _c
+}
+
+func (_c *MockRoundStore_GetFibberCountsByGameStateID_Call) RunAndReturn(run func(ctx context.Context, gameStateID uuid.UUID) ([]db.GetFibberCountsByGameStateIDRow, error)) *MockRoundStore_GetFibberCountsByGameStateID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFibbersByRoundID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetFibbersByRoundID(ctx context.Context, roundID uuid.UUID) ([]db.FibbingItPlayerRole, error) {
 	ret := _mock.Called(ctx, roundID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFibberByRoundID")
+		panic("no return value specified for GetFibbersByRoundID")
 	}
 
-	var r0 db.FibbingItPlayerRole
+	var r0 []db.FibbingItPlayerRole
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.FibbingItPlayerRole, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.FibbingItPlayerRole, error)); ok {
 		return returnFunc(ctx, roundID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.FibbingItPlayerRole); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.FibbingItPlayerRole); ok {
 		r0 = returnFunc(ctx, roundID)
 	} else {
-		r0 = ret.Get(0).(db.FibbingItPlayerRole)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.FibbingItPlayerRole)
+		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
 		r1 = returnFunc(ctx, roundID)
@@ -733,19 +1005,19 @@ func (_mock *MockRoundStore) GetFibberByRoundID(ctx context.Context, roundID uui
 	return r0, r1
 }
 
-// MockRoundStore_GetFibberByRoundID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFibberByRoundID'
-type MockRoundStore_GetFibberByRoundID_Call struct {
+// MockRoundStore_GetFibbersByRoundID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFibbersByRoundID'
+type MockRoundStore_GetFibbersByRoundID_Call struct {
 	*mock.Call
 }
 
-// GetFibberByRoundID is a helper method to define mock.On call
+// GetFibbersByRoundID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - roundID uuid.UUID
-func (_e *MockRoundStore_Expecter) GetFibberByRoundID(ctx interface{}, roundID interface{}) *MockRoundStore_GetFibberByRoundID_Call {
-	return &MockRoundStore_GetFibberByRoundID_Call{Call: _e.mock.On("GetFibberByRoundID", ctx, roundID)}
+func (_e *MockRoundStore_Expecter) GetFibbersByRoundID(ctx interface{}, roundID interface{}) *MockRoundStore_GetFibbersByRoundID_Call {
+	return &MockRoundStore_GetFibbersByRoundID_Call{Call: _e.mock.On("GetFibbersByRoundID", ctx, roundID)}
 }
 
-func (_c *MockRoundStore_GetFibberByRoundID_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockRoundStore_GetFibberByRoundID_Call {
+func (_c *MockRoundStore_GetFibbersByRoundID_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockRoundStore_GetFibbersByRoundID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -763,12 +1035,12 @@ func (_c *MockRoundStore_GetFibberByRoundID_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockRoundStore_GetFibberByRoundID_Call) Return(fibbingItPlayerRole db.FibbingItPlayerRole, err error) *MockRoundStore_GetFibberByRoundID_Call {
-	_c.Call.Return(fibbingItPlayerRole, err)
+func (_c *MockRoundStore_GetFibbersByRoundID_Call) Return(fibbingItPlayerRoles []db.FibbingItPlayerRole, err error) *MockRoundStore_GetFibbersByRoundID_Call {
+	_c.Call.Return(fibbingItPlayerRoles, err)
 	return _c
 }
 
-func (_c *MockRoundStore_GetFibberByRoundID_Call) RunAndReturn(run func(ctx context.Context, roundID uuid.UUID) (db.FibbingItPlayerRole, error)) *MockRoundStore_GetFibberByRoundID_Call {
+func (_c *MockRoundStore_GetFibbersByRoundID_Call) RunAndReturn(run func(ctx context.Context, roundID uuid.UUID) ([]db.FibbingItPlayerRole, error)) *MockRoundStore_GetFibbersByRoundID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1307,53 +1579,53 @@ func (_c *MockRoundStore_GetRandomQuestionInGroup_Call) RunAndReturn(run func(ct
 	return _c
 }
 
-// GetRoomByPlayerID provides a mock function for the type MockRoundStore
-func (_mock *MockRoundStore) GetRoomByPlayerID(ctx context.Context, playerID uuid.UUID) (db.Room, error) {
-	ret := _mock.Called(ctx, playerID)
+// GetRandomQuestionPair provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetRandomQuestionPair(ctx context.Context, arg db.GetRandomQuestionPairParams) (db.QuestionPair, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRoomByPlayerID")
+		panic("no return value specified for GetRandomQuestionPair")
 	}
 
-	var r0 db.Room
+	var r0 db.QuestionPair
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Room, error)); ok {
-		return returnFunc(ctx, playerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetRandomQuestionPairParams) (db.QuestionPair, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Room); ok {
-		r0 = returnFunc(ctx, playerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetRandomQuestionPairParams) db.QuestionPair); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(db.Room)
+		r0 = ret.Get(0).(db.QuestionPair)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, playerID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetRandomQuestionPairParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRoundStore_GetRoomByPlayerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoomByPlayerID'
-type MockRoundStore_GetRoomByPlayerID_Call struct {
+// MockRoundStore_GetRandomQuestionPair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRandomQuestionPair'
+type MockRoundStore_GetRandomQuestionPair_Call struct {
 	*mock.Call
 }
 
-// GetRoomByPlayerID is a helper method to define mock.On call
+// GetRandomQuestionPair is a helper method to define mock.On call
 //   - ctx context.Context
-//   - playerID uuid.UUID
-func (_e *MockRoundStore_Expecter) GetRoomByPlayerID(ctx interface{}, playerID interface{}) *MockRoundStore_GetRoomByPlayerID_Call {
-	return &MockRoundStore_GetRoomByPlayerID_Call{Call: _e.mock.On("GetRoomByPlayerID", ctx, playerID)}
+//   - arg db.GetRandomQuestionPairParams
+func (_e *MockRoundStore_Expecter) GetRandomQuestionPair(ctx interface{}, arg interface{}) *MockRoundStore_GetRandomQuestionPair_Call {
+	return &MockRoundStore_GetRandomQuestionPair_Call{Call: _e.mock.On("GetRandomQuestionPair", ctx, arg)}
 }
 
-func (_c *MockRoundStore_GetRoomByPlayerID_Call) Run(run func(ctx context.Context, playerID uuid.UUID)) *MockRoundStore_GetRoomByPlayerID_Call {
+func (_c *MockRoundStore_GetRandomQuestionPair_Call) Run(run func(ctx context.Context, arg db.GetRandomQuestionPairParams)) *MockRoundStore_GetRandomQuestionPair_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 db.GetRandomQuestionPairParams
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(db.GetRandomQuestionPairParams)
 		}
 		run(
 			arg0,
@@ -1363,52 +1635,456 @@ func (_c *MockRoundStore_GetRoomByPlayerID_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockRoundStore_GetRoomByPlayerID_Call) Return(room db.Room, err error) *MockRoundStore_GetRoomByPlayerID_Call {
-	_c.Call.Return(room, err)
+func (_c *MockRoundStore_GetRandomQuestionPair_Call) Return(questionPair db.QuestionPair, err error) *MockRoundStore_GetRandomQuestionPair_Call {
+	_c.Call.Return(questionPair, err)
 	return _c
 }
 
-func (_c *MockRoundStore_GetRoomByPlayerID_Call) RunAndReturn(run func(ctx context.Context, playerID uuid.UUID) (db.Room, error)) *MockRoundStore_GetRoomByPlayerID_Call {
+func (_c *MockRoundStore_GetRandomQuestionPair_Call) RunAndReturn(run func(ctx context.Context, arg db.GetRandomQuestionPairParams) (db.QuestionPair, error)) *MockRoundStore_GetRandomQuestionPair_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTotalScoresByGameStateID provides a mock function for the type MockRoundStore
-func (_mock *MockRoundStore) GetTotalScoresByGameStateID(ctx context.Context, arg db.GetTotalScoresByGameStateIDParams) ([]db.GetTotalScoresByGameStateIDRow, error) {
-	ret := _mock.Called(ctx, arg)
+// GetRecapAnswersByGameStateID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetRecapAnswersByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]db.GetRecapAnswersByGameStateIDRow, error) {
+	ret := _mock.Called(ctx, gameStateID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTotalScoresByGameStateID")
+		panic("no return value specified for GetRecapAnswersByGameStateID")
 	}
 
-	var r0 []db.GetTotalScoresByGameStateIDRow
+	var r0 []db.GetRecapAnswersByGameStateIDRow
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetTotalScoresByGameStateIDParams) ([]db.GetTotalScoresByGameStateIDRow, error)); ok {
-		return returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.GetRecapAnswersByGameStateIDRow, error)); ok {
+		return returnFunc(ctx, gameStateID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetTotalScoresByGameStateIDParams) []db.GetTotalScoresByGameStateIDRow); ok {
-		r0 = returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.GetRecapAnswersByGameStateIDRow); ok {
+		r0 = returnFunc(ctx, gameStateID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]db.GetTotalScoresByGameStateIDRow)
+			r0 = ret.Get(0).([]db.GetRecapAnswersByGameStateIDRow)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetTotalScoresByGameStateIDParams) error); ok {
-		r1 = returnFunc(ctx, arg)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, gameStateID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRoundStore_GetTotalScoresByGameStateID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotalScoresByGameStateID'
-type MockRoundStore_GetTotalScoresByGameStateID_Call struct {
+// MockRoundStore_GetRecapAnswersByGameStateID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecapAnswersByGameStateID'
+type MockRoundStore_GetRecapAnswersByGameStateID_Call struct {
 	*mock.Call
 }
 
-// GetTotalScoresByGameStateID is a helper method to define mock.On call
+// GetRecapAnswersByGameStateID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg db.GetTotalScoresByGameStateIDParams
+//   - gameStateID uuid.UUID
+func (_e *MockRoundStore_Expecter) GetRecapAnswersByGameStateID(ctx interface{}, gameStateID interface{}) *MockRoundStore_GetRecapAnswersByGameStateID_Call {
+	return &MockRoundStore_GetRecapAnswersByGameStateID_Call{Call: _e.mock.On("GetRecapAnswersByGameStateID", ctx, gameStateID)}
+}
+
+func (_c *MockRoundStore_GetRecapAnswersByGameStateID_Call) Run(run func(ctx context.Context, gameStateID uuid.UUID)) *MockRoundStore_GetRecapAnswersByGameStateID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_GetRecapAnswersByGameStateID_Call) Return(getRecapAnswersByGameStateIDRows []db.GetRecapAnswersByGameStateIDRow, err error) *MockRoundStore_GetRecapAnswersByGameStateID_Call {
+	_c.Call.Return(getRecapAnswersByGameStateIDRows, err)
+	return _c
+}
+
+func (_c *MockRoundStore_GetRecapAnswersByGameStateID_Call) RunAndReturn(run func(ctx context.Context, gameStateID uuid.UUID) ([]db.GetRecapAnswersByGameStateIDRow, error)) *MockRoundStore_GetRecapAnswersByGameStateID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecapVotesByGameStateID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetRecapVotesByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]db.GetRecapVotesByGameStateIDRow, error) {
+	ret := _mock.Called(ctx, gameStateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecapVotesByGameStateID")
+	}
+
+	var r0 []db.GetRecapVotesByGameStateIDRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.GetRecapVotesByGameStateIDRow, error)); ok {
+		return returnFunc(ctx, gameStateID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.GetRecapVotesByGameStateIDRow); ok {
+		r0 = returnFunc(ctx, gameStateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetRecapVotesByGameStateIDRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, gameStateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_GetRecapVotesByGameStateID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecapVotesByGameStateID'
+type MockRoundStore_GetRecapVotesByGameStateID_Call struct {
+	*mock.Call
+}
+
+// GetRecapVotesByGameStateID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameStateID uuid.UUID
+func (_e *MockRoundStore_Expecter) GetRecapVotesByGameStateID(ctx interface{}, gameStateID interface{}) *MockRoundStore_GetRecapVotesByGameStateID_Call {
+	return &MockRoundStore_GetRecapVotesByGameStateID_Call{Call: _e.mock.On("GetRecapVotesByGameStateID", ctx, gameStateID)}
+}
+
+func (_c *MockRoundStore_GetRecapVotesByGameStateID_Call) Run(run func(ctx context.Context, gameStateID uuid.UUID)) *MockRoundStore_GetRecapVotesByGameStateID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_GetRecapVotesByGameStateID_Call) Return(getRecapVotesByGameStateIDRows []db.GetRecapVotesByGameStateIDRow, err error) *MockRoundStore_GetRecapVotesByGameStateID_Call {
+	_c.Call.Return(getRecapVotesByGameStateIDRows, err)
+	return _c
+}
+
+func (_c *MockRoundStore_GetRecapVotesByGameStateID_Call) RunAndReturn(run func(ctx context.Context, gameStateID uuid.UUID) ([]db.GetRecapVotesByGameStateIDRow, error)) *MockRoundStore_GetRecapVotesByGameStateID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoomByGameStateID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetRoomByGameStateID(ctx context.Context, id uuid.UUID) (db.Room, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoomByGameStateID")
+	}
+
+	var r0 db.Room
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Room, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Room); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(db.Room)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_GetRoomByGameStateID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoomByGameStateID'
+type MockRoundStore_GetRoomByGameStateID_Call struct {
+	*mock.Call
+}
+
+// GetRoomByGameStateID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockRoundStore_Expecter) GetRoomByGameStateID(ctx interface{}, id interface{}) *MockRoundStore_GetRoomByGameStateID_Call {
+	return &MockRoundStore_GetRoomByGameStateID_Call{Call: _e.mock.On("GetRoomByGameStateID", ctx, id)}
+}
+
+func (_c *MockRoundStore_GetRoomByGameStateID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRoundStore_GetRoomByGameStateID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_GetRoomByGameStateID_Call) Return(room db.Room, err error) *MockRoundStore_GetRoomByGameStateID_Call {
+	_c.Call.Return(room, err)
+	return _c
+}
+
+func (_c *MockRoundStore_GetRoomByGameStateID_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (db.Room, error)) *MockRoundStore_GetRoomByGameStateID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoomByPlayerID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetRoomByPlayerID(ctx context.Context, playerID uuid.UUID) (db.Room, error) {
+	ret := _mock.Called(ctx, playerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoomByPlayerID")
+	}
+
+	var r0 db.Room
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.Room, error)); ok {
+		return returnFunc(ctx, playerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.Room); ok {
+		r0 = returnFunc(ctx, playerID)
+	} else {
+		r0 = ret.Get(0).(db.Room)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, playerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_GetRoomByPlayerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoomByPlayerID'
+type MockRoundStore_GetRoomByPlayerID_Call struct {
+	*mock.Call
+}
+
+// GetRoomByPlayerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - playerID uuid.UUID
+func (_e *MockRoundStore_Expecter) GetRoomByPlayerID(ctx interface{}, playerID interface{}) *MockRoundStore_GetRoomByPlayerID_Call {
+	return &MockRoundStore_GetRoomByPlayerID_Call{Call: _e.mock.On("GetRoomByPlayerID", ctx, playerID)}
+}
+
+func (_c *MockRoundStore_GetRoomByPlayerID_Call) Run(run func(ctx context.Context, playerID uuid.UUID)) *MockRoundStore_GetRoomByPlayerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_GetRoomByPlayerID_Call) Return(room db.Room, err error) *MockRoundStore_GetRoomByPlayerID_Call {
+	_c.Call.Return(room, err)
+	return _c
+}
+
+func (_c *MockRoundStore_GetRoomByPlayerID_Call) RunAndReturn(run func(ctx context.Context, playerID uuid.UUID) (db.Room, error)) *MockRoundStore_GetRoomByPlayerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScoreReasonsByRoundID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetScoreReasonsByRoundID(ctx context.Context, roundID uuid.UUID) ([]db.GetScoreReasonsByRoundIDRow, error) {
+	ret := _mock.Called(ctx, roundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScoreReasonsByRoundID")
+	}
+
+	var r0 []db.GetScoreReasonsByRoundIDRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]db.GetScoreReasonsByRoundIDRow, error)); ok {
+		return returnFunc(ctx, roundID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []db.GetScoreReasonsByRoundIDRow); ok {
+		r0 = returnFunc(ctx, roundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetScoreReasonsByRoundIDRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, roundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_GetScoreReasonsByRoundID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScoreReasonsByRoundID'
+type MockRoundStore_GetScoreReasonsByRoundID_Call struct {
+	*mock.Call
+}
+
+// GetScoreReasonsByRoundID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roundID uuid.UUID
+func (_e *MockRoundStore_Expecter) GetScoreReasonsByRoundID(ctx interface{}, roundID interface{}) *MockRoundStore_GetScoreReasonsByRoundID_Call {
+	return &MockRoundStore_GetScoreReasonsByRoundID_Call{Call: _e.mock.On("GetScoreReasonsByRoundID", ctx, roundID)}
+}
+
+func (_c *MockRoundStore_GetScoreReasonsByRoundID_Call) Run(run func(ctx context.Context, roundID uuid.UUID)) *MockRoundStore_GetScoreReasonsByRoundID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_GetScoreReasonsByRoundID_Call) Return(getScoreReasonsByRoundIDRows []db.GetScoreReasonsByRoundIDRow, err error) *MockRoundStore_GetScoreReasonsByRoundID_Call {
+	_c.Call.Return(getScoreReasonsByRoundIDRows, err)
+	return _c
+}
+
+func (_c *MockRoundStore_GetScoreReasonsByRoundID_Call) RunAndReturn(run func(ctx context.Context, roundID uuid.UUID) ([]db.GetScoreReasonsByRoundIDRow, error)) *MockRoundStore_GetScoreReasonsByRoundID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesScores provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetSeriesScores(ctx context.Context, arg db.GetSeriesScoresParams) ([]db.GetSeriesScoresRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesScores")
+	}
+
+	var r0 []db.GetSeriesScoresRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetSeriesScoresParams) ([]db.GetSeriesScoresRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetSeriesScoresParams) []db.GetSeriesScoresRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetSeriesScoresRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetSeriesScoresParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_GetSeriesScores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesScores'
+type MockRoundStore_GetSeriesScores_Call struct {
+	*mock.Call
+}
+
+// GetSeriesScores is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetSeriesScoresParams
+func (_e *MockRoundStore_Expecter) GetSeriesScores(ctx interface{}, arg interface{}) *MockRoundStore_GetSeriesScores_Call {
+	return &MockRoundStore_GetSeriesScores_Call{Call: _e.mock.On("GetSeriesScores", ctx, arg)}
+}
+
+func (_c *MockRoundStore_GetSeriesScores_Call) Run(run func(ctx context.Context, arg db.GetSeriesScoresParams)) *MockRoundStore_GetSeriesScores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetSeriesScoresParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetSeriesScoresParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_GetSeriesScores_Call) Return(getSeriesScoresRows []db.GetSeriesScoresRow, err error) *MockRoundStore_GetSeriesScores_Call {
+	_c.Call.Return(getSeriesScoresRows, err)
+	return _c
+}
+
+func (_c *MockRoundStore_GetSeriesScores_Call) RunAndReturn(run func(ctx context.Context, arg db.GetSeriesScoresParams) ([]db.GetSeriesScoresRow, error)) *MockRoundStore_GetSeriesScores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTotalScoresByGameStateID provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetTotalScoresByGameStateID(ctx context.Context, arg db.GetTotalScoresByGameStateIDParams) ([]db.GetTotalScoresByGameStateIDRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotalScoresByGameStateID")
+	}
+
+	var r0 []db.GetTotalScoresByGameStateIDRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetTotalScoresByGameStateIDParams) ([]db.GetTotalScoresByGameStateIDRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetTotalScoresByGameStateIDParams) []db.GetTotalScoresByGameStateIDRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetTotalScoresByGameStateIDRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetTotalScoresByGameStateIDParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_GetTotalScoresByGameStateID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotalScoresByGameStateID'
+type MockRoundStore_GetTotalScoresByGameStateID_Call struct {
+	*mock.Call
+}
+
+// GetTotalScoresByGameStateID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetTotalScoresByGameStateIDParams
 func (_e *MockRoundStore_Expecter) GetTotalScoresByGameStateID(ctx interface{}, arg interface{}) *MockRoundStore_GetTotalScoresByGameStateID_Call {
 	return &MockRoundStore_GetTotalScoresByGameStateID_Call{Call: _e.mock.On("GetTotalScoresByGameStateID", ctx, arg)}
 }
@@ -1441,6 +2117,74 @@ func (_c *MockRoundStore_GetTotalScoresByGameStateID_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetUsedQuestionIDs provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) GetUsedQuestionIDs(ctx context.Context, arg db.GetUsedQuestionIDsParams) ([]db.GetUsedQuestionIDsRow, error) {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsedQuestionIDs")
+	}
+
+	var r0 []db.GetUsedQuestionIDsRow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetUsedQuestionIDsParams) ([]db.GetUsedQuestionIDsRow, error)); ok {
+		return returnFunc(ctx, arg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.GetUsedQuestionIDsParams) []db.GetUsedQuestionIDsRow); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]db.GetUsedQuestionIDsRow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.GetUsedQuestionIDsParams) error); ok {
+		r1 = returnFunc(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundStore_GetUsedQuestionIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsedQuestionIDs'
+type MockRoundStore_GetUsedQuestionIDs_Call struct {
+	*mock.Call
+}
+
+// GetUsedQuestionIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.GetUsedQuestionIDsParams
+func (_e *MockRoundStore_Expecter) GetUsedQuestionIDs(ctx interface{}, arg interface{}) *MockRoundStore_GetUsedQuestionIDs_Call {
+	return &MockRoundStore_GetUsedQuestionIDs_Call{Call: _e.mock.On("GetUsedQuestionIDs", ctx, arg)}
+}
+
+func (_c *MockRoundStore_GetUsedQuestionIDs_Call) Run(run func(ctx context.Context, arg db.GetUsedQuestionIDsParams)) *MockRoundStore_GetUsedQuestionIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.GetUsedQuestionIDsParams
+		if args[1] != nil {
+			arg1 = args[1].(db.GetUsedQuestionIDsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_GetUsedQuestionIDs_Call) Return(getUsedQuestionIDsRows []db.GetUsedQuestionIDsRow, err error) *MockRoundStore_GetUsedQuestionIDs_Call {
+	_c.Call.Return(getUsedQuestionIDsRows, err)
+	return _c
+}
+
+func (_c *MockRoundStore_GetUsedQuestionIDs_Call) RunAndReturn(run func(ctx context.Context, arg db.GetUsedQuestionIDsParams) ([]db.GetUsedQuestionIDsRow, error)) *MockRoundStore_GetUsedQuestionIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetVotingState provides a mock function for the type MockRoundStore
 func (_mock *MockRoundStore) GetVotingState(ctx context.Context, roundID uuid.UUID) ([]db.GetVotingStateRow, error) {
 	ret := _mock.Called(ctx, roundID)
@@ -1641,6 +2385,120 @@ func (_c *MockRoundStore_ResumeGame_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// StartRevote provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) StartRevote(ctx context.Context, arg db.StartRevoteArgs) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartRevote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.StartRevoteArgs) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRoundStore_StartRevote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartRevote'
+type MockRoundStore_StartRevote_Call struct {
+	*mock.Call
+}
+
+// StartRevote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.StartRevoteArgs
+func (_e *MockRoundStore_Expecter) StartRevote(ctx interface{}, arg interface{}) *MockRoundStore_StartRevote_Call {
+	return &MockRoundStore_StartRevote_Call{Call: _e.mock.On("StartRevote", ctx, arg)}
+}
+
+func (_c *MockRoundStore_StartRevote_Call) Run(run func(ctx context.Context, arg db.StartRevoteArgs)) *MockRoundStore_StartRevote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.StartRevoteArgs
+		if args[1] != nil {
+			arg1 = args[1].(db.StartRevoteArgs)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_StartRevote_Call) Return(err error) *MockRoundStore_StartRevote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRoundStore_StartRevote_Call) RunAndReturn(run func(ctx context.Context, arg db.StartRevoteArgs) error) *MockRoundStore_StartRevote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitVote provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) SubmitVote(ctx context.Context, arg db.SubmitVoteArgs) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitVote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.SubmitVoteArgs) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRoundStore_SubmitVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitVote'
+type MockRoundStore_SubmitVote_Call struct {
+	*mock.Call
+}
+
+// SubmitVote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.SubmitVoteArgs
+func (_e *MockRoundStore_Expecter) SubmitVote(ctx interface{}, arg interface{}) *MockRoundStore_SubmitVote_Call {
+	return &MockRoundStore_SubmitVote_Call{Call: _e.mock.On("SubmitVote", ctx, arg)}
+}
+
+func (_c *MockRoundStore_SubmitVote_Call) Run(run func(ctx context.Context, arg db.SubmitVoteArgs)) *MockRoundStore_SubmitVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.SubmitVoteArgs
+		if args[1] != nil {
+			arg1 = args[1].(db.SubmitVoteArgs)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_SubmitVote_Call) Return(err error) *MockRoundStore_SubmitVote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRoundStore_SubmitVote_Call) RunAndReturn(run func(ctx context.Context, arg db.SubmitVoteArgs) error) *MockRoundStore_SubmitVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ToggleAnswerIsReady provides a mock function for the type MockRoundStore
 func (_mock *MockRoundStore) ToggleAnswerIsReady(ctx context.Context, playerID uuid.UUID) (db.FibbingItAnswer, error) {
 	ret := _mock.Called(ctx, playerID)
@@ -1708,8 +2566,8 @@ func (_c *MockRoundStore_ToggleAnswerIsReady_Call) RunAndReturn(run func(ctx con
 }
 
 // ToggleVotingIsReady provides a mock function for the type MockRoundStore
-func (_mock *MockRoundStore) ToggleVotingIsReady(ctx context.Context, playerID uuid.UUID) (db.FibbingItVote, error) {
-	ret := _mock.Called(ctx, playerID)
+func (_mock *MockRoundStore) ToggleVotingIsReady(ctx context.Context, arg db.ToggleVotingIsReadyParams) (db.FibbingItVote, error) {
+	ret := _mock.Called(ctx, arg)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ToggleVotingIsReady")
@@ -1717,16 +2575,16 @@ func (_mock *MockRoundStore) ToggleVotingIsReady(ctx context.Context, playerID u
 
 	var r0 db.FibbingItVote
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (db.FibbingItVote, error)); ok {
-		return returnFunc(ctx, playerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ToggleVotingIsReadyParams) (db.FibbingItVote, error)); ok {
+		return returnFunc(ctx, arg)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) db.FibbingItVote); ok {
-		r0 = returnFunc(ctx, playerID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.ToggleVotingIsReadyParams) db.FibbingItVote); ok {
+		r0 = returnFunc(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(db.FibbingItVote)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, playerID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, db.ToggleVotingIsReadyParams) error); ok {
+		r1 = returnFunc(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1740,20 +2598,20 @@ type MockRoundStore_ToggleVotingIsReady_Call struct {
 
 // ToggleVotingIsReady is a helper method to define mock.On call
 //   - ctx context.Context
-//   - playerID uuid.UUID
-func (_e *MockRoundStore_Expecter) ToggleVotingIsReady(ctx interface{}, playerID interface{}) *MockRoundStore_ToggleVotingIsReady_Call {
-	return &MockRoundStore_ToggleVotingIsReady_Call{Call: _e.mock.On("ToggleVotingIsReady", ctx, playerID)}
+//   - arg db.ToggleVotingIsReadyParams
+func (_e *MockRoundStore_Expecter) ToggleVotingIsReady(ctx interface{}, arg interface{}) *MockRoundStore_ToggleVotingIsReady_Call {
+	return &MockRoundStore_ToggleVotingIsReady_Call{Call: _e.mock.On("ToggleVotingIsReady", ctx, arg)}
 }
 
-func (_c *MockRoundStore_ToggleVotingIsReady_Call) Run(run func(ctx context.Context, playerID uuid.UUID)) *MockRoundStore_ToggleVotingIsReady_Call {
+func (_c *MockRoundStore_ToggleVotingIsReady_Call) Run(run func(ctx context.Context, arg db.ToggleVotingIsReadyParams)) *MockRoundStore_ToggleVotingIsReady_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 db.ToggleVotingIsReadyParams
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(db.ToggleVotingIsReadyParams)
 		}
 		run(
 			arg0,
@@ -1768,7 +2626,64 @@ func (_c *MockRoundStore_ToggleVotingIsReady_Call) Return(fibbingItVote db.Fibbi
 	return _c
 }
 
-func (_c *MockRoundStore_ToggleVotingIsReady_Call) RunAndReturn(run func(ctx context.Context, playerID uuid.UUID) (db.FibbingItVote, error)) *MockRoundStore_ToggleVotingIsReady_Call {
+func (_c *MockRoundStore_ToggleVotingIsReady_Call) RunAndReturn(run func(ctx context.Context, arg db.ToggleVotingIsReadyParams) (db.FibbingItVote, error)) *MockRoundStore_ToggleVotingIsReady_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFibbingItRoundRevealedPlayers provides a mock function for the type MockRoundStore
+func (_mock *MockRoundStore) UpdateFibbingItRoundRevealedPlayers(ctx context.Context, arg db.UpdateFibbingItRoundRevealedPlayersParams) error {
+	ret := _mock.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFibbingItRoundRevealedPlayers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpdateFibbingItRoundRevealedPlayersParams) error); ok {
+		r0 = returnFunc(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRoundStore_UpdateFibbingItRoundRevealedPlayers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFibbingItRoundRevealedPlayers'
+type MockRoundStore_UpdateFibbingItRoundRevealedPlayers_Call struct {
+	*mock.Call
+}
+
+// UpdateFibbingItRoundRevealedPlayers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg db.UpdateFibbingItRoundRevealedPlayersParams
+func (_e *MockRoundStore_Expecter) UpdateFibbingItRoundRevealedPlayers(ctx interface{}, arg interface{}) *MockRoundStore_UpdateFibbingItRoundRevealedPlayers_Call {
+	return &MockRoundStore_UpdateFibbingItRoundRevealedPlayers_Call{Call: _e.mock.On("UpdateFibbingItRoundRevealedPlayers", ctx, arg)}
+}
+
+func (_c *MockRoundStore_UpdateFibbingItRoundRevealedPlayers_Call) Run(run func(ctx context.Context, arg db.UpdateFibbingItRoundRevealedPlayersParams)) *MockRoundStore_UpdateFibbingItRoundRevealedPlayers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 db.UpdateFibbingItRoundRevealedPlayersParams
+		if args[1] != nil {
+			arg1 = args[1].(db.UpdateFibbingItRoundRevealedPlayersParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundStore_UpdateFibbingItRoundRevealedPlayers_Call) Return(err error) *MockRoundStore_UpdateFibbingItRoundRevealedPlayers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRoundStore_UpdateFibbingItRoundRevealedPlayers_Call) RunAndReturn(run func(ctx context.Context, arg db.UpdateFibbingItRoundRevealedPlayersParams) error) *MockRoundStore_UpdateFibbingItRoundRevealedPlayers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2234,60 +3149,3 @@ func (_c *MockRoundStore_UpsertFibbingItAnswer_Call) RunAndReturn(run func(ctx c
 	_c.Call.Return(run)
 	return _c
 }
-
-// UpsertFibbingItVote provides a mock function for the type MockRoundStore
-func (_mock *MockRoundStore) UpsertFibbingItVote(ctx context.Context, arg db.UpsertFibbingItVoteParams) error {
-	ret := _mock.Called(ctx, arg)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UpsertFibbingItVote")
-	}
-
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, db.UpsertFibbingItVoteParams) error); ok {
-		r0 = returnFunc(ctx, arg)
-	} else {
-		r0 = ret.Error(0)
-	}
-	return r0
-}
-
-// MockRoundStore_UpsertFibbingItVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertFibbingItVote'
-type MockRoundStore_UpsertFibbingItVote_Call struct {
-	*mock.Call
-}
-
-// UpsertFibbingItVote is a helper method to define mock.On call
-//   - ctx context.Context
-//   - arg db.UpsertFibbingItVoteParams
-func (_e *MockRoundStore_Expecter) UpsertFibbingItVote(ctx interface{}, arg interface{}) *MockRoundStore_UpsertFibbingItVote_Call {
-	return &MockRoundStore_UpsertFibbingItVote_Call{Call: _e.mock.On("UpsertFibbingItVote", ctx, arg)}
-}
-
-func (_c *MockRoundStore_UpsertFibbingItVote_Call) Run(run func(ctx context.Context, arg db.UpsertFibbingItVoteParams)) *MockRoundStore_UpsertFibbingItVote_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
-		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 db.UpsertFibbingItVoteParams
-		if args[1] != nil {
-			arg1 = args[1].(db.UpsertFibbingItVoteParams)
-		}
-		run(
-			arg0,
-			arg1,
-		)
-	})
-	return _c
-}
-
-func (_c *MockRoundStore_UpsertFibbingItVote_Call) Return(err error) *MockRoundStore_UpsertFibbingItVote_Call {
-	_c.Call.Return(err)
-	return _c
-}
-
-func (_c *MockRoundStore_UpsertFibbingItVote_Call) RunAndReturn(run func(ctx context.Context, arg db.UpsertFibbingItVoteParams) error) *MockRoundStore_UpsertFibbingItVote_Call {
-	_c.Call.Return(run)
-	return _c
-}
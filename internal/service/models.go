@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
 )
 
 type Lobby struct {
@@ -51,6 +53,8 @@ type PlayerWithRole struct {
 	PossibleAnswers []string
 	CurrentAnswer   string
 	IsHost          bool
+	// IsInactive is true when the player disconnected and didn't come back in time, they aren't waited on.
+	IsInactive bool
 }
 
 // TODO: could just be a single player
@@ -79,6 +83,28 @@ type VotingState struct {
 	Deadline             time.Duration
 	IsPaused             bool
 	PauseTimeRemainingMs int32
+	// IsRevote is true when players are voting again between the players who tied for the most votes.
+	IsRevote bool
+	// Fibbers is how many fibbers there are this round, each player gets one vote per fibber.
+	Fibbers   int
+	RoundType string
+	// AnswerSpread is only set in numeric rounds, it is the range of numbers the players answered with.
+	AnswerSpread *AnswerSpread
+	// Rankings are only set in ranking rounds, so players can compare everyone's order side by side.
+	Rankings []PlayerRanking
+}
+
+type AnswerSpread struct {
+	Min    float64
+	Max    float64
+	Median float64
+}
+
+type PlayerRanking struct {
+	Nickname string
+	Ranking  []string
+	// IsRevealed is true when the group voted for the player.
+	IsRevealed bool
 }
 
 type PlayerWithVoting struct {
@@ -87,30 +113,65 @@ type PlayerWithVoting struct {
 	Avatar   string
 	Votes    int
 	Answer   string
-	IsReady  bool
-	IsHost   bool
-	Role     string
+	// Ranking is the order the player put the items in, only set in ranking rounds.
+	Ranking []string
+	IsReady bool
+	IsHost  bool
+	Role    string
+	// IsRevoteCandidate is true when the player tied for the most votes and can be voted for in the revote.
+	IsRevoteCandidate bool
+	// IsInactive is true when the player disconnected and didn't come back in time, they don't count as a voter.
+	IsInactive bool
 }
 
 type RevealRoleState struct {
-	VotedForPlayerNickname string
-	VotedForPlayerAvatar   string
-	VotedForPlayerRole     string
-	ShouldReveal           bool
-	Deadline               time.Duration
-	Round                  int
-	RoundType              string
-	PlayerIDs              []uuid.UUID
+	// RevealedPlayers are the players the group voted for, at most one for every fibber.
+	RevealedPlayers []RevealedPlayer
+	ShouldReveal    bool
+	Deadline        time.Duration
+	Round           int
+	MaxRounds       int
+	RoundType       string
+	IsLastRoundType bool
+	PlayerIDs       []uuid.UUID
+	RevealRule      string
+	// Decision is how the group came to reveal or not reveal a player, i.e. majority.
+	Decision string
+	Votes    int
+	Voters   int
+	Fibbers  int
+	// FibbersFound is how many of the revealed players were fibbers.
+	FibbersFound int
+	Rankings     []PlayerRanking
+	// AudienceVotes are who the audience thought the fibber was, they don't count towards the players' scores.
+	AudienceVotes []AudienceVote
+	GameStateID   uuid.UUID
+}
+
+type AudienceVote struct {
+	Nickname string
+	Votes    int
+}
+
+type RevealedPlayer struct {
+	ID       uuid.UUID
+	Nickname string
+	Avatar   string
+	Role     string
+	Votes    int
 }
 
 type ScoreState struct {
-	GameStateID  uuid.UUID
-	Players      []PlayerWithScoring
-	Deadline     time.Duration
-	RoundType    string
-	RoundNumber  int
-	TotalRounds  int
-	FibberCaught bool
+	GameStateID     uuid.UUID
+	Players         []PlayerWithScoring
+	Deadline        time.Duration
+	RoundType       string
+	RoundNumber     int
+	TotalRounds     int
+	MaxRounds       int
+	IsLastRoundType bool
+	FibberCaught    bool
+	Series          SeriesState
 }
 
 type PlayerWithScoring struct {
@@ -118,11 +179,17 @@ type PlayerWithScoring struct {
 	Nickname string
 	Avatar   string
 	Score    int
+	// Reasons explain the bonus points the player got this round from the room's scorers.
+	Reasons []ScoreReason
 }
 
 type Scoring struct {
 	GuessedFibber      int
 	FibberEvadeCapture int
+	SpeedBonus         int
+	StreakBonus        int
+	CatchUpPercent     int
+	DodgedVote         int
 }
 
 type Question struct {
@@ -132,6 +199,8 @@ type Question struct {
 	Locale    string
 	RoundType string
 	Enabled   bool
+	// AnswerOptions are what players pick from in multiple choice rounds, empty means the default scale is used.
+	AnswerOptions []string
 }
 
 type Group struct {
@@ -140,15 +209,174 @@ type Group struct {
 	Type string
 }
 
+// QuestionPair is a curated normal and fibber question, which are always picked together for a round.
+type QuestionPair struct {
+	ID               string
+	NormalQuestionID string
+	FibberQuestionID string
+}
+
 type QuestionTranslation struct {
-	Text   string
-	Locale string
+	Text          string
+	Locale        string
+	AnswerOptions []string
+}
+
+// QuestionPack is a set of groups and questions which can be exported and imported in bulk.
+type QuestionPack struct {
+	Groups    []Group
+	Questions []PackQuestion
+}
+
+type PackQuestion struct {
+	// Row is where the question is in the imported file, so errors can point back to it.
+	Row          int
+	GroupName    string
+	RoundType    string
+	Enabled      bool
+	Translations []QuestionTranslation
+}
+
+type ImportResult struct {
+	DryRun bool
+	// Groups, Questions and Translations are how many were, or in a dry run would be, added.
+	Groups       int
+	Questions    int
+	Translations int
+	Errors       []ImportError
+}
+
+// ImportError is why a row in a question pack can't be imported, Locale is set if it's about one translation.
+type ImportError struct {
+	Row     int
+	Locale  string
+	Message string
 }
 
 type WinnerState struct {
+	GameStateID uuid.UUID
+	Players     []PlayerWithScoring
+	// HostPlayerID is who can start a rematch from the winner screen.
+	HostPlayerID uuid.UUID
+	Series       SeriesState
+	Awards       []Award
+}
+
+type Award struct {
+	// Name is one of the Award constants, e.g. AwardMasterFibber.
+	Name     string
+	PlayerID uuid.UUID
+	Nickname string
+	Avatar   string
+	// Score is what the award was won with, votes for most awards and milliseconds for the fastest answer.
+	Score int
+}
+
+// SeriesState is the running leaderboard for a room playing several games in a row.
+type SeriesState struct {
+	// Game is which game of the series this is, starting from 1.
+	Game    int
+	Games   int
 	Players []PlayerWithScoring
 }
 
+// GameRecap is everything that happened in a finished game, round by round.
+type GameRecap struct {
+	GameStateID uuid.UUID
+	Rounds      []RoundRecap
+	Awards      []Award
+}
+
+type RoundRecap struct {
+	Round          int
+	RoundType      string
+	NormalQuestion string
+	FibberQuestion string
+	Players        []PlayerRecap
+	Votes          []VoteRecap
+}
+
+type PlayerRecap struct {
+	ID       uuid.UUID
+	Nickname string
+	Avatar   string
+	Role     string
+	Answer   string
+	// Ranking is the order the player put the items in, only set for ranking rounds.
+	Ranking []string
+	// Points is what the player scored this round, including any bonus points.
+	Points int
+}
+
+type VoteRecap struct {
+	VoterID          uuid.UUID
+	VoterNickname    string
+	VotedForID       uuid.UUID
+	VotedForNickname string
+}
+
+// Account is the saved profile of a signed in player, empty fields mean the player picks them for each game.
+type Account struct {
+	ID       uuid.UUID
+	Nickname string
+	Avatar   string
+	Locale   string
+	Stats    AccountStats
+}
+
+type AccountProfile struct {
+	Nickname string
+	Avatar   string
+	Locale   string
+}
+
+// AccountStats are collected from every game the account has played.
+type AccountStats struct {
+	GamesPlayed     int
+	Wins            int
+	FibberRounds    int
+	FibberSuccesses int
+	// FibberSuccessRate is the fraction of fibber rounds where the player didn't get more than half of the votes.
+	FibberSuccessRate float64
+}
+
+type Leaderboard struct {
+	Period string
+	// RoundType is empty when the leaderboard counts every round type.
+	RoundType string
+	Entries   []LeaderboardEntry
+}
+
+type LeaderboardEntry struct {
+	Rank int
+	// AccountID is only set for signed in players, anonymous players are grouped by their nickname.
+	AccountID   *uuid.UUID
+	Nickname    string
+	Avatar      string
+	Score       int
+	GamesPlayed int
+}
+
+type AudienceMember struct {
+	ID       uuid.UUID
+	Nickname string
+	Avatar   string
+	Locale   string
+	RoomCode string
+	// HostPlayerID is used to get the game's state, the audience sees the game the same way the host does.
+	HostPlayerID uuid.UUID
+}
+
+// Display is a shared screen, like a TV, showing the room to everyone. It is not a player, so it never answers,
+// votes or scores.
+type Display struct {
+	ID           uuid.UUID
+	Locale       string
+	RoomCode     string
+	RoomState    db.RoomState
+	HostPlayerID uuid.UUID
+}
+
 type NewRoundState struct {
 	Round     int
 	RoundType string
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidNumericAnswer = errors.New("answer must be a number")
+
+// getNumericAnswer checks the answer is a number and normalises it, so answers like 1e3 and 1000 look the same
+// when players are voting.
+func getNumericAnswer(answer string) (string, error) {
+	number, err := strconv.ParseFloat(strings.TrimSpace(answer), 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidNumericAnswer, answer)
+	}
+
+	return strconv.FormatFloat(number, 'f', -1, 64), nil
+}
+
+// getAnswerSpread works out the range of answers in a numeric round, so players can spot the fibber's guess if it
+// is far from everyone else's. Returns nil for other round types or if no one has answered yet.
+func getAnswerSpread(roundType string, players []PlayerWithVoting) *AnswerSpread {
+	if roundType != RoundTypeNumeric {
+		return nil
+	}
+
+	numbers := []float64{}
+	for _, player := range players {
+		number, err := strconv.ParseFloat(player.Answer, 64)
+		if err != nil {
+			continue
+		}
+		numbers = append(numbers, number)
+	}
+
+	if len(numbers) == 0 {
+		return nil
+	}
+
+	slices.Sort(numbers)
+	median := numbers[len(numbers)/2]
+	if len(numbers)%2 == 0 {
+		median = (numbers[len(numbers)/2-1] + numbers[len(numbers)/2]) / 2
+	}
+
+	return &AnswerSpread{
+		Min:    numbers[0],
+		Max:    numbers[len(numbers)-1],
+		Median: median,
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -18,6 +19,8 @@ type PlayerStore interface {
 	UpdateNicknameWithPlayers(ctx context.Context, arg db.UpdateNicknameArgs) (db.UpdateNicknameResult, error)
 	GenerateNewAvatarWithPlayers(ctx context.Context, arg db.GenerateNewAvatarArgs) (db.GenerateNewAvatarResult, error)
 	TogglePlayerReadyWithPlayers(ctx context.Context, arg db.TogglePlayerIsReadyArgs) (db.TogglePlayerIsReadyResult, error)
+	SetPlayerInactive(ctx context.Context, arg db.SetPlayerInactiveParams) (int64, error)
+	SetPlayerActive(ctx context.Context, id uuid.UUID) error
 }
 
 type PlayerService struct {
@@ -106,3 +109,20 @@ func (p *PlayerService) GetPlayerByID(ctx context.Context, playerID uuid.UUID) (
 	}
 	return player, nil
 }
+
+// MarkInactive marks a player who has been disconnected since before disconnectedBefore as inactive, returns false
+// if they have reconnected since or were already inactive.
+func (p *PlayerService) MarkInactive(ctx context.Context, playerID uuid.UUID, disconnectedBefore time.Time) (bool, error) {
+	rows, err := p.store.SetPlayerInactive(ctx, db.SetPlayerInactiveParams{
+		ID:             playerID,
+		DisconnectedAt: pgtype.Timestamp{Time: disconnectedBefore, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (p *PlayerService) MarkActive(ctx context.Context, playerID uuid.UUID) error {
+	return p.store.SetPlayerActive(ctx, playerID)
+}
@@ -34,7 +34,7 @@ func TestIntegrationPlayerUpdateNickname(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobbyService.Create(ctx, "fibbing_it", newPlayer)
 
 		srv := service.NewPlayerService(str, randomizer)
@@ -62,7 +62,7 @@ func TestIntegrationPlayerUpdateNickname(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := lobbyService.Create(ctx, "fibbing_it", newPlayer)
 		require.NoError(t, err)
 
@@ -97,7 +97,7 @@ func TestIntegrationPlayerUpdateNickname(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobbyService.Create(ctx, "fibbing_it", newPlayer)
 
 		srv := service.NewPlayerService(str, randomizer)
@@ -127,7 +127,7 @@ func TestIntegrationPlayerGenerateNewAvatar(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := lobbyService.Create(ctx, "fibbing_it", newPlayer)
 		require.NoError(t, err)
 		oldAvatar := lobby.Lobby.Players[0].Avatar
@@ -157,7 +157,7 @@ func TestIntegrationPlayerGenerateNewAvatar(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := lobbyService.Create(ctx, "fibbing_it", newPlayer)
 		require.NoError(t, err)
 
@@ -195,7 +195,7 @@ func TestIntegrationToggleIsReady(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		_, err = lobbyService.Create(ctx, "fibbing_it", newPlayer)
 		require.NoError(t, err)
 
@@ -223,7 +223,7 @@ func TestIntegrationToggleIsReady(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		_, err = lobbyService.Create(ctx, "fibbing_it", newPlayer)
 		require.NoError(t, err)
 
@@ -253,7 +253,7 @@ func TestIntegrationToggleIsReady(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		_, err = lobbyService.Create(ctx, "fibbing_it", newPlayer)
 		require.NoError(t, err)
 
@@ -280,7 +280,7 @@ func TestIntegrationToggleIsReady(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		lobby, err := lobbyService.Create(ctx, "fibbing_it", newPlayer)
 		require.NoError(t, err)
 
@@ -3,6 +3,7 @@ package service_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
@@ -448,3 +449,93 @@ func TestPlayerServiceUpdateLocale(t *testing.T) {
 		assert.Contains(t, err.Error(), "database connection error")
 	})
 }
+
+func TestPlayerServiceMarkInactive(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should mark disconnected player as inactive", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockPlayerStore(t)
+		mockRandomizer := mockService.NewMockRandomizer(t)
+		srv := service.NewPlayerService(mockStore, mockRandomizer)
+
+		ctx := t.Context()
+		disconnectedBefore := time.Now().UTC()
+
+		mockStore.EXPECT().SetPlayerInactive(ctx, db.SetPlayerInactiveParams{
+			ID:             playerID,
+			DisconnectedAt: pgtype.Timestamp{Time: disconnectedBefore, Valid: true},
+		}).Return(1, nil)
+
+		inactive, err := srv.MarkInactive(ctx, playerID, disconnectedBefore)
+		assert.NoError(t, err)
+		assert.True(t, inactive)
+	})
+
+	t.Run("Should not mark player as inactive if they reconnected", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockPlayerStore(t)
+		mockRandomizer := mockService.NewMockRandomizer(t)
+		srv := service.NewPlayerService(mockStore, mockRandomizer)
+
+		ctx := t.Context()
+		disconnectedBefore := time.Now().UTC()
+
+		mockStore.EXPECT().SetPlayerInactive(ctx, db.SetPlayerInactiveParams{
+			ID:             playerID,
+			DisconnectedAt: pgtype.Timestamp{Time: disconnectedBefore, Valid: true},
+		}).Return(0, nil)
+
+		inactive, err := srv.MarkInactive(ctx, playerID, disconnectedBefore)
+		assert.NoError(t, err)
+		assert.False(t, inactive)
+	})
+
+	t.Run("Should fail to mark player as inactive because DB call fails", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockPlayerStore(t)
+		mockRandomizer := mockService.NewMockRandomizer(t)
+		srv := service.NewPlayerService(mockStore, mockRandomizer)
+
+		ctx := t.Context()
+		disconnectedBefore := time.Now().UTC()
+
+		mockStore.EXPECT().SetPlayerInactive(ctx, db.SetPlayerInactiveParams{
+			ID:             playerID,
+			DisconnectedAt: pgtype.Timestamp{Time: disconnectedBefore, Valid: true},
+		}).Return(0, errors.New("failed to update player"))
+
+		_, err := srv.MarkInactive(ctx, playerID, disconnectedBefore)
+		assert.Error(t, err)
+	})
+}
+
+func TestPlayerServiceMarkActive(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should mark player as active", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockPlayerStore(t)
+		mockRandomizer := mockService.NewMockRandomizer(t)
+		srv := service.NewPlayerService(mockStore, mockRandomizer)
+
+		ctx := t.Context()
+		mockStore.EXPECT().SetPlayerActive(ctx, playerID).Return(nil)
+
+		err := srv.MarkActive(ctx, playerID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should fail to mark player as active because DB call fails", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockPlayerStore(t)
+		mockRandomizer := mockService.NewMockRandomizer(t)
+		srv := service.NewPlayerService(mockStore, mockRandomizer)
+
+		ctx := t.Context()
+		mockStore.EXPECT().SetPlayerActive(ctx, playerID).Return(errors.New("failed to update player"))
+
+		err := srv.MarkActive(ctx, playerID)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/invopop/ctxi18n"
+	"github.com/invopop/ctxi18n/i18n"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+const DefaultGroupType = "questions"
+
+var ErrInvalidQuestionPack = errors.New("invalid question pack")
+
+// translationKey is what makes a translation unique, the same question can't be added twice in the same locale.
+type translationKey struct {
+	text   string
+	locale string
+}
+
+// ExportPack gets every question in the group, with all of its translations, in the same format ImportPack takes.
+// If groupName is empty every group is exported.
+func (q QuestionService) ExportPack(ctx context.Context, groupName string) (QuestionPack, error) {
+	groups, err := q.store.GetGroups(ctx)
+	if err != nil {
+		return QuestionPack{}, err
+	}
+
+	rows, err := q.store.GetQuestionPack(ctx, groupName)
+	if err != nil {
+		return QuestionPack{}, err
+	}
+
+	pack := QuestionPack{Groups: []Group{}, Questions: []PackQuestion{}}
+	for _, group := range groups {
+		if groupName == "" || group.GroupName == groupName {
+			pack.Groups = append(pack.Groups, Group{ID: group.ID.String(), Name: group.GroupName, Type: group.GroupType})
+		}
+	}
+
+	for i, row := range rows {
+		// INFO: Translations of the same question are next to each other, as the rows are ordered by question.
+		if i == 0 || rows[i-1].ID != row.ID {
+			pack.Questions = append(pack.Questions, PackQuestion{
+				Row:          len(pack.Questions) + 1,
+				GroupName:    row.GroupName,
+				RoundType:    row.RoundType,
+				Enabled:      row.Enabled.Bool,
+				Translations: []QuestionTranslation{},
+			})
+		}
+
+		question := &pack.Questions[len(pack.Questions)-1]
+		question.Translations = append(question.Translations, QuestionTranslation{
+			Text:          row.Question,
+			Locale:        row.Locale,
+			AnswerOptions: getAnswerOptions(row.AnswerOptions),
+		})
+	}
+
+	return pack, nil
+}
+
+// ImportPack adds all the groups and questions in the pack, or none of them if any question is invalid. Every
+// invalid question is reported in the result, along with ErrInvalidQuestionPack. A dry run only checks the pack.
+func (q QuestionService) ImportPack(ctx context.Context, pack QuestionPack, dryRun bool) (ImportResult, error) {
+	existingGroups, err := q.store.GetGroups(ctx)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	duplicates, err := q.getDuplicateTranslations(ctx, pack)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	result := ImportResult{DryRun: dryRun, Errors: []ImportError{}}
+	args := db.ImportQuestionPackArgs{GameName: DefaultGameName}
+
+	groupNames := map[string]bool{}
+	for _, group := range existingGroups {
+		groupNames[group.GroupName] = true
+	}
+
+	for _, group := range pack.Groups {
+		if strings.TrimSpace(group.Name) == "" {
+			result.Errors = append(result.Errors, ImportError{Message: "group name is required"})
+			continue
+		}
+		if groupNames[group.Name] {
+			continue
+		}
+
+		groupType := group.Type
+		if groupType == "" {
+			groupType = DefaultGroupType
+		}
+
+		groupNames[group.Name] = true
+		args.Groups = append(args.Groups, db.ImportGroupArgs{GroupName: group.Name, GroupType: groupType})
+		result.Groups++
+	}
+
+	// INFO: Tracks which row each translation was first seen in, to report duplicates within the pack.
+	seen := map[translationKey]int{}
+	for i, question := range pack.Questions {
+		row := question.Row
+		if row == 0 {
+			row = i + 1
+		}
+
+		questionErrors := validatePackQuestion(question, row, groupNames, q.defaultLocale)
+		for _, translation := range question.Translations {
+			key := translationKey{text: translation.Text, locale: translation.Locale}
+			if duplicates[key] {
+				questionErrors = append(questionErrors, ImportError{
+					Row:     row,
+					Locale:  translation.Locale,
+					Message: "question already exists",
+				})
+			} else if firstRow, ok := seen[key]; ok {
+				questionErrors = append(questionErrors, ImportError{
+					Row:     row,
+					Locale:  translation.Locale,
+					Message: fmt.Sprintf("question is repeated from row %d", firstRow),
+				})
+			} else {
+				seen[key] = row
+			}
+		}
+
+		if len(questionErrors) > 0 {
+			result.Errors = append(result.Errors, questionErrors...)
+			continue
+		}
+
+		translations := []db.ImportTranslationArgs{}
+		for _, translation := range question.Translations {
+			translations = append(translations, db.ImportTranslationArgs{
+				Text:          translation.Text,
+				Locale:        translation.Locale,
+				AnswerOptions: getAnswerOptions(translation.AnswerOptions),
+			})
+		}
+		args.Questions = append(args.Questions, db.ImportQuestionArgs{
+			GroupName:    question.GroupName,
+			RoundType:    question.RoundType,
+			Enabled:      question.Enabled,
+			Translations: translations,
+		})
+		result.Questions++
+		result.Translations += len(translations)
+	}
+
+	if len(result.Errors) > 0 {
+		return result, ErrInvalidQuestionPack
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	err = q.store.ImportQuestionPack(ctx, args)
+	return result, err
+}
+
+func (q QuestionService) getDuplicateTranslations(
+	ctx context.Context,
+	pack QuestionPack,
+) (map[translationKey]bool, error) {
+	texts := []string{}
+	locales := []string{}
+	for _, question := range pack.Questions {
+		for _, translation := range question.Translations {
+			texts = append(texts, translation.Text)
+			locales = append(locales, translation.Locale)
+		}
+	}
+
+	duplicates := map[translationKey]bool{}
+	if len(texts) == 0 {
+		return duplicates, nil
+	}
+
+	rows, err := q.store.GetDuplicateQuestionTranslations(ctx, db.GetDuplicateQuestionTranslationsParams{
+		Questions: texts,
+		Locales:   locales,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		duplicates[translationKey{text: row.Question, locale: row.Locale}] = true
+	}
+
+	return duplicates, nil
+}
+
+// validatePackQuestion checks everything about the question which doesn't need the database. Questions need a
+// translation in the default locale, as that is what players see when their locale is missing.
+func validatePackQuestion(
+	question PackQuestion,
+	row int,
+	groupNames map[string]bool,
+	defaultLocale string,
+) []ImportError {
+	errs := []ImportError{}
+
+	if !groupNames[question.GroupName] {
+		errs = append(errs, ImportError{Row: row, Message: fmt.Sprintf("group %q does not exist", question.GroupName)})
+	}
+
+	if !slices.Contains(AllRoundTypes(), question.RoundType) {
+		errs = append(errs, ImportError{Row: row, Message: fmt.Sprintf("invalid round type %q", question.RoundType)})
+	}
+
+	hasDefaultLocale := false
+	locales := map[string]bool{}
+	for _, translation := range question.Translations {
+		if translation.Locale == defaultLocale {
+			hasDefaultLocale = true
+		}
+
+		if ctxi18n.Get(i18n.Code(translation.Locale)) == nil {
+			errs = append(errs, ImportError{Row: row, Locale: translation.Locale, Message: "unsupported locale"})
+		} else if locales[translation.Locale] {
+			errs = append(errs, ImportError{Row: row, Locale: translation.Locale, Message: "locale is repeated"})
+		}
+		locales[translation.Locale] = true
+
+		if strings.TrimSpace(translation.Text) == "" {
+			errs = append(errs, ImportError{Row: row, Locale: translation.Locale, Message: "question text is required"})
+		}
+
+		err := validateQuestionAnswerOptions(question.RoundType, translation.AnswerOptions)
+		if err != nil {
+			errs = append(errs, ImportError{Row: row, Locale: translation.Locale, Message: err.Error()})
+		}
+	}
+
+	if !hasDefaultLocale {
+		errs = append(errs, ImportError{
+			Row:     row,
+			Message: fmt.Sprintf("question needs a %s translation", defaultLocale),
+		})
+	}
+
+	return errs
+}
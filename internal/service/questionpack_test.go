@@ -0,0 +1,261 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	mockService "gitlab.com/hmajid2301/banterbus/internal/service/mocks"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+var packGroupID = uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a901"))
+
+func TestQuestionServiceExportPack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully export pack with all translations", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		firstID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a902"))
+		secondID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a903"))
+
+		mockStore.EXPECT().GetGroups(ctx).Return([]db.QuestionsGroup{
+			{ID: packGroupID, GroupName: "animals", GroupType: "questions"},
+			{ID: uuid.Must(uuid.NewV7()), GroupName: "food", GroupType: "questions"},
+		}, nil)
+		mockStore.EXPECT().GetQuestionPack(ctx, "animals").Return([]db.GetQuestionPackRow{
+			{
+				ID:        firstID,
+				RoundType: "free_form",
+				Enabled:   pgtype.Bool{Bool: true, Valid: true},
+				GroupName: "animals",
+				Locale:    "de-DE",
+				Question:  "Was ist dein Lieblingstier?",
+			},
+			{
+				ID:        firstID,
+				RoundType: "free_form",
+				Enabled:   pgtype.Bool{Bool: true, Valid: true},
+				GroupName: "animals",
+				Locale:    "en-GB",
+				Question:  "What is your favourite animal?",
+			},
+			{
+				ID:            secondID,
+				RoundType:     "multiple_choice",
+				Enabled:       pgtype.Bool{Bool: false, Valid: true},
+				GroupName:     "animals",
+				Locale:        "en-GB",
+				Question:      "Cats are better than dogs",
+				AnswerOptions: []string{"Yes", "No"},
+			},
+		}, nil)
+
+		pack, err := srv.ExportPack(ctx, "animals")
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.QuestionPack{
+			Groups: []service.Group{{ID: packGroupID.String(), Name: "animals", Type: "questions"}},
+			Questions: []service.PackQuestion{
+				{
+					Row:       1,
+					GroupName: "animals",
+					RoundType: "free_form",
+					Enabled:   true,
+					Translations: []service.QuestionTranslation{
+						{Text: "Was ist dein Lieblingstier?", Locale: "de-DE", AnswerOptions: []string{}},
+						{Text: "What is your favourite animal?", Locale: "en-GB", AnswerOptions: []string{}},
+					},
+				},
+				{
+					Row:       2,
+					GroupName: "animals",
+					RoundType: "multiple_choice",
+					Translations: []service.QuestionTranslation{
+						{Text: "Cats are better than dogs", Locale: "en-GB", AnswerOptions: []string{"Yes", "No"}},
+					},
+				},
+			},
+		}, pack)
+	})
+}
+
+func TestQuestionServiceImportPack(t *testing.T) {
+	t.Parallel()
+
+	pack := service.QuestionPack{
+		Groups: []service.Group{{Name: "animals"}, {Name: "food", Type: "questions"}},
+		Questions: []service.PackQuestion{
+			{
+				GroupName: "animals",
+				RoundType: "free_form",
+				Enabled:   true,
+				Translations: []service.QuestionTranslation{
+					{Text: "What is your favourite animal?", Locale: "en-GB"},
+					{Text: "Was ist dein Lieblingstier?", Locale: "de-DE"},
+				},
+			},
+			{
+				GroupName: "food",
+				RoundType: "ranking",
+				Translations: []service.QuestionTranslation{
+					{Text: "Rank these foods", Locale: "en-GB", AnswerOptions: []string{"Pizza", "Pasta"}},
+				},
+			},
+		},
+	}
+
+	t.Run("Should successfully import pack", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx, err := getI18nCtx(t.Context())
+		require.NoError(t, err)
+
+		mockStore.EXPECT().GetGroups(ctx).Return([]db.QuestionsGroup{
+			{ID: packGroupID, GroupName: "food", GroupType: "questions"},
+		}, nil)
+		mockStore.EXPECT().GetDuplicateQuestionTranslations(ctx, db.GetDuplicateQuestionTranslationsParams{
+			Questions: []string{"What is your favourite animal?", "Was ist dein Lieblingstier?", "Rank these foods"},
+			Locales:   []string{"en-GB", "de-DE", "en-GB"},
+		}).Return([]db.GetDuplicateQuestionTranslationsRow{}, nil)
+		mockStore.EXPECT().ImportQuestionPack(ctx, db.ImportQuestionPackArgs{
+			GameName: "fibbing_it",
+			Groups:   []db.ImportGroupArgs{{GroupName: "animals", GroupType: "questions"}},
+			Questions: []db.ImportQuestionArgs{
+				{
+					GroupName: "animals",
+					RoundType: "free_form",
+					Enabled:   true,
+					Translations: []db.ImportTranslationArgs{
+						{Text: "What is your favourite animal?", Locale: "en-GB", AnswerOptions: []string{}},
+						{Text: "Was ist dein Lieblingstier?", Locale: "de-DE", AnswerOptions: []string{}},
+					},
+				},
+				{
+					GroupName: "food",
+					RoundType: "ranking",
+					Translations: []db.ImportTranslationArgs{
+						{Text: "Rank these foods", Locale: "en-GB", AnswerOptions: []string{"Pizza", "Pasta"}},
+					},
+				},
+			},
+		}).Return(nil)
+
+		result, err := srv.ImportPack(ctx, pack, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.ImportResult{
+			Groups:       1,
+			Questions:    2,
+			Translations: 3,
+			Errors:       []service.ImportError{},
+		}, result)
+	})
+
+	t.Run("Should only check pack in dry run", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx, err := getI18nCtx(t.Context())
+		require.NoError(t, err)
+
+		mockStore.EXPECT().GetGroups(ctx).Return([]db.QuestionsGroup{}, nil)
+		mockStore.EXPECT().GetDuplicateQuestionTranslations(ctx, db.GetDuplicateQuestionTranslationsParams{
+			Questions: []string{"What is your favourite animal?", "Was ist dein Lieblingstier?", "Rank these foods"},
+			Locales:   []string{"en-GB", "de-DE", "en-GB"},
+		}).Return([]db.GetDuplicateQuestionTranslationsRow{}, nil)
+
+		result, err := srv.ImportPack(ctx, pack, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.ImportResult{
+			DryRun:       true,
+			Groups:       2,
+			Questions:    2,
+			Translations: 3,
+			Errors:       []service.ImportError{},
+		}, result)
+	})
+
+	t.Run("Should fail to import pack, reports errors for each row", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx, err := getI18nCtx(t.Context())
+		require.NoError(t, err)
+
+		invalidPack := service.QuestionPack{
+			Questions: []service.PackQuestion{
+				{
+					Row:       2,
+					GroupName: "animals",
+					RoundType: "free_form",
+					Translations: []service.QuestionTranslation{
+						{Text: "What is your favourite animal?", Locale: "en-GB"},
+					},
+				},
+				{
+					Row:       3,
+					GroupName: "animals",
+					RoundType: "not_a_round_type",
+					Translations: []service.QuestionTranslation{
+						{Text: "Was ist dein Lieblingstier?", Locale: "de-DE"},
+					},
+				},
+				{
+					Row:       4,
+					GroupName: "missing",
+					RoundType: "free_form",
+					Translations: []service.QuestionTranslation{
+						{Text: "What is your favourite animal?", Locale: "en-GB"},
+						{Text: "Qual é o teu animal favorito?", Locale: "xx-XX"},
+					},
+				},
+			},
+		}
+
+		mockStore.EXPECT().GetGroups(ctx).Return([]db.QuestionsGroup{
+			{ID: packGroupID, GroupName: "animals", GroupType: "questions"},
+		}, nil)
+		mockStore.EXPECT().GetDuplicateQuestionTranslations(ctx, db.GetDuplicateQuestionTranslationsParams{
+			Questions: []string{
+				"What is your favourite animal?",
+				"Was ist dein Lieblingstier?",
+				"What is your favourite animal?",
+				"Qual é o teu animal favorito?",
+			},
+			Locales: []string{"en-GB", "de-DE", "en-GB", "xx-XX"},
+		}).Return([]db.GetDuplicateQuestionTranslationsRow{
+			{Question: "What is your favourite animal?", Locale: "en-GB"},
+		}, nil)
+
+		result, err := srv.ImportPack(ctx, invalidPack, false)
+
+		assert.ErrorIs(t, err, service.ErrInvalidQuestionPack)
+		assert.Equal(t, []service.ImportError{
+			{Row: 2, Locale: "en-GB", Message: "question already exists"},
+			{Row: 3, Message: `invalid round type "not_a_round_type"`},
+			{Row: 3, Message: "question needs a en-GB translation"},
+			{Row: 4, Message: `group "missing" does not exist`},
+			{Row: 4, Locale: "xx-XX", Message: "unsupported locale"},
+			{Row: 4, Locale: "en-GB", Message: "question already exists"},
+		}, result.Errors)
+		assert.Equal(t, 0, result.Questions)
+	})
+}
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
 
 	"gitlab.com/hmajid2301/banterbus/internal/store/db"
 )
@@ -12,6 +15,17 @@ const (
 	DefaultGameName = "fibbing_it"
 )
 
+var (
+	ErrQuestionNotFound     = errors.New("question not found")
+	ErrTranslationNotFound  = errors.New("question translation not found")
+	ErrGroupNotFound        = errors.New("group not found")
+	ErrGroupExists          = errors.New("group already exists")
+	ErrDefaultTranslation   = errors.New("default locale translation can't be deleted")
+	ErrQuestionPairExists   = errors.New("question pair already exists")
+	ErrQuestionPairNotFound = errors.New("question pair not found")
+	ErrInvalidQuestionPair  = errors.New("questions in a pair must be different questions with the same round type")
+)
+
 type QuestionStore interface {
 	CreateQuestionWithTranslation(ctx context.Context, arg db.CreateQuestionArgs) (uuid.UUID, error)
 	AddQuestionTranslation(ctx context.Context, arg db.AddQuestionTranslationParams) (db.QuestionsI18n, error)
@@ -20,6 +34,25 @@ type QuestionStore interface {
 	AddGroup(ctx context.Context, arg db.AddGroupParams) (db.QuestionsGroup, error)
 	DisableQuestion(ctx context.Context, id uuid.UUID) (db.Question, error)
 	EnableQuestion(ctx context.Context, id uuid.UUID) (db.Question, error)
+	GetQuestionPack(ctx context.Context, groupName string) ([]db.GetQuestionPackRow, error)
+	GetDuplicateQuestionTranslations(
+		ctx context.Context,
+		arg db.GetDuplicateQuestionTranslationsParams,
+	) ([]db.GetDuplicateQuestionTranslationsRow, error)
+	ImportQuestionPack(ctx context.Context, arg db.ImportQuestionPackArgs) error
+	GetQuestionByID(ctx context.Context, id uuid.UUID) (db.Question, error)
+	GetQuestionWithLocalesById(ctx context.Context, id uuid.UUID) ([]db.GetQuestionWithLocalesByIdRow, error)
+	GetGroupByID(ctx context.Context, id uuid.UUID) (db.QuestionsGroup, error)
+	GetGroupByName(ctx context.Context, groupName string) (db.QuestionsGroup, error)
+	UpdateQuestion(ctx context.Context, arg db.UpdateQuestionParams) (db.Question, error)
+	UpdateQuestionTranslation(ctx context.Context, arg db.UpdateQuestionTranslationParams) (db.QuestionsI18n, error)
+	DeleteQuestionTranslation(ctx context.Context, arg db.DeleteQuestionTranslationParams) (int64, error)
+	DeleteOrArchiveQuestion(ctx context.Context, id uuid.UUID) (bool, error)
+	UpdateGroupName(ctx context.Context, arg db.UpdateGroupNameParams) (db.QuestionsGroup, error)
+	DeleteOrArchiveGroup(ctx context.Context, id uuid.UUID) (bool, error)
+	AddQuestionPair(ctx context.Context, arg db.AddQuestionPairParams) (db.QuestionPair, error)
+	GetQuestionPairs(ctx context.Context, questionID uuid.UUID) ([]db.QuestionPair, error)
+	DeleteQuestionPair(ctx context.Context, arg db.DeleteQuestionPairParams) (int64, error)
 }
 
 type QuestionService struct {
@@ -37,22 +70,30 @@ func (q QuestionService) Add(
 	text string,
 	group string,
 	roundType string,
+	answerOptions []string,
 ) (Question, error) {
+	err := validateQuestionAnswerOptions(roundType, answerOptions)
+	if err != nil {
+		return Question{}, err
+	}
+
 	u, err := q.store.CreateQuestionWithTranslation(ctx, db.CreateQuestionArgs{
-		GameName:  DefaultGameName,
-		GroupName: group,
-		RoundType: roundType,
-		Text:      text,
-		Locale:    q.defaultLocale,
+		GameName:      DefaultGameName,
+		GroupName:     group,
+		RoundType:     roundType,
+		Text:          text,
+		Locale:        q.defaultLocale,
+		AnswerOptions: getAnswerOptions(answerOptions),
 	})
 
 	return Question{
-		ID:        u.String(),
-		Text:      text,
-		GroupName: group,
-		Locale:    q.defaultLocale,
-		RoundType: roundType,
-		Enabled:   true,
+		ID:            u.String(),
+		Text:          text,
+		GroupName:     group,
+		Locale:        q.defaultLocale,
+		RoundType:     roundType,
+		Enabled:       true,
+		AnswerOptions: getAnswerOptions(answerOptions),
 	}, err
 }
 
@@ -61,21 +102,29 @@ func (q QuestionService) AddTranslation(
 	questionID uuid.UUID,
 	text string,
 	locale string,
+	answerOptions []string,
 ) (QuestionTranslation, error) {
+	err := validateAnswerOptions(answerOptions)
+	if err != nil {
+		return QuestionTranslation{}, err
+	}
+
 	u, err := q.randomizer.GetID()
 	if err != nil {
 		return QuestionTranslation{}, err
 	}
 	_, err = q.store.AddQuestionTranslation(ctx, db.AddQuestionTranslationParams{
-		ID:         u,
-		Question:   text,
-		Locale:     locale,
-		QuestionID: questionID,
+		ID:            u,
+		Question:      text,
+		Locale:        locale,
+		QuestionID:    questionID,
+		AnswerOptions: getAnswerOptions(answerOptions),
 	})
 
 	return QuestionTranslation{
-		Text:   text,
-		Locale: locale,
+		Text:          text,
+		Locale:        locale,
+		AnswerOptions: getAnswerOptions(answerOptions),
 	}, err
 }
 
@@ -123,12 +172,13 @@ func (q QuestionService) GetQuestions(
 	questions := []Question{}
 	for _, q := range qq {
 		question := Question{
-			ID:        q.ID.String(),
-			Text:      q.Question,
-			GroupName: q.GroupName,
-			Locale:    q.Locale,
-			RoundType: q.RoundType,
-			Enabled:   q.Enabled.Bool,
+			ID:            q.ID.String(),
+			Text:          q.Question,
+			GroupName:     q.GroupName,
+			Locale:        q.Locale,
+			RoundType:     q.RoundType,
+			Enabled:       q.Enabled.Bool,
+			AnswerOptions: q.AnswerOptions,
 		}
 		questions = append(questions, question)
 	}
@@ -172,3 +222,269 @@ func (q QuestionService) EnableQuestion(ctx context.Context, id uuid.UUID) error
 	_, err := q.store.EnableQuestion(ctx, id)
 	return err
 }
+
+// UpdateQuestion moves the question to another group and changes its round type, empty values are left as they are.
+// The answer options of every translation must suit the new round type.
+func (q QuestionService) UpdateQuestion(ctx context.Context, id uuid.UUID, groupName string, roundType string) error {
+	question, err := q.store.GetQuestionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return ErrQuestionNotFound
+		}
+		return err
+	}
+
+	groupID := question.GroupID
+	if groupName != "" {
+		group, err := q.store.GetGroupByName(ctx, groupName)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+				return ErrGroupNotFound
+			}
+			return err
+		}
+		groupID = group.ID
+	}
+
+	if roundType == "" {
+		roundType = question.RoundType
+	}
+
+	if roundType != question.RoundType {
+		translations, err := q.store.GetQuestionWithLocalesById(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, translation := range translations {
+			err = validateQuestionAnswerOptions(roundType, translation.AnswerOptions)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = q.store.UpdateQuestion(ctx, db.UpdateQuestionParams{
+		ID:        id,
+		GroupID:   groupID,
+		RoundType: roundType,
+	})
+	return err
+}
+
+func (q QuestionService) UpdateTranslation(
+	ctx context.Context,
+	questionID uuid.UUID,
+	text string,
+	locale string,
+	answerOptions []string,
+) (QuestionTranslation, error) {
+	question, err := q.store.GetQuestionByID(ctx, questionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return QuestionTranslation{}, ErrQuestionNotFound
+		}
+		return QuestionTranslation{}, err
+	}
+
+	err = validateQuestionAnswerOptions(question.RoundType, answerOptions)
+	if err != nil {
+		return QuestionTranslation{}, err
+	}
+
+	_, err = q.store.UpdateQuestionTranslation(ctx, db.UpdateQuestionTranslationParams{
+		Question:      text,
+		AnswerOptions: getAnswerOptions(answerOptions),
+		QuestionID:    questionID,
+		Locale:        locale,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return QuestionTranslation{}, ErrTranslationNotFound
+		}
+		return QuestionTranslation{}, err
+	}
+
+	return QuestionTranslation{
+		Text:          text,
+		Locale:        locale,
+		AnswerOptions: getAnswerOptions(answerOptions),
+	}, nil
+}
+
+// DeleteTranslation deletes the question in one locale. The default locale can't be deleted, as it is what players
+// see when their own locale is missing, delete the question instead.
+func (q QuestionService) DeleteTranslation(ctx context.Context, questionID uuid.UUID, locale string) error {
+	if locale == q.defaultLocale {
+		return ErrDefaultTranslation
+	}
+
+	deleted, err := q.store.DeleteQuestionTranslation(ctx, db.DeleteQuestionTranslationParams{
+		QuestionID: questionID,
+		Locale:     locale,
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrTranslationNotFound
+	}
+	return nil
+}
+
+// DeleteQuestion deletes the question, or archives it if it has been used in a round. Archived questions aren't
+// picked for new rounds and are hidden from every list. Returns true if the question was archived.
+func (q QuestionService) DeleteQuestion(ctx context.Context, id uuid.UUID) (bool, error) {
+	_, err := q.store.GetQuestionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return false, ErrQuestionNotFound
+		}
+		return false, err
+	}
+
+	return q.store.DeleteOrArchiveQuestion(ctx, id)
+}
+
+func (q QuestionService) UpdateGroup(ctx context.Context, id uuid.UUID, name string) (Group, error) {
+	existing, err := q.store.GetGroupByName(ctx, name)
+	if err == nil && existing.ID != id {
+		return Group{}, ErrGroupExists
+	} else if err != nil && !errors.Is(err, sql.ErrNoRows) && !errors.Is(err, pgx.ErrNoRows) {
+		return Group{}, err
+	}
+
+	group, err := q.store.UpdateGroupName(ctx, db.UpdateGroupNameParams{ID: id, GroupName: name})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return Group{}, ErrGroupNotFound
+		}
+		return Group{}, err
+	}
+
+	return Group{
+		ID:   group.ID.String(),
+		Name: group.GroupName,
+		Type: group.GroupType,
+	}, nil
+}
+
+// DeleteGroup deletes the group and its questions. Like DeleteQuestion, questions used in a round are archived, and
+// then so is the group. Returns true if the group was archived.
+func (q QuestionService) DeleteGroup(ctx context.Context, id uuid.UUID) (bool, error) {
+	_, err := q.store.GetGroupByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return false, ErrGroupNotFound
+		}
+		return false, err
+	}
+
+	return q.store.DeleteOrArchiveGroup(ctx, id)
+}
+
+// AddPair links a question shown to the normal players with the question shown to the fibbers, so they are picked
+// together for a round. Both questions must be of the same game and round type, so the answers can be compared.
+func (q QuestionService) AddPair(
+	ctx context.Context,
+	normalQuestionID uuid.UUID,
+	fibberQuestionID uuid.UUID,
+) (QuestionPair, error) {
+	if normalQuestionID == fibberQuestionID {
+		return QuestionPair{}, ErrInvalidQuestionPair
+	}
+
+	normal, err := q.store.GetQuestionByID(ctx, normalQuestionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return QuestionPair{}, ErrQuestionNotFound
+		}
+		return QuestionPair{}, err
+	}
+
+	fibber, err := q.store.GetQuestionByID(ctx, fibberQuestionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return QuestionPair{}, ErrQuestionNotFound
+		}
+		return QuestionPair{}, err
+	}
+
+	if normal.GameName != fibber.GameName || normal.RoundType != fibber.RoundType {
+		return QuestionPair{}, ErrInvalidQuestionPair
+	}
+
+	pairs, err := q.store.GetQuestionPairs(ctx, normalQuestionID)
+	if err != nil {
+		return QuestionPair{}, err
+	}
+
+	for _, pair := range pairs {
+		if pair.NormalQuestionID == normalQuestionID && pair.FibberQuestionID == fibberQuestionID {
+			return QuestionPair{}, ErrQuestionPairExists
+		}
+	}
+
+	u, err := q.randomizer.GetID()
+	if err != nil {
+		return QuestionPair{}, err
+	}
+
+	pair, err := q.store.AddQuestionPair(ctx, db.AddQuestionPairParams{
+		ID:               u,
+		NormalQuestionID: normalQuestionID,
+		FibberQuestionID: fibberQuestionID,
+	})
+	if err != nil {
+		return QuestionPair{}, err
+	}
+
+	return newQuestionPair(pair), nil
+}
+
+// GetPairs returns every pair the question is in, either as the normal or the fibber question.
+func (q QuestionService) GetPairs(ctx context.Context, questionID uuid.UUID) ([]QuestionPair, error) {
+	_, err := q.store.GetQuestionByID(ctx, questionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrQuestionNotFound
+		}
+		return nil, err
+	}
+
+	pairsDB, err := q.store.GetQuestionPairs(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := []QuestionPair{}
+	for _, pair := range pairsDB {
+		pairs = append(pairs, newQuestionPair(pair))
+	}
+
+	return pairs, nil
+}
+
+func (q QuestionService) RemovePair(ctx context.Context, normalQuestionID uuid.UUID, fibberQuestionID uuid.UUID) error {
+	deleted, err := q.store.DeleteQuestionPair(ctx, db.DeleteQuestionPairParams{
+		NormalQuestionID: normalQuestionID,
+		FibberQuestionID: fibberQuestionID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrQuestionPairNotFound
+	}
+	return nil
+}
+
+func newQuestionPair(pair db.QuestionPair) QuestionPair {
+	return QuestionPair{
+		ID:               pair.ID.String(),
+		NormalQuestionID: pair.NormalQuestionID.String(),
+		FibberQuestionID: pair.FibberQuestionID.String(),
+	}
+}
@@ -43,15 +43,16 @@ func TestIntegrationQuestionServiceAdd(t *testing.T) {
 
 		questionText := getDefaultText()
 		questionGroup := getDefaultGroup()
-		q, err := questionService.Add(ctx, questionText, questionGroup, defaultRoundType)
+		q, err := questionService.Add(ctx, questionText, questionGroup, defaultRoundType, nil)
 		assert.NoError(t, err)
 		expectedQuestion := service.Question{
-			ID:        q.ID,
-			Text:      questionText,
-			GroupName: questionGroup,
-			Locale:    "en-GB",
-			RoundType: defaultRoundType,
-			Enabled:   true,
+			ID:            q.ID,
+			Text:          questionText,
+			GroupName:     questionGroup,
+			Locale:        "en-GB",
+			RoundType:     defaultRoundType,
+			Enabled:       true,
+			AnswerOptions: []string{},
 		}
 
 		assert.Equal(t, expectedQuestion, q)
@@ -76,7 +77,7 @@ func TestIntegrationQuestionServiceAdd(t *testing.T) {
 // 		group := "cat"
 // 		roundType := "free_form"
 //
-// 		q, err := questionService.Add(ctx, text, group, roundType)
+// 		q, err := questionService.Add(ctx, text, group, roundType, nil)
 //
 // 		textTranslation := "what do you think of cats"
 // 		q, err := questionService.AddTranslation(ctx, q.questionID, textTranslation, "pt-PT")
@@ -149,180 +150,202 @@ func TestIntegrationQuestionGetQuestions(t *testing.T) {
 
 		expectedQuestions := []service.Question{
 			{
-				ID:        "4b1355bb-82de-40c8-8eda-0c634091cc3c",
-				Text:      "to get arrested",
-				GroupName: "person",
-				Locale:    "en-GB",
-				RoundType: "most_likely",
-				Enabled:   true,
-			},
-			{
-				ID:        "a91af98c-f989-4e00-aa14-7a34e732519e",
-				Text:      "to eat ice-cream from the tub",
-				GroupName: "person",
-				Locale:    "en-GB",
-				RoundType: "most_likely",
-				Enabled:   true,
-			},
-			{
-				ID:        "fac6a98f-e3b5-4328-999c-b39fd86657ba",
-				Text:      "to fight a police officer",
-				GroupName: "person",
-				Locale:    "en-GB",
-				RoundType: "most_likely",
-				Enabled:   true,
-			},
-			{
-				ID:        "6b60f097-b714-4f9e-b8cb-de75a7890381",
-				Text:      "to steal a horse",
-				GroupName: "horse",
-				Locale:    "en-GB",
-				RoundType: "most_likely",
-				Enabled:   true,
-			},
-			{
-				ID:        "6b60f097-b714-4f9e-b8cb-de75a7890382",
-				Text:      "to ride a horse",
-				GroupName: "horse",
-				Locale:    "en-GB",
-				RoundType: "most_likely",
-				Enabled:   true,
-			},
-			{
-				ID:        "93dd56a8-c8a3-4c63-93dc-9d890c4d2b74",
-				Text:      "What do you think about programmers",
-				GroupName: "programming",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "066e7a8a-b0b7-44d4-b882-582a64151c15",
-				Text:      "What don't you like about programmers",
-				GroupName: "programming",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "654327b9-36a2-4d75-b4bf-d68d19fcfe7c",
-				Text:      "what don't you think about programmers",
-				GroupName: "programming",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "281bc3c7-f55d-4a8a-88cf-4e0d67d2825e",
-				Text:      "what dont you think about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "fc1a3c9f-3d98-452e-b77e-c6c7f353176d",
-				Text:      "what don't you like about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "393dae17-84fe-449d-ba0f-8c9d320a46e6",
-				Text:      "what do you like about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "393dae17-84fe-449d-ba0f-8c9d320a46e7",
-				Text:      "what do you think about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730348",
-				Text:      "Favourite bike colour",
-				GroupName: "bike",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730350",
-				Text:      "Who would win in a fight a bike or a car",
-				GroupName: "bike",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730351",
-				Text:      "What color bike do you prefer",
-				GroupName: "bike",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730352",
-				Text:      "How fast can a bike go",
-				GroupName: "bike",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730353",
-				Text:      "What is your favorite food",
-				GroupName: "food",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730354",
-				Text:      "What food do you dislike",
-				GroupName: "food",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "89b20c84-12ae-444d-ad9c-26f72d3f28ab",
-				Text:      "What do you think about camels",
-				GroupName: "horse",
-				Locale:    "en-GB",
-				RoundType: "multiple_choice",
-				Enabled:   true,
-			},
-			{
-				ID:        "68ed9133-dc58-41bb-b642-c48470998127",
-				Text:      "What do you think about horses",
-				GroupName: "horse",
-				Locale:    "en-GB",
-				RoundType: "multiple_choice",
-				Enabled:   true,
-			},
-			{
-				ID:        "e90d613d-2e6c-4331-9204-9b685c0795b7",
-				Text:      "Are cats cute",
-				GroupName: "animal",
-				Locale:    "en-GB",
-				RoundType: "multiple_choice",
-				Enabled:   true,
-			},
-			{
-				ID:        "89deb03f-66be-4265-91e6-dedd9227718a",
-				Text:      "Dogs are cuter than cats",
-				GroupName: "animal",
-				Locale:    "en-GB",
-				RoundType: "multiple_choice",
-				Enabled:   true,
+				ID:            "4b1355bb-82de-40c8-8eda-0c634091cc3c",
+				Text:          "to get arrested",
+				GroupName:     "person",
+				Locale:        "en-GB",
+				RoundType:     "most_likely",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "a91af98c-f989-4e00-aa14-7a34e732519e",
+				Text:          "to eat ice-cream from the tub",
+				GroupName:     "person",
+				Locale:        "en-GB",
+				RoundType:     "most_likely",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "fac6a98f-e3b5-4328-999c-b39fd86657ba",
+				Text:          "to fight a police officer",
+				GroupName:     "person",
+				Locale:        "en-GB",
+				RoundType:     "most_likely",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "6b60f097-b714-4f9e-b8cb-de75a7890381",
+				Text:          "to steal a horse",
+				GroupName:     "horse",
+				Locale:        "en-GB",
+				RoundType:     "most_likely",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "6b60f097-b714-4f9e-b8cb-de75a7890382",
+				Text:          "to ride a horse",
+				GroupName:     "horse",
+				Locale:        "en-GB",
+				RoundType:     "most_likely",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "93dd56a8-c8a3-4c63-93dc-9d890c4d2b74",
+				Text:          "What do you think about programmers",
+				GroupName:     "programming",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "066e7a8a-b0b7-44d4-b882-582a64151c15",
+				Text:          "What don't you like about programmers",
+				GroupName:     "programming",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "654327b9-36a2-4d75-b4bf-d68d19fcfe7c",
+				Text:          "what don't you think about programmers",
+				GroupName:     "programming",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "281bc3c7-f55d-4a8a-88cf-4e0d67d2825e",
+				Text:          "what dont you think about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "fc1a3c9f-3d98-452e-b77e-c6c7f353176d",
+				Text:          "what don't you like about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "393dae17-84fe-449d-ba0f-8c9d320a46e6",
+				Text:          "what do you like about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "393dae17-84fe-449d-ba0f-8c9d320a46e7",
+				Text:          "what do you think about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730348",
+				Text:          "Favourite bike colour",
+				GroupName:     "bike",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730350",
+				Text:          "Who would win in a fight a bike or a car",
+				GroupName:     "bike",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730351",
+				Text:          "What color bike do you prefer",
+				GroupName:     "bike",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730352",
+				Text:          "How fast can a bike go",
+				GroupName:     "bike",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730353",
+				Text:          "What is your favorite food",
+				GroupName:     "food",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730354",
+				Text:          "What food do you dislike",
+				GroupName:     "food",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "89b20c84-12ae-444d-ad9c-26f72d3f28ab",
+				Text:          "What do you think about camels",
+				GroupName:     "horse",
+				Locale:        "en-GB",
+				RoundType:     "multiple_choice",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "68ed9133-dc58-41bb-b642-c48470998127",
+				Text:          "What do you think about horses",
+				GroupName:     "horse",
+				Locale:        "en-GB",
+				RoundType:     "multiple_choice",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "e90d613d-2e6c-4331-9204-9b685c0795b7",
+				Text:          "Are cats cute",
+				GroupName:     "animal",
+				Locale:        "en-GB",
+				RoundType:     "multiple_choice",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "89deb03f-66be-4265-91e6-dedd9227718a",
+				Text:          "Dogs are cuter than cats",
+				GroupName:     "animal",
+				Locale:        "en-GB",
+				RoundType:     "multiple_choice",
+				Enabled:       true,
+				AnswerOptions: []string{},
 			},
 		}
 		assert.Len(t, questions, len(expectedQuestions))
@@ -351,20 +374,22 @@ func TestIntegrationQuestionGetQuestions(t *testing.T) {
 
 		expectedQuestions := []service.Question{
 			{
-				ID:        "e90d613d-2e6c-4331-9204-9b685c0795b7",
-				Text:      "Are cats cute",
-				GroupName: "animal",
-				Locale:    "en-GB",
-				RoundType: "multiple_choice",
-				Enabled:   true,
+				ID:            "e90d613d-2e6c-4331-9204-9b685c0795b7",
+				Text:          "Are cats cute",
+				GroupName:     "animal",
+				Locale:        "en-GB",
+				RoundType:     "multiple_choice",
+				Enabled:       true,
+				AnswerOptions: []string{},
 			},
 			{
-				ID:        "89deb03f-66be-4265-91e6-dedd9227718a",
-				Text:      "Dogs are cuter than cats",
-				GroupName: "animal",
-				Locale:    "en-GB",
-				RoundType: "multiple_choice",
-				Enabled:   true,
+				ID:            "89deb03f-66be-4265-91e6-dedd9227718a",
+				Text:          "Dogs are cuter than cats",
+				GroupName:     "animal",
+				Locale:        "en-GB",
+				RoundType:     "multiple_choice",
+				Enabled:       true,
+				AnswerOptions: []string{},
 			},
 		}
 		assert.Equal(t, expectedQuestions, questions)
@@ -392,108 +417,121 @@ func TestIntegrationQuestionGetQuestions(t *testing.T) {
 
 		expectedQuestions := []service.Question{
 			{
-				ID:        "93dd56a8-c8a3-4c63-93dc-9d890c4d2b74",
-				Text:      "What do you think about programmers",
-				GroupName: "programming",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "066e7a8a-b0b7-44d4-b882-582a64151c15",
-				Text:      "What don't you like about programmers",
-				GroupName: "programming",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "654327b9-36a2-4d75-b4bf-d68d19fcfe7c",
-				Text:      "what don't you think about programmers",
-				GroupName: "programming",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "281bc3c7-f55d-4a8a-88cf-4e0d67d2825e",
-				Text:      "what dont you think about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "fc1a3c9f-3d98-452e-b77e-c6c7f353176d",
-				Text:      "what don't you like about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "393dae17-84fe-449d-ba0f-8c9d320a46e6",
-				Text:      "what do you like about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "393dae17-84fe-449d-ba0f-8c9d320a46e7",
-				Text:      "what do you think about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730348",
-				Text:      "Favourite bike colour",
-				GroupName: "bike",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730350",
-				Text:      "Who would win in a fight a bike or a car",
-				GroupName: "bike",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730351",
-				Text:      "What color bike do you prefer",
-				GroupName: "bike",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730352",
-				Text:      "How fast can a bike go",
-				GroupName: "bike",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730353",
-				Text:      "What is your favorite food",
-				GroupName: "food",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "8aa9f87f-31d9-4421-aae5-2024ca730354",
-				Text:      "What food do you dislike",
-				GroupName: "food",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
+				ID:            "93dd56a8-c8a3-4c63-93dc-9d890c4d2b74",
+				Text:          "What do you think about programmers",
+				GroupName:     "programming",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "066e7a8a-b0b7-44d4-b882-582a64151c15",
+				Text:          "What don't you like about programmers",
+				GroupName:     "programming",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "654327b9-36a2-4d75-b4bf-d68d19fcfe7c",
+				Text:          "what don't you think about programmers",
+				GroupName:     "programming",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "281bc3c7-f55d-4a8a-88cf-4e0d67d2825e",
+				Text:          "what dont you think about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "fc1a3c9f-3d98-452e-b77e-c6c7f353176d",
+				Text:          "what don't you like about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "393dae17-84fe-449d-ba0f-8c9d320a46e6",
+				Text:          "what do you like about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "393dae17-84fe-449d-ba0f-8c9d320a46e7",
+				Text:          "what do you think about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730348",
+				Text:          "Favourite bike colour",
+				GroupName:     "bike",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730350",
+				Text:          "Who would win in a fight a bike or a car",
+				GroupName:     "bike",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730351",
+				Text:          "What color bike do you prefer",
+				GroupName:     "bike",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730352",
+				Text:          "How fast can a bike go",
+				GroupName:     "bike",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730353",
+				Text:          "What is your favorite food",
+				GroupName:     "food",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "8aa9f87f-31d9-4421-aae5-2024ca730354",
+				Text:          "What food do you dislike",
+				GroupName:     "food",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
 			},
 		}
 		assert.Len(t, questions, len(expectedQuestions))
@@ -523,36 +561,40 @@ func TestIntegrationQuestionGetQuestions(t *testing.T) {
 
 		expectedQuestions := []service.Question{
 			{
-				ID:        "281bc3c7-f55d-4a8a-88cf-4e0d67d2825e",
-				Text:      "what dont you think about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "fc1a3c9f-3d98-452e-b77e-c6c7f353176d",
-				Text:      "what don't you like about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "393dae17-84fe-449d-ba0f-8c9d320a46e6",
-				Text:      "what do you like about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
-			},
-			{
-				ID:        "393dae17-84fe-449d-ba0f-8c9d320a46e7",
-				Text:      "what do you think about cats",
-				GroupName: "cat",
-				Locale:    "en-GB",
-				RoundType: "free_form",
-				Enabled:   true,
+				ID:            "281bc3c7-f55d-4a8a-88cf-4e0d67d2825e",
+				Text:          "what dont you think about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "fc1a3c9f-3d98-452e-b77e-c6c7f353176d",
+				Text:          "what don't you like about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "393dae17-84fe-449d-ba0f-8c9d320a46e6",
+				Text:          "what do you like about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
+			},
+			{
+				ID:            "393dae17-84fe-449d-ba0f-8c9d320a46e7",
+				Text:          "what do you think about cats",
+				GroupName:     "cat",
+				Locale:        "en-GB",
+				RoundType:     "free_form",
+				Enabled:       true,
+				AnswerOptions: []string{},
 			},
 		}
 		assert.Equal(t, expectedQuestions, questions)
@@ -579,12 +621,13 @@ func TestIntegrationQuestionGetQuestions(t *testing.T) {
 
 		expectedQuestions := []service.Question{
 			{
-				ID:        questions[0].ID,
-				Text:      "to get arrested",
-				GroupName: "person",
-				Locale:    "en-GB",
-				RoundType: "most_likely",
-				Enabled:   true,
+				ID:            questions[0].ID,
+				Text:          "to get arrested",
+				GroupName:     "person",
+				Locale:        "en-GB",
+				RoundType:     "most_likely",
+				Enabled:       true,
+				AnswerOptions: []string{},
 			},
 		}
 		assert.Equal(t, expectedQuestions, questions)
@@ -668,7 +711,7 @@ func TestIntegrationQuestionDisableQuestion(t *testing.T) {
 		group := "cat"
 		roundType := "free_form"
 
-		q, err := questionService.Add(ctx, text, group, roundType)
+		q, err := questionService.Add(ctx, text, group, roundType, nil)
 		require.NoError(t, err)
 
 		err = questionService.DisableQuestion(ctx, uuid.Must(uuid.FromString(q.ID)))
@@ -697,7 +740,7 @@ func TestIntegrationQuestionEnableQuestion(t *testing.T) {
 		group := "cat"
 		roundType := "free_form"
 
-		q, err := questionService.Add(ctx, text, group, roundType)
+		q, err := questionService.Add(ctx, text, group, roundType, nil)
 		require.NoError(t, err)
 
 		err = questionService.DisableQuestion(ctx, uuid.Must(uuid.FromString(q.ID)))
@@ -707,3 +750,78 @@ func TestIntegrationQuestionEnableQuestion(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestIntegrationQuestionDeleteQuestion(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully delete question not used in a round", func(t *testing.T) {
+		t.Parallel()
+		pool, teardown := setupSubtest(t)
+		t.Cleanup(teardown)
+
+		baseDelay := (time.Millisecond * 100)
+		str := db.NewDB(pool, 3, baseDelay)
+		randomizer := randomizer.NewUserRandomizer()
+
+		ctx, err := getI18nCtx(t.Context())
+		require.NoError(t, err)
+
+		questionService := service.NewQuestionService(str, randomizer, "en-GB")
+
+		q, err := questionService.Add(ctx, getDefaultText(), getDefaultGroup(), defaultRoundType, nil)
+		require.NoError(t, err)
+
+		archived, err := questionService.DeleteQuestion(ctx, uuid.Must(uuid.FromString(q.ID)))
+		assert.NoError(t, err)
+		assert.False(t, archived)
+
+		_, err = questionService.DeleteQuestion(ctx, uuid.Must(uuid.FromString(q.ID)))
+		assert.ErrorIs(t, err, service.ErrQuestionNotFound)
+	})
+}
+
+func TestIntegrationQuestionPairs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully add, get and remove pair", func(t *testing.T) {
+		t.Parallel()
+		pool, teardown := setupSubtest(t)
+		t.Cleanup(teardown)
+
+		baseDelay := (time.Millisecond * 100)
+		str := db.NewDB(pool, 3, baseDelay)
+		randomizer := randomizer.NewUserRandomizer()
+
+		ctx, err := getI18nCtx(t.Context())
+		require.NoError(t, err)
+
+		questionService := service.NewQuestionService(str, randomizer, "en-GB")
+
+		normal, err := questionService.Add(ctx, getDefaultText(), getDefaultGroup(), defaultRoundType, nil)
+		require.NoError(t, err)
+		fibber, err := questionService.Add(ctx, getDefaultText(), getDefaultGroup(), defaultRoundType, nil)
+		require.NoError(t, err)
+
+		normalID := uuid.Must(uuid.FromString(normal.ID))
+		fibberID := uuid.Must(uuid.FromString(fibber.ID))
+
+		pair, err := questionService.AddPair(ctx, normalID, fibberID)
+		require.NoError(t, err)
+		assert.Equal(t, normal.ID, pair.NormalQuestionID)
+		assert.Equal(t, fibber.ID, pair.FibberQuestionID)
+
+		_, err = questionService.AddPair(ctx, normalID, fibberID)
+		assert.ErrorIs(t, err, service.ErrQuestionPairExists)
+
+		pairs, err := questionService.GetPairs(ctx, fibberID)
+		assert.NoError(t, err)
+		assert.Equal(t, []service.QuestionPair{pair}, pairs)
+
+		err = questionService.RemovePair(ctx, normalID, fibberID)
+		assert.NoError(t, err)
+
+		pairs, err = questionService.GetPairs(ctx, fibberID)
+		assert.NoError(t, err)
+		assert.Empty(t, pairs)
+	})
+}
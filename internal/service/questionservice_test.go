@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -30,27 +31,126 @@ func TestQuestionServiceAdd(t *testing.T) {
 		text := "an example question"
 
 		mockStore.EXPECT().CreateQuestionWithTranslation(ctx, db.CreateQuestionArgs{
-			GameName:  "fibbing_it",
-			GroupName: groupName,
-			RoundType: roundType,
-			Text:      text,
-			Locale:    "en-GB",
+			GameName:      "fibbing_it",
+			GroupName:     groupName,
+			RoundType:     roundType,
+			Text:          text,
+			Locale:        "en-GB",
+			AnswerOptions: []string{},
 		}).Return(uuid.UUID{}, nil)
 
-		question, err := srv.Add(ctx, text, groupName, roundType)
+		question, err := srv.Add(ctx, text, groupName, roundType, nil)
 		assert.NoError(t, err)
 
 		expectedQuestion := service.Question{
-			ID:        question.ID,
-			Text:      text,
-			GroupName: groupName,
-			Locale:    "en-GB",
-			RoundType: roundType,
-			Enabled:   true,
+			ID:            question.ID,
+			Text:          text,
+			GroupName:     groupName,
+			Locale:        "en-GB",
+			RoundType:     roundType,
+			Enabled:       true,
+			AnswerOptions: []string{},
 		}
 		assert.Equal(t, expectedQuestion, question)
 	})
 
+	t.Run("Should successfully add new question with answer options", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		roundType := "multiple_choice"
+		groupName := "example"
+		text := "how often do you go to the gym"
+		answerOptions := []string{"Never", "Sometimes", "Always"}
+
+		mockStore.EXPECT().CreateQuestionWithTranslation(ctx, db.CreateQuestionArgs{
+			GameName:      "fibbing_it",
+			GroupName:     groupName,
+			RoundType:     roundType,
+			Text:          text,
+			Locale:        "en-GB",
+			AnswerOptions: answerOptions,
+		}).Return(uuid.UUID{}, nil)
+
+		question, err := srv.Add(ctx, text, groupName, roundType, answerOptions)
+		assert.NoError(t, err)
+		assert.Equal(t, answerOptions, question.AnswerOptions)
+	})
+
+	t.Run("Should successfully add new ranking question", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		text := "rank these drinks from best to worst"
+		items := []string{"Tea", "Coffee", "Water"}
+
+		mockStore.EXPECT().CreateQuestionWithTranslation(ctx, db.CreateQuestionArgs{
+			GameName:      "fibbing_it",
+			GroupName:     "example",
+			RoundType:     "ranking",
+			Text:          text,
+			Locale:        "en-GB",
+			AnswerOptions: items,
+		}).Return(uuid.UUID{}, nil)
+
+		question, err := srv.Add(ctx, text, "example", "ranking", items)
+		assert.NoError(t, err)
+		assert.Equal(t, items, question.AnswerOptions)
+	})
+
+	t.Run("Should fail to add new question, answer options on free form question", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		_, err := srv.Add(t.Context(), "an example question", "example", "free_form", []string{"Yes", "No"})
+		assert.ErrorIs(t, err, service.ErrInvalidAnswerOptions)
+	})
+
+	t.Run("Should fail to add new question, ranking question without items", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		_, err := srv.Add(t.Context(), "rank these drinks from best to worst", "example", "ranking", nil)
+		assert.ErrorIs(t, err, service.ErrInvalidAnswerOptions)
+	})
+
+	tests := []struct {
+		name          string
+		answerOptions []string
+	}{
+		{name: "only one option", answerOptions: []string{"Yes"}},
+		{name: "empty option", answerOptions: []string{"Yes", " "}},
+		{name: "repeated option", answerOptions: []string{"Yes", "No", "Yes"}},
+		{
+			name:          "too many options",
+			answerOptions: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("Should fail to add new question, "+tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockStore := mockService.NewMockQuestionStore(t)
+			mockRandom := mockService.NewMockRandomizer(t)
+			srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+			_, err := srv.Add(t.Context(), "an example question", "example", "multiple_choice", tt.answerOptions)
+			assert.ErrorIs(t, err, service.ErrInvalidAnswerOptions)
+		})
+	}
+
 	t.Run("Should fail to add new question, db fails", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockQuestionStore(t)
@@ -64,14 +164,15 @@ func TestQuestionServiceAdd(t *testing.T) {
 		text := "an example question"
 
 		mockStore.EXPECT().CreateQuestionWithTranslation(ctx, db.CreateQuestionArgs{
-			GameName:  "fibbing_it",
-			GroupName: groupName,
-			RoundType: roundType,
-			Text:      text,
-			Locale:    "en-GB",
+			GameName:      "fibbing_it",
+			GroupName:     groupName,
+			RoundType:     roundType,
+			Text:          text,
+			Locale:        "en-GB",
+			AnswerOptions: []string{},
 		}).Return(uuid.UUID{}, fmt.Errorf("failed to create question"))
 
-		_, err := srv.Add(ctx, text, groupName, roundType)
+		_, err := srv.Add(ctx, text, groupName, roundType, nil)
 		assert.Error(t, err)
 	})
 }
@@ -97,22 +198,67 @@ func TestQuestionServiceAddTranslation(t *testing.T) {
 		require.NoError(t, err)
 		mockRandom.EXPECT().GetID().Return(u, nil)
 		mockStore.EXPECT().AddQuestionTranslation(ctx, db.AddQuestionTranslationParams{
-			ID:         u,
-			Question:   text,
-			Locale:     locale,
-			QuestionID: questionID,
+			ID:            u,
+			Question:      text,
+			Locale:        locale,
+			QuestionID:    questionID,
+			AnswerOptions: []string{},
 		}).Return(db.QuestionsI18n{}, nil)
 
-		question, err := srv.AddTranslation(ctx, questionID, text, locale)
+		question, err := srv.AddTranslation(ctx, questionID, text, locale, nil)
 		assert.NoError(t, err)
 
 		expectedQuestion := service.QuestionTranslation{
-			Text:   text,
-			Locale: locale,
+			Text:          text,
+			Locale:        locale,
+			AnswerOptions: []string{},
 		}
 		assert.Equal(t, expectedQuestion, question)
 	})
 
+	t.Run("Should successfully add new question translation with answer options", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		text := "portguese words"
+		locale := "pt-PT"
+		answerOptions := []string{"Nunca", "Às vezes", "Sempre"}
+
+		u, err := uuid.NewV7()
+		require.NoError(t, err)
+		questionID, err := uuid.NewV7()
+		require.NoError(t, err)
+		mockRandom.EXPECT().GetID().Return(u, nil)
+		mockStore.EXPECT().AddQuestionTranslation(ctx, db.AddQuestionTranslationParams{
+			ID:            u,
+			Question:      text,
+			Locale:        locale,
+			QuestionID:    questionID,
+			AnswerOptions: answerOptions,
+		}).Return(db.QuestionsI18n{}, nil)
+
+		question, err := srv.AddTranslation(ctx, questionID, text, locale, answerOptions)
+		assert.NoError(t, err)
+		assert.Equal(t, answerOptions, question.AnswerOptions)
+	})
+
+	t.Run("Should fail to add new question translation, repeated answer options", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		questionID, err := uuid.NewV7()
+		require.NoError(t, err)
+
+		_, err = srv.AddTranslation(t.Context(), questionID, "portguese words", "pt-PT", []string{"Sim", "Sim"})
+		assert.ErrorIs(t, err, service.ErrInvalidAnswerOptions)
+	})
+
 	t.Run("Should fail to add new question translation, db fails", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockQuestionStore(t)
@@ -131,13 +277,14 @@ func TestQuestionServiceAddTranslation(t *testing.T) {
 		require.NoError(t, err)
 		mockRandom.EXPECT().GetID().Return(u, nil)
 		mockStore.EXPECT().AddQuestionTranslation(ctx, db.AddQuestionTranslationParams{
-			ID:         u,
-			Question:   text,
-			Locale:     locale,
-			QuestionID: questionID,
+			ID:            u,
+			Question:      text,
+			Locale:        locale,
+			QuestionID:    questionID,
+			AnswerOptions: []string{},
 		}).Return(db.QuestionsI18n{}, fmt.Errorf("failed to add question translation"))
 
-		_, err = srv.AddTranslation(ctx, questionID, text, locale)
+		_, err = srv.AddTranslation(ctx, questionID, text, locale, nil)
 		assert.Error(t, err)
 	})
 }
@@ -411,3 +558,558 @@ func TestQuestionServiceAddGroup(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestQuestionServiceUpdateQuestion(t *testing.T) {
+	t.Parallel()
+
+	questionID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a910"))
+	groupID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a911"))
+
+	t.Run("Should successfully move question to another group and change round type", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		newGroupID := uuid.Must(uuid.NewV7())
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{
+			ID:        questionID,
+			RoundType: "free_form",
+			GroupID:   groupID,
+		}, nil)
+		mockStore.EXPECT().GetGroupByName(ctx, "animals").Return(db.QuestionsGroup{ID: newGroupID}, nil)
+		mockStore.EXPECT().GetQuestionWithLocalesById(ctx, questionID).Return([]db.GetQuestionWithLocalesByIdRow{
+			{Locale: "en-GB", AnswerOptions: []string{}},
+		}, nil)
+		mockStore.EXPECT().UpdateQuestion(ctx, db.UpdateQuestionParams{
+			ID:        questionID,
+			GroupID:   newGroupID,
+			RoundType: "most_likely",
+		}).Return(db.Question{}, nil)
+
+		err := srv.UpdateQuestion(ctx, questionID, "animals", "most_likely")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should keep round type when it is empty", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		newGroupID := uuid.Must(uuid.NewV7())
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{
+			ID:        questionID,
+			RoundType: "free_form",
+			GroupID:   groupID,
+		}, nil)
+		mockStore.EXPECT().GetGroupByName(ctx, "animals").Return(db.QuestionsGroup{ID: newGroupID}, nil)
+		mockStore.EXPECT().UpdateQuestion(ctx, db.UpdateQuestionParams{
+			ID:        questionID,
+			GroupID:   newGroupID,
+			RoundType: "free_form",
+		}).Return(db.Question{}, nil)
+
+		err := srv.UpdateQuestion(ctx, questionID, "animals", "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should fail to update question, answer options don't suit new round type", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{
+			ID:        questionID,
+			RoundType: "multiple_choice",
+			GroupID:   groupID,
+		}, nil)
+		mockStore.EXPECT().GetQuestionWithLocalesById(ctx, questionID).Return([]db.GetQuestionWithLocalesByIdRow{
+			{Locale: "en-GB", AnswerOptions: []string{"Yes", "No"}},
+		}, nil)
+
+		err := srv.UpdateQuestion(ctx, questionID, "", "free_form")
+		assert.ErrorIs(t, err, service.ErrInvalidAnswerOptions)
+	})
+
+	t.Run("Should fail to update question, group does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{ID: questionID}, nil)
+		mockStore.EXPECT().GetGroupByName(ctx, "missing").Return(db.QuestionsGroup{}, pgx.ErrNoRows)
+
+		err := srv.UpdateQuestion(ctx, questionID, "missing", "")
+		assert.ErrorIs(t, err, service.ErrGroupNotFound)
+	})
+
+	t.Run("Should fail to update question, question does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{}, pgx.ErrNoRows)
+
+		err := srv.UpdateQuestion(ctx, questionID, "animals", "")
+		assert.ErrorIs(t, err, service.ErrQuestionNotFound)
+	})
+}
+
+func TestQuestionServiceUpdateTranslation(t *testing.T) {
+	t.Parallel()
+
+	questionID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a910"))
+
+	t.Run("Should successfully update translation", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{
+			ID:        questionID,
+			RoundType: "multiple_choice",
+		}, nil)
+		mockStore.EXPECT().UpdateQuestionTranslation(ctx, db.UpdateQuestionTranslationParams{
+			Question:      "Katzen sind besser als Hunde",
+			AnswerOptions: []string{"Ja", "Nein"},
+			QuestionID:    questionID,
+			Locale:        "de-DE",
+		}).Return(db.QuestionsI18n{}, nil)
+
+		translation, err := srv.UpdateTranslation(
+			ctx,
+			questionID,
+			"Katzen sind besser als Hunde",
+			"de-DE",
+			[]string{"Ja", "Nein"},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, service.QuestionTranslation{
+			Text:          "Katzen sind besser als Hunde",
+			Locale:        "de-DE",
+			AnswerOptions: []string{"Ja", "Nein"},
+		}, translation)
+	})
+
+	t.Run("Should fail to update translation, locale does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{
+			ID:        questionID,
+			RoundType: "free_form",
+		}, nil)
+		mockStore.EXPECT().UpdateQuestionTranslation(ctx, db.UpdateQuestionTranslationParams{
+			Question:      "Qual é o teu animal favorito?",
+			AnswerOptions: []string{},
+			QuestionID:    questionID,
+			Locale:        "pt-PT",
+		}).Return(db.QuestionsI18n{}, pgx.ErrNoRows)
+
+		_, err := srv.UpdateTranslation(ctx, questionID, "Qual é o teu animal favorito?", "pt-PT", nil)
+		assert.ErrorIs(t, err, service.ErrTranslationNotFound)
+	})
+
+	t.Run("Should fail to update translation, invalid answer options", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{
+			ID:        questionID,
+			RoundType: "free_form",
+		}, nil)
+
+		_, err := srv.UpdateTranslation(ctx, questionID, "What is your favourite animal?", "en-GB", []string{"Cat", "Dog"})
+		assert.ErrorIs(t, err, service.ErrInvalidAnswerOptions)
+	})
+}
+
+func TestQuestionServiceDeleteTranslation(t *testing.T) {
+	t.Parallel()
+
+	questionID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a910"))
+
+	t.Run("Should successfully delete translation", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().DeleteQuestionTranslation(ctx, db.DeleteQuestionTranslationParams{
+			QuestionID: questionID,
+			Locale:     "de-DE",
+		}).Return(1, nil)
+
+		err := srv.DeleteTranslation(ctx, questionID, "de-DE")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should fail to delete translation, it does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().DeleteQuestionTranslation(ctx, db.DeleteQuestionTranslationParams{
+			QuestionID: questionID,
+			Locale:     "pt-PT",
+		}).Return(0, nil)
+
+		err := srv.DeleteTranslation(ctx, questionID, "pt-PT")
+		assert.ErrorIs(t, err, service.ErrTranslationNotFound)
+	})
+
+	t.Run("Should fail to delete translation in default locale", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		err := srv.DeleteTranslation(t.Context(), questionID, "en-GB")
+		assert.ErrorIs(t, err, service.ErrDefaultTranslation)
+	})
+}
+
+func TestQuestionServiceDeleteQuestion(t *testing.T) {
+	t.Parallel()
+
+	questionID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a910"))
+
+	t.Run("Should successfully delete question", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{ID: questionID}, nil)
+		mockStore.EXPECT().DeleteOrArchiveQuestion(ctx, questionID).Return(false, nil)
+
+		archived, err := srv.DeleteQuestion(ctx, questionID)
+		assert.NoError(t, err)
+		assert.False(t, archived)
+	})
+
+	t.Run("Should archive question used in a round", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{ID: questionID}, nil)
+		mockStore.EXPECT().DeleteOrArchiveQuestion(ctx, questionID).Return(true, nil)
+
+		archived, err := srv.DeleteQuestion(ctx, questionID)
+		assert.NoError(t, err)
+		assert.True(t, archived)
+	})
+
+	t.Run("Should fail to delete question, question does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{}, pgx.ErrNoRows)
+
+		_, err := srv.DeleteQuestion(ctx, questionID)
+		assert.ErrorIs(t, err, service.ErrQuestionNotFound)
+	})
+}
+
+func TestQuestionServiceUpdateGroup(t *testing.T) {
+	t.Parallel()
+
+	groupID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a911"))
+
+	t.Run("Should successfully rename group", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGroupByName(ctx, "animals").Return(db.QuestionsGroup{}, pgx.ErrNoRows)
+		mockStore.EXPECT().UpdateGroupName(ctx, db.UpdateGroupNameParams{
+			ID:        groupID,
+			GroupName: "animals",
+		}).Return(db.QuestionsGroup{ID: groupID, GroupName: "animals", GroupType: "questions"}, nil)
+
+		group, err := srv.UpdateGroup(ctx, groupID, "animals")
+		assert.NoError(t, err)
+		assert.Equal(t, service.Group{ID: groupID.String(), Name: "animals", Type: "questions"}, group)
+	})
+
+	t.Run("Should fail to rename group, name is used by another group", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGroupByName(ctx, "animals").Return(db.QuestionsGroup{ID: uuid.Must(uuid.NewV7())}, nil)
+
+		_, err := srv.UpdateGroup(ctx, groupID, "animals")
+		assert.ErrorIs(t, err, service.ErrGroupExists)
+	})
+
+	t.Run("Should fail to rename group, group does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGroupByName(ctx, "animals").Return(db.QuestionsGroup{}, pgx.ErrNoRows)
+		mockStore.EXPECT().UpdateGroupName(ctx, db.UpdateGroupNameParams{
+			ID:        groupID,
+			GroupName: "animals",
+		}).Return(db.QuestionsGroup{}, pgx.ErrNoRows)
+
+		_, err := srv.UpdateGroup(ctx, groupID, "animals")
+		assert.ErrorIs(t, err, service.ErrGroupNotFound)
+	})
+}
+
+func TestQuestionServiceDeleteGroup(t *testing.T) {
+	t.Parallel()
+
+	groupID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a911"))
+
+	t.Run("Should archive group with questions used in a round", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGroupByID(ctx, groupID).Return(db.QuestionsGroup{ID: groupID}, nil)
+		mockStore.EXPECT().DeleteOrArchiveGroup(ctx, groupID).Return(true, nil)
+
+		archived, err := srv.DeleteGroup(ctx, groupID)
+		assert.NoError(t, err)
+		assert.True(t, archived)
+	})
+
+	t.Run("Should fail to delete group, group does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGroupByID(ctx, groupID).Return(db.QuestionsGroup{}, pgx.ErrNoRows)
+
+		_, err := srv.DeleteGroup(ctx, groupID)
+		assert.ErrorIs(t, err, service.ErrGroupNotFound)
+	})
+}
+
+func TestQuestionServiceAddPair(t *testing.T) {
+	t.Parallel()
+
+	normalID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a912"))
+	fibberID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a913"))
+	pairID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a914"))
+
+	t.Run("Should successfully add pair", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, normalID).Return(db.Question{
+			ID:        normalID,
+			GameName:  "fibbing_it",
+			RoundType: "free_form",
+		}, nil)
+		mockStore.EXPECT().GetQuestionByID(ctx, fibberID).Return(db.Question{
+			ID:        fibberID,
+			GameName:  "fibbing_it",
+			RoundType: "free_form",
+		}, nil)
+		mockStore.EXPECT().GetQuestionPairs(ctx, normalID).Return([]db.QuestionPair{}, nil)
+		mockRandom.EXPECT().GetID().Return(pairID, nil)
+		mockStore.EXPECT().AddQuestionPair(ctx, db.AddQuestionPairParams{
+			ID:               pairID,
+			NormalQuestionID: normalID,
+			FibberQuestionID: fibberID,
+		}).Return(db.QuestionPair{
+			ID:               pairID,
+			NormalQuestionID: normalID,
+			FibberQuestionID: fibberID,
+		}, nil)
+
+		pair, err := srv.AddPair(ctx, normalID, fibberID)
+		assert.NoError(t, err)
+		assert.Equal(t, service.QuestionPair{
+			ID:               pairID.String(),
+			NormalQuestionID: normalID.String(),
+			FibberQuestionID: fibberID.String(),
+		}, pair)
+	})
+
+	t.Run("Should fail to add pair, question paired with itself", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		_, err := srv.AddPair(t.Context(), normalID, normalID)
+		assert.ErrorIs(t, err, service.ErrInvalidQuestionPair)
+	})
+
+	t.Run("Should fail to add pair, questions have different round types", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, normalID).Return(db.Question{
+			ID:        normalID,
+			GameName:  "fibbing_it",
+			RoundType: "free_form",
+		}, nil)
+		mockStore.EXPECT().GetQuestionByID(ctx, fibberID).Return(db.Question{
+			ID:        fibberID,
+			GameName:  "fibbing_it",
+			RoundType: "multiple_choice",
+		}, nil)
+
+		_, err := srv.AddPair(ctx, normalID, fibberID)
+		assert.ErrorIs(t, err, service.ErrInvalidQuestionPair)
+	})
+
+	t.Run("Should fail to add pair, fibber question does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, normalID).Return(db.Question{ID: normalID}, nil)
+		mockStore.EXPECT().GetQuestionByID(ctx, fibberID).Return(db.Question{}, pgx.ErrNoRows)
+
+		_, err := srv.AddPair(ctx, normalID, fibberID)
+		assert.ErrorIs(t, err, service.ErrQuestionNotFound)
+	})
+
+	t.Run("Should fail to add pair, pair already exists", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, normalID).Return(db.Question{ID: normalID}, nil)
+		mockStore.EXPECT().GetQuestionByID(ctx, fibberID).Return(db.Question{ID: fibberID}, nil)
+		mockStore.EXPECT().GetQuestionPairs(ctx, normalID).Return([]db.QuestionPair{
+			{ID: pairID, NormalQuestionID: normalID, FibberQuestionID: fibberID},
+		}, nil)
+
+		_, err := srv.AddPair(ctx, normalID, fibberID)
+		assert.ErrorIs(t, err, service.ErrQuestionPairExists)
+	})
+}
+
+func TestQuestionServiceGetPairs(t *testing.T) {
+	t.Parallel()
+
+	questionID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a915"))
+	otherID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a916"))
+	pairID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a917"))
+
+	t.Run("Should successfully get pairs", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{ID: questionID}, nil)
+		mockStore.EXPECT().GetQuestionPairs(ctx, questionID).Return([]db.QuestionPair{
+			{ID: pairID, NormalQuestionID: otherID, FibberQuestionID: questionID},
+		}, nil)
+
+		pairs, err := srv.GetPairs(ctx, questionID)
+		assert.NoError(t, err)
+		assert.Equal(t, []service.QuestionPair{
+			{ID: pairID.String(), NormalQuestionID: otherID.String(), FibberQuestionID: questionID.String()},
+		}, pairs)
+	})
+
+	t.Run("Should fail to get pairs, question does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetQuestionByID(ctx, questionID).Return(db.Question{}, pgx.ErrNoRows)
+
+		_, err := srv.GetPairs(ctx, questionID)
+		assert.ErrorIs(t, err, service.ErrQuestionNotFound)
+	})
+}
+
+func TestQuestionServiceRemovePair(t *testing.T) {
+	t.Parallel()
+
+	normalID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a918"))
+	fibberID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a919"))
+
+	t.Run("Should successfully remove pair", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().DeleteQuestionPair(ctx, db.DeleteQuestionPairParams{
+			NormalQuestionID: normalID,
+			FibberQuestionID: fibberID,
+		}).Return(1, nil)
+
+		err := srv.RemovePair(ctx, normalID, fibberID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should fail to remove pair, pair does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockQuestionStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewQuestionService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().DeleteQuestionPair(ctx, db.DeleteQuestionPairParams{
+			NormalQuestionID: normalID,
+			FibberQuestionID: fibberID,
+		}).Return(0, nil)
+
+		err := srv.RemovePair(ctx, normalID, fibberID)
+		assert.ErrorIs(t, err, service.ErrQuestionPairNotFound)
+	})
+}
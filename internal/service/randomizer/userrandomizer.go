@@ -1,8 +1,10 @@
 package randomizer
 
 import (
+	"cmp"
 	"fmt"
 	"math/rand/v2"
+	"slices"
 
 	"github.com/gofrs/uuid/v5"
 )
@@ -107,6 +109,20 @@ func (UserRandomizer) GetID() (uuid.UUID, error) {
 	return uuid.NewV7()
 }
 
-func (UserRandomizer) GetFibberIndex(playersLen int) int {
-	return rand.IntN(playersLen)
+// GetFibberIndexes picks which players are fibbers, no player is picked twice.
+func (UserRandomizer) GetFibberIndexes(playersLen int, fibbers int) []int {
+	fibbers = min(fibbers, playersLen)
+	return rand.Perm(playersLen)[:fibbers]
+}
+
+// GetFairFibberIndexes picks the players who have been the fibber the fewest times, timesFibber is indexed by player.
+func (UserRandomizer) GetFairFibberIndexes(timesFibber []int, fibbers int) []int {
+	// INFO: Shuffle first, so players who have been the fibber the same number of times are picked at random.
+	indexes := rand.Perm(len(timesFibber))
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		return cmp.Compare(timesFibber[a], timesFibber[b])
+	})
+
+	fibbers = min(fibbers, len(indexes))
+	return indexes[:fibbers]
 }
@@ -146,18 +146,41 @@ func TestUserRandomizer(t *testing.T) {
 		}
 	})
 
-	t.Run("Should generate valid fibber index", func(t *testing.T) {
+	t.Run("Should generate valid fibber indexes", func(t *testing.T) {
 		t.Parallel()
 
 		tests := []int{1, 2, 5, 10, 100}
 
 		for _, playerCount := range tests {
-			index := randomizer.GetFibberIndex(playerCount)
-			assert.GreaterOrEqual(t, index, 0)
-			assert.Less(t, index, playerCount)
+			indexes := randomizer.GetFibberIndexes(playerCount, 1)
+			assert.Len(t, indexes, 1)
+			assert.GreaterOrEqual(t, indexes[0], 0)
+			assert.Less(t, indexes[0], playerCount)
 		}
 	})
 
+	t.Run("Should generate unique fibber indexes", func(t *testing.T) {
+		t.Parallel()
+
+		for i := 0; i < 100; i++ {
+			indexes := randomizer.GetFibberIndexes(10, 3)
+			assert.Len(t, indexes, 3)
+			seen := map[int]bool{}
+			for _, index := range indexes {
+				assert.False(t, seen[index], "Index %d should only be picked once", index)
+				assert.Less(t, index, 10)
+				seen[index] = true
+			}
+		}
+	})
+
+	t.Run("Should not pick more fibbers than players", func(t *testing.T) {
+		t.Parallel()
+
+		indexes := randomizer.GetFibberIndexes(2, 5)
+		assert.ElementsMatch(t, []int{0, 1}, indexes)
+	})
+
 	t.Run("Should distribute fibber indices randomly", func(t *testing.T) {
 		t.Parallel()
 
@@ -166,7 +189,7 @@ func TestUserRandomizer(t *testing.T) {
 
 		// Generate many indices and check distribution
 		for i := 0; i < 1000; i++ {
-			index := randomizer.GetFibberIndex(playerCount)
+			index := randomizer.GetFibberIndexes(playerCount, 1)[0]
 			indices[index]++
 		}
 
@@ -177,6 +200,38 @@ func TestUserRandomizer(t *testing.T) {
 			assert.Less(t, count, 300, "Index %d should not appear too frequently", i)
 		}
 	})
+
+	t.Run("Should pick players who have been the fibber the fewest times", func(t *testing.T) {
+		t.Parallel()
+
+		for i := 0; i < 100; i++ {
+			indexes := randomizer.GetFairFibberIndexes([]int{2, 0, 1, 0}, 2)
+			assert.ElementsMatch(t, []int{1, 3}, indexes)
+		}
+	})
+
+	t.Run("Should not pick more fair fibbers than players", func(t *testing.T) {
+		t.Parallel()
+
+		indexes := randomizer.GetFairFibberIndexes([]int{1, 0}, 3)
+		assert.Equal(t, []int{1, 0}, indexes)
+	})
+
+	t.Run("Should pick randomly between players who have been the fibber equally often", func(t *testing.T) {
+		t.Parallel()
+
+		indices := make(map[int]int)
+		for i := 0; i < 1000; i++ {
+			index := randomizer.GetFairFibberIndexes([]int{1, 1, 1, 1}, 1)[0]
+			indices[index]++
+		}
+
+		for i := 0; i < 4; i++ {
+			count := indices[i]
+			assert.Greater(t, count, 200, "Index %d should appear frequently", i)
+			assert.Less(t, count, 300, "Index %d should not appear too frequently", i)
+		}
+	})
 }
 
 // Helper function to check if string contains substring
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// RankingSeparator joins a player's ranking into a single answer, so it can be shown anywhere a normal answer is.
+const RankingSeparator = " > "
+
+var ErrInvalidRanking = errors.New("invalid ranking")
+
+// getRanking parses a ranking answer, a JSON list of the round's items in the order the player put them in.
+func getRanking(answer string, items []string) ([]string, error) {
+	var ranking []string
+	err := json.Unmarshal([]byte(answer), &ranking)
+	if err != nil {
+		return nil, fmt.Errorf("%w: answer must be a list of items", ErrInvalidRanking)
+	}
+
+	if len(ranking) != len(items) {
+		return nil, fmt.Errorf("%w: all %d items must be ranked", ErrInvalidRanking, len(items))
+	}
+
+	for _, item := range items {
+		if !slices.Contains(ranking, item) {
+			return nil, fmt.Errorf("%w: item %s is missing", ErrInvalidRanking, item)
+		}
+	}
+
+	return ranking, nil
+}
+
+// getRankings returns every player's ranking so they can be compared side by side. Returns nil for other round types.
+func getRankings(roundType string, players []PlayerWithVoting, revealedPlayerIDs []uuid.UUID) []PlayerRanking {
+	if roundType != RoundTypeRanking {
+		return nil
+	}
+
+	rankings := []PlayerRanking{}
+	for _, player := range players {
+		if len(player.Ranking) == 0 {
+			continue
+		}
+
+		rankings = append(rankings, PlayerRanking{
+			Nickname:   player.Nickname,
+			Ranking:    player.Ranking,
+			IsRevealed: slices.Contains(revealedPlayerIDs, player.ID),
+		})
+	}
+
+	return rankings
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/invopop/ctxi18n"
+	"github.com/jackc/pgx/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+type RecapStore interface {
+	GetGameState(ctx context.Context, id uuid.UUID) (db.GameState, error)
+	GetRecapRoundsByGameStateID(
+		ctx context.Context,
+		arg db.GetRecapRoundsByGameStateIDParams,
+	) ([]db.GetRecapRoundsByGameStateIDRow, error)
+	GetRecapAnswersByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]db.GetRecapAnswersByGameStateIDRow, error)
+	GetRecapVotesByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]db.GetRecapVotesByGameStateIDRow, error)
+}
+
+// RecapService builds the round by round recap of a finished game, from the answers, votes, roles and scores that
+// were stored while it was played.
+type RecapService struct {
+	store         RecapStore
+	defaultLocale string
+}
+
+func NewRecapService(store RecapStore, defaultLocale string) *RecapService {
+	return &RecapService{store: store, defaultLocale: defaultLocale}
+}
+
+func (r *RecapService) GetRecap(ctx context.Context, gameStateID uuid.UUID) (GameRecap, error) {
+	game, err := r.store.GetGameState(ctx, gameStateID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return GameRecap{}, ErrGameNotFound
+		}
+		return GameRecap{}, err
+	}
+
+	// The recap shows who the fibbers were, so it can't be seen until the game is over.
+	if game.State != db.FibbingItWinner.String() {
+		return GameRecap{}, ErrGameNotOver
+	}
+
+	locale := r.defaultLocale
+	if l := ctxi18n.Locale(ctx); l != nil {
+		locale = l.Code().String()
+	}
+
+	rounds, err := r.store.GetRecapRoundsByGameStateID(ctx, db.GetRecapRoundsByGameStateIDParams{
+		Locale:        locale,
+		DefaultLocale: r.defaultLocale,
+		GameStateID:   gameStateID,
+	})
+	if err != nil {
+		return GameRecap{}, err
+	}
+
+	answers, err := r.store.GetRecapAnswersByGameStateID(ctx, gameStateID)
+	if err != nil {
+		return GameRecap{}, err
+	}
+
+	votes, err := r.store.GetRecapVotesByGameStateID(ctx, gameStateID)
+	if err != nil {
+		return GameRecap{}, err
+	}
+
+	recap := GameRecap{
+		GameStateID: gameStateID,
+		Rounds:      []RoundRecap{},
+		Awards:      getAwards(answers, votes),
+	}
+
+	roundIndexes := map[uuid.UUID]int{}
+	for i, round := range rounds {
+		roundIndexes[round.ID] = i
+		recap.Rounds = append(recap.Rounds, RoundRecap{
+			Round:          int(round.Round),
+			RoundType:      round.RoundType,
+			NormalQuestion: round.NormalQuestion,
+			FibberQuestion: round.FibberQuestion,
+			Players:        []PlayerRecap{},
+			Votes:          []VoteRecap{},
+		})
+	}
+
+	for _, answer := range answers {
+		i, ok := roundIndexes[answer.RoundID]
+		if !ok {
+			continue
+		}
+
+		recap.Rounds[i].Players = append(recap.Rounds[i].Players, PlayerRecap{
+			ID:       answer.PlayerID,
+			Nickname: answer.Nickname,
+			Avatar:   answer.Avatar,
+			Role:     answer.Role,
+			Answer:   answer.Answer,
+			Ranking:  answer.Ranking,
+			Points:   int(answer.Score),
+		})
+	}
+
+	for _, vote := range votes {
+		i, ok := roundIndexes[vote.RoundID]
+		if !ok {
+			continue
+		}
+
+		recap.Rounds[i].Votes = append(recap.Rounds[i].Votes, VoteRecap{
+			VoterID:          vote.VoterID,
+			VoterNickname:    vote.VoterNickname,
+			VotedForID:       vote.VotedForID,
+			VotedForNickname: vote.VotedForNickname,
+		})
+	}
+
+	return recap, nil
+}
@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	mockService "gitlab.com/hmajid2301/banterbus/internal/service/mocks"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+var recapGameStateID = uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d8"))
+
+func TestRecapServiceGetRecap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully get recap of finished game", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRecapStore(t)
+		srv := service.NewRecapService(mockStore, "en-GB")
+
+		ctx := t.Context()
+		secondRoundID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d9"))
+
+		mockStore.EXPECT().GetGameState(ctx, recapGameStateID).Return(db.GameState{
+			ID:    recapGameStateID,
+			State: db.FibbingItWinner.String(),
+		}, nil)
+		mockStore.EXPECT().GetRecapRoundsByGameStateID(ctx, db.GetRecapRoundsByGameStateIDParams{
+			Locale:        "en-GB",
+			DefaultLocale: "en-GB",
+			GameStateID:   recapGameStateID,
+		}).Return([]db.GetRecapRoundsByGameStateIDRow{
+			{
+				ID:             roundID,
+				Round:          1,
+				RoundType:      "free_form",
+				NormalQuestion: "What is your favourite food?",
+				FibberQuestion: "What is your least favourite food?",
+			},
+			{
+				ID:             secondRoundID,
+				Round:          2,
+				RoundType:      "free_form",
+				NormalQuestion: "Where would you go on holiday?",
+				FibberQuestion: "Where would you never go on holiday?",
+			},
+		}, nil)
+		mockStore.EXPECT().GetRecapAnswersByGameStateID(ctx, recapGameStateID).Return(
+			[]db.GetRecapAnswersByGameStateIDRow{
+				{
+					RoundID:  roundID,
+					PlayerID: defaultHostPlayerID,
+					Nickname: defaultHostNickname,
+					Role:     service.NormalRole,
+					Answer:   "Pizza",
+					Score:    100,
+				},
+				{
+					RoundID:  roundID,
+					PlayerID: defaultOtherPlayerID,
+					Nickname: defaultOtherPlayerNickname,
+					Role:     service.FibberRole,
+					Answer:   "Olives",
+				},
+				{
+					RoundID:  secondRoundID,
+					PlayerID: defaultHostPlayerID,
+					Nickname: defaultHostNickname,
+					Role:     service.NormalRole,
+				},
+			},
+			nil,
+		)
+		mockStore.EXPECT().GetRecapVotesByGameStateID(ctx, recapGameStateID).Return(
+			[]db.GetRecapVotesByGameStateIDRow{
+				{
+					RoundID:          roundID,
+					VoterID:          defaultHostPlayerID,
+					VoterNickname:    defaultHostNickname,
+					VotedForID:       defaultOtherPlayerID,
+					VotedForNickname: defaultOtherPlayerNickname,
+				},
+			},
+			nil,
+		)
+
+		recap, err := srv.GetRecap(ctx, recapGameStateID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.GameRecap{
+			GameStateID: recapGameStateID,
+			Rounds: []service.RoundRecap{
+				{
+					Round:          1,
+					RoundType:      "free_form",
+					NormalQuestion: "What is your favourite food?",
+					FibberQuestion: "What is your least favourite food?",
+					Players: []service.PlayerRecap{
+						{
+							ID:       defaultHostPlayerID,
+							Nickname: defaultHostNickname,
+							Role:     service.NormalRole,
+							Answer:   "Pizza",
+							Points:   100,
+						},
+						{
+							ID:       defaultOtherPlayerID,
+							Nickname: defaultOtherPlayerNickname,
+							Role:     service.FibberRole,
+							Answer:   "Olives",
+						},
+					},
+					Votes: []service.VoteRecap{
+						{
+							VoterID:          defaultHostPlayerID,
+							VoterNickname:    defaultHostNickname,
+							VotedForID:       defaultOtherPlayerID,
+							VotedForNickname: defaultOtherPlayerNickname,
+						},
+					},
+				},
+				{
+					Round:          2,
+					RoundType:      "free_form",
+					NormalQuestion: "Where would you go on holiday?",
+					FibberQuestion: "Where would you never go on holiday?",
+					Players: []service.PlayerRecap{
+						{
+							ID:       defaultHostPlayerID,
+							Nickname: defaultHostNickname,
+							Role:     service.NormalRole,
+						},
+					},
+					Votes: []service.VoteRecap{},
+				},
+			},
+			Awards: []service.Award{
+				{Name: service.AwardDetective, PlayerID: defaultHostPlayerID, Nickname: defaultHostNickname, Score: 1},
+			},
+		}, recap)
+	})
+
+	t.Run("Should fail to get recap, game does not exist", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRecapStore(t)
+		srv := service.NewRecapService(mockStore, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGameState(ctx, recapGameStateID).Return(db.GameState{}, sql.ErrNoRows)
+
+		_, err := srv.GetRecap(ctx, recapGameStateID)
+		assert.ErrorIs(t, err, service.ErrGameNotFound)
+	})
+
+	t.Run("Should fail to get recap, game is still being played", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRecapStore(t)
+		srv := service.NewRecapService(mockStore, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGameState(ctx, recapGameStateID).Return(db.GameState{
+			ID:    recapGameStateID,
+			State: db.FibbingItVoting.String(),
+		}, nil)
+
+		_, err := srv.GetRecap(ctx, recapGameStateID)
+		assert.ErrorIs(t, err, service.ErrGameNotOver)
+	})
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"slices"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	RevealRuleUnanimous = "unanimous"
+	RevealRuleMajority  = "majority"
+	RevealRulePlurality = "plurality"
+
+	TieBreakNone   = "none"
+	TieBreakRevote = "revote"
+)
+
+// Decisions explain on the reveal screen how the group came to reveal (or not reveal) a player.
+const (
+	DecisionUnanimous   = "unanimous"
+	DecisionMajority    = "majority"
+	DecisionPlurality   = "plurality"
+	DecisionRevote      = "revote"
+	DecisionTie         = "tie"
+	DecisionNoConsensus = "no_consensus"
+	DecisionNoVotes     = "no_votes"
+)
+
+func RevealRules() []string {
+	return []string{RevealRuleUnanimous, RevealRuleMajority, RevealRulePlurality}
+}
+
+func TieBreaks() []string {
+	return []string{TieBreakNone, TieBreakRevote}
+}
+
+// getRevealRule falls back to unanimous for games started before hosts could pick a reveal rule.
+func getRevealRule(rule string) string {
+	if rule == "" {
+		return RevealRuleUnanimous
+	}
+	return rule
+}
+
+func getTieBreak(tieBreak string) string {
+	if tieBreak == "" {
+		return TieBreakNone
+	}
+	return tieBreak
+}
+
+type revealDecision struct {
+	// PlayerIDs are the players who get revealed, at most one for every fibber.
+	PlayerIDs []uuid.UUID
+	Decision  string
+	Votes     int
+	Voters    int
+	// Tied are the players who tied for the last spot when we can't tell which of them to reveal. Players with more
+	// votes than them clearly won a spot, so they aren't tied and are still revealed.
+	Tied []uuid.UUID
+}
+
+// decideReveal picks which players get revealed. Decided are players who clearly won a spot before a revote, they
+// stay revealed and the revote only decides the spots that are left.
+func decideReveal(
+	rule string,
+	players []PlayerWithVoting,
+	fibbers int,
+	isRevote bool,
+	decided []uuid.UUID,
+) revealDecision {
+	ranked := []PlayerWithVoting{}
+	for _, p := range players {
+		if !slices.Contains(decided, p.ID) {
+			ranked = append(ranked, p)
+		}
+	}
+	slices.SortStableFunc(ranked, func(a, b PlayerWithVoting) int {
+		return b.Votes - a.Votes
+	})
+
+	// INFO: Inactive players aren't around to vote, so they don't count towards the reveal thresholds.
+	voters := 0
+	for _, p := range players {
+		if !p.IsInactive {
+			voters++
+		}
+	}
+
+	decision := revealDecision{Voters: voters, PlayerIDs: slices.Clone(decided)}
+	spots := min(max(fibbers, 1)-len(decided), len(ranked))
+	if spots <= 0 || ranked[0].Votes == 0 {
+		decision.Decision = DecisionNoVotes
+		return decision
+	}
+	decision.Votes = ranked[0].Votes
+
+	// INFO: One player can be revealed for every fibber, if players tie for the last spot we can't tell who to
+	// reveal, so none of the tied players are.
+	lastVotes := ranked[spots-1].Votes
+	if lastVotes > 0 && spots < len(ranked) && ranked[spots].Votes == lastVotes {
+		for _, p := range ranked[:spots] {
+			if p.Votes == lastVotes || getRevealedBy(rule, p.Votes, voters, !p.IsInactive) == "" {
+				break
+			}
+			decision.PlayerIDs = append(decision.PlayerIDs, p.ID)
+		}
+
+		decision.Decision = DecisionNoConsensus
+		if rule == RevealRulePlurality {
+			decision.Decision = DecisionTie
+			for _, p := range ranked {
+				if p.Votes == lastVotes {
+					decision.Tied = append(decision.Tied, p.ID)
+				}
+			}
+		}
+		return decision
+	}
+
+	for _, p := range ranked[:spots] {
+		revealedBy := getRevealedBy(rule, p.Votes, voters, !p.IsInactive)
+		// INFO: Players are ranked by votes, so nobody after this player can be revealed either.
+		if revealedBy == "" {
+			break
+		}
+		decision.Decision = revealedBy
+		decision.Votes = p.Votes
+		decision.PlayerIDs = append(decision.PlayerIDs, p.ID)
+	}
+
+	if len(decision.PlayerIDs) == len(decided) {
+		decision.Decision = DecisionNoConsensus
+		return decision
+	}
+
+	if isRevote {
+		decision.Decision = DecisionRevote
+	}
+	return decision
+}
+
+func getRevoteWinners(winners []string) []uuid.UUID {
+	winnerIDs := []uuid.UUID{}
+	for _, winner := range winners {
+		if id := uuid.FromStringOrNil(winner); id != uuid.Nil {
+			winnerIDs = append(winnerIDs, id)
+		}
+	}
+	return winnerIDs
+}
+
+// getRevealedBy returns the decision that reveals a player with this many votes, empty if they aren't revealed.
+func getRevealedBy(rule string, votes int, voters int, isVoter bool) string {
+	// INFO: Everyone can vote apart from the player themselves, votes from players who have since gone inactive
+	// still count.
+	possibleVotes := voters
+	if isVoter {
+		possibleVotes--
+	}
+	unanimous := votes >= possibleVotes
+	majority := votes*2 > voters
+	switch {
+	case votes == 0:
+		return ""
+	case unanimous:
+		return DecisionUnanimous
+	case majority && rule != RevealRuleUnanimous:
+		return DecisionMajority
+	case rule == RevealRulePlurality:
+		return DecisionPlurality
+	default:
+		return ""
+	}
+}
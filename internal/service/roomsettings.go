@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+const (
+	MinMaxRounds   = 1
+	MaxMaxRounds   = 10
+	MaxRoundTypes  = 10
+	MaxFibbers     = 3
+	MaxSeriesGames = 7
+	MaxRecentGames = 10
+
+	MinPhaseDuration = 5 * time.Second
+	MaxPhaseDuration = 5 * time.Minute
+)
+
+var ErrInvalidRoomSettings = errors.New("invalid room settings")
+
+// RoomSettings are chosen by the host in the lobby and copied onto the game state when the game starts.
+type RoomSettings struct {
+	MaxRounds int
+	// RoundTypes are played in order, the same round type can be played more than once.
+	RoundTypes []string
+	Timings    Timings
+	// Scorers give out bonus points on top of the base score, a room can use any number of them.
+	Scorers []string
+	// RevealRule is how many votes a player needs before they are revealed.
+	RevealRule string
+	// TieBreak decides what happens when players tie for the most votes, only used with the plurality rule.
+	TieBreak string
+	// Fibbers is how many fibbers there are each round, 0 scales the number of fibbers with the lobby size.
+	Fibbers int
+	// FibberSelection is how fibbers are picked, fair picks the players who have been the fibber the fewest times.
+	FibberSelection string
+	// FibberRotation is whether new fibbers are picked for every round type or for every question.
+	FibberRotation string
+	// SeriesGames is how many games are played in a row with a running leaderboard, 0 or 1 plays a single game.
+	SeriesGames int
+	// RecentGames is how many of the players' previous games to avoid repeating questions from, questions are never
+	// repeated within a game.
+	RecentGames int
+}
+
+// Timings are how long each phase of a round lasts before the game moves on by itself.
+type Timings struct {
+	ShowQuestionScreenFor time.Duration
+	ShowVotingScreenFor   time.Duration
+	ShowRevealScreenFor   time.Duration
+	// ShowRevoteScreenFor is how long players get to vote again when they tie, only used with the revote tie break.
+	ShowRevoteScreenFor time.Duration
+	ShowScoreScreenFor  time.Duration
+	ShowWinnerScreenFor time.Duration
+}
+
+func (t Timings) Validate() error {
+	phases := []struct {
+		name     string
+		duration time.Duration
+	}{
+		{name: "question", duration: t.ShowQuestionScreenFor},
+		{name: "voting", duration: t.ShowVotingScreenFor},
+		{name: "reveal", duration: t.ShowRevealScreenFor},
+		{name: "revote", duration: t.ShowRevoteScreenFor},
+		{name: "score", duration: t.ShowScoreScreenFor},
+		{name: "winner", duration: t.ShowWinnerScreenFor},
+	}
+
+	for _, phase := range phases {
+		if phase.duration < MinPhaseDuration || phase.duration > MaxPhaseDuration {
+			return fmt.Errorf(
+				"%w: %s timer must be between %s and %s",
+				ErrInvalidRoomSettings,
+				phase.name,
+				MinPhaseDuration,
+				MaxPhaseDuration,
+			)
+		}
+	}
+
+	return nil
+}
+
+func newTimings(questionSeconds, votingSeconds, revealSeconds, revoteSeconds, scoreSeconds, winnerSeconds int32) Timings {
+	return Timings{
+		ShowQuestionScreenFor: time.Duration(questionSeconds) * time.Second,
+		ShowVotingScreenFor:   time.Duration(votingSeconds) * time.Second,
+		ShowRevealScreenFor:   time.Duration(revealSeconds) * time.Second,
+		ShowRevoteScreenFor:   time.Duration(revoteSeconds) * time.Second,
+		ShowScoreScreenFor:    time.Duration(scoreSeconds) * time.Second,
+		ShowWinnerScreenFor:   time.Duration(winnerSeconds) * time.Second,
+	}
+}
+
+func (s RoomSettings) Validate() error {
+	if s.MaxRounds < MinMaxRounds || s.MaxRounds > MaxMaxRounds {
+		return fmt.Errorf(
+			"%w: max rounds must be between %d and %d",
+			ErrInvalidRoomSettings,
+			MinMaxRounds,
+			MaxMaxRounds,
+		)
+	}
+
+	if len(s.RoundTypes) == 0 || len(s.RoundTypes) > MaxRoundTypes {
+		return fmt.Errorf(
+			"%w: between 1 and %d round types must be picked",
+			ErrInvalidRoomSettings,
+			MaxRoundTypes,
+		)
+	}
+
+	for _, roundType := range s.RoundTypes {
+		if !slices.Contains(AllRoundTypes(), roundType) {
+			return fmt.Errorf("%w: unknown round type %s", ErrInvalidRoomSettings, roundType)
+		}
+	}
+
+	for i, scorer := range s.Scorers {
+		if !slices.Contains(ScorerNames(), scorer) {
+			return fmt.Errorf("%w: unknown scorer %s", ErrInvalidRoomSettings, scorer)
+		}
+		if slices.Contains(s.Scorers[:i], scorer) {
+			return fmt.Errorf("%w: scorer %s picked more than once", ErrInvalidRoomSettings, scorer)
+		}
+	}
+
+	if !slices.Contains(RevealRules(), s.RevealRule) {
+		return fmt.Errorf("%w: unknown reveal rule %s", ErrInvalidRoomSettings, s.RevealRule)
+	}
+
+	if !slices.Contains(TieBreaks(), s.TieBreak) {
+		return fmt.Errorf("%w: unknown tie break %s", ErrInvalidRoomSettings, s.TieBreak)
+	}
+
+	if s.Fibbers < 0 || s.Fibbers > MaxFibbers {
+		return fmt.Errorf("%w: fibbers must be between 0 and %d", ErrInvalidRoomSettings, MaxFibbers)
+	}
+
+	if s.SeriesGames < 0 || s.SeriesGames > MaxSeriesGames {
+		return fmt.Errorf("%w: series games must be between 0 and %d", ErrInvalidRoomSettings, MaxSeriesGames)
+	}
+
+	if s.RecentGames < 0 || s.RecentGames > MaxRecentGames {
+		return fmt.Errorf("%w: recent games must be between 0 and %d", ErrInvalidRoomSettings, MaxRecentGames)
+	}
+
+	if !slices.Contains(FibberSelections(), s.FibberSelection) {
+		return fmt.Errorf("%w: unknown fibber selection %s", ErrInvalidRoomSettings, s.FibberSelection)
+	}
+
+	if !slices.Contains(FibberRotations(), s.FibberRotation) {
+		return fmt.Errorf("%w: unknown fibber rotation %s", ErrInvalidRoomSettings, s.FibberRotation)
+	}
+
+	if s.TieBreak != TieBreakNone && s.RevealRule != RevealRulePlurality {
+		return fmt.Errorf("%w: tie break can only be used with the plurality rule", ErrInvalidRoomSettings)
+	}
+
+	return s.Timings.Validate()
+}
+
+func (r *LobbyService) DefaultRoomSettings() RoomSettings {
+	return RoomSettings{
+		MaxRounds:       r.defaultSettings.MaxRounds,
+		RoundTypes:      getRoundTypes(r.defaultSettings.RoundTypes),
+		Timings:         r.defaultSettings.Timings,
+		Scorers:         r.defaultSettings.Scorers,
+		RevealRule:      getRevealRule(r.defaultSettings.RevealRule),
+		TieBreak:        getTieBreak(r.defaultSettings.TieBreak),
+		Fibbers:         r.defaultSettings.Fibbers,
+		FibberSelection: getFibberSelection(r.defaultSettings.FibberSelection),
+		FibberRotation:  getFibberRotation(r.defaultSettings.FibberRotation),
+		SeriesGames:     r.defaultSettings.SeriesGames,
+		RecentGames:     r.defaultSettings.RecentGames,
+	}
+}
+
+func (r *LobbyService) GetRoomSettings(ctx context.Context, roomCode string) (RoomSettings, error) {
+	room, err := r.store.GetRoomByCode(ctx, roomCode)
+	if err != nil {
+		return RoomSettings{}, err
+	}
+
+	return r.getRoomSettings(ctx, room.ID)
+}
+
+func (r *LobbyService) UpdateRoomSettings(
+	ctx context.Context,
+	roomCode string,
+	playerID uuid.UUID,
+	settings RoomSettings,
+) (RoomSettings, error) {
+	room, err := r.store.GetRoomByCode(ctx, roomCode)
+	if err != nil {
+		return RoomSettings{}, err
+	}
+
+	if room.HostPlayer != playerID {
+		return RoomSettings{}, errors.New("player is not the host of the room")
+	}
+
+	if room.RoomState != db.Created.String() {
+		return RoomSettings{}, errors.New("room is not in CREATED state")
+	}
+
+	err = settings.Validate()
+	if err != nil {
+		return RoomSettings{}, err
+	}
+
+	saved, err := r.store.UpsertRoomSettings(ctx, db.UpsertRoomSettingsParams{
+		RoomID:          room.ID,
+		MaxRounds:       int32(settings.MaxRounds),
+		RoundTypes:      settings.RoundTypes,
+		QuestionSeconds: int32(settings.Timings.ShowQuestionScreenFor.Seconds()),
+		VotingSeconds:   int32(settings.Timings.ShowVotingScreenFor.Seconds()),
+		RevealSeconds:   int32(settings.Timings.ShowRevealScreenFor.Seconds()),
+		RevoteSeconds:   int32(settings.Timings.ShowRevoteScreenFor.Seconds()),
+		ScoreSeconds:    int32(settings.Timings.ShowScoreScreenFor.Seconds()),
+		WinnerSeconds:   int32(settings.Timings.ShowWinnerScreenFor.Seconds()),
+		Scorers:         getScorerNames(settings.Scorers),
+		RevealRule:      settings.RevealRule,
+		TieBreak:        settings.TieBreak,
+		Fibbers:         int32(settings.Fibbers),
+		FibberSelection: settings.FibberSelection,
+		FibberRotation:  settings.FibberRotation,
+		SeriesGames:     int32(settings.SeriesGames),
+		RecentGames:     int32(settings.RecentGames),
+	})
+	if err != nil {
+		return RoomSettings{}, fmt.Errorf("failed to save room settings: %w", err)
+	}
+
+	return newRoomSettings(saved), nil
+}
+
+// getRoomSettings falls back to the defaults when the host never changed any settings.
+func (r *LobbyService) getRoomSettings(ctx context.Context, roomID uuid.UUID) (RoomSettings, error) {
+	settings, err := r.store.GetRoomSettings(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return r.DefaultRoomSettings(), nil
+		}
+		return RoomSettings{}, err
+	}
+
+	return newRoomSettings(settings), nil
+}
+
+func newRoomSettings(settings db.RoomSetting) RoomSettings {
+	return RoomSettings{
+		MaxRounds:  int(settings.MaxRounds),
+		RoundTypes: getRoundTypes(settings.RoundTypes),
+		Timings: newTimings(
+			settings.QuestionSeconds,
+			settings.VotingSeconds,
+			settings.RevealSeconds,
+			settings.RevoteSeconds,
+			settings.ScoreSeconds,
+			settings.WinnerSeconds,
+		),
+		Scorers:         settings.Scorers,
+		RevealRule:      getRevealRule(settings.RevealRule),
+		TieBreak:        getTieBreak(settings.TieBreak),
+		Fibbers:         int(settings.Fibbers),
+		FibberSelection: getFibberSelection(settings.FibberSelection),
+		FibberRotation:  getFibberRotation(settings.FibberRotation),
+		SeriesGames:     int(settings.SeriesGames),
+		RecentGames:     int(settings.RecentGames),
+	}
+}
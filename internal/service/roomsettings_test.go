@@ -0,0 +1,529 @@
+package service_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	mockService "gitlab.com/hmajid2301/banterbus/internal/service/mocks"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+func TestRoomSettingsValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		settings service.RoomSettings
+		valid    bool
+	}{
+		{
+			name: "Should accept default round types",
+			settings: service.RoomSettings{
+				MaxRounds:       service.MinMaxRounds,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+			},
+			valid: true,
+		},
+		{
+			name: "Should accept repeated round types",
+			settings: service.RoomSettings{
+				MaxRounds:       service.MaxMaxRounds,
+				RoundTypes:      []string{"free_form", "free_form"},
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+			},
+			valid: true,
+		},
+		{
+			name: "Should accept a most likely only game",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      []string{"most_likely"},
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+			},
+			valid: true,
+		},
+		{
+			name: "Should accept numeric and ranking rounds in the round order",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      []string{"free_form", "numeric", "ranking"},
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+			},
+			valid: true,
+		},
+		{
+			name: "Should accept shortest and longest timers",
+			settings: service.RoomSettings{
+				MaxRounds:  3,
+				RoundTypes: service.RoundTypes(),
+				Timings: service.Timings{
+					ShowQuestionScreenFor: service.MinPhaseDuration,
+					ShowVotingScreenFor:   service.MaxPhaseDuration,
+					ShowRevealScreenFor:   service.MinPhaseDuration,
+					ShowRevoteScreenFor:   service.MinPhaseDuration,
+					ShowScoreScreenFor:    service.MaxPhaseDuration,
+					ShowWinnerScreenFor:   service.MaxPhaseDuration,
+				},
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+			},
+			valid: true,
+		},
+		{
+			name:     "Should reject max rounds of zero",
+			settings: service.RoomSettings{MaxRounds: 0, RoundTypes: service.RoundTypes()},
+		},
+		{
+			name: "Should reject too many max rounds",
+			settings: service.RoomSettings{
+				MaxRounds:  service.MaxMaxRounds + 1,
+				RoundTypes: service.RoundTypes(),
+			},
+		},
+		{
+			name:     "Should reject no round types",
+			settings: service.RoomSettings{MaxRounds: 3},
+		},
+		{
+			name:     "Should reject unknown round type",
+			settings: service.RoomSettings{MaxRounds: 3, RoundTypes: []string{"free_form", "drawing"}},
+		},
+		{
+			name: "Should accept every scorer",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				Scorers:         service.ScorerNames(),
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+			},
+			valid: true,
+		},
+		{
+			name: "Should reject unknown scorer",
+			settings: service.RoomSettings{
+				MaxRounds:  3,
+				RoundTypes: service.RoundTypes(),
+				Timings:    defaultTimings,
+				Scorers:    []string{"double_points"},
+			},
+		},
+		{
+			name: "Should reject scorer picked twice",
+			settings: service.RoomSettings{
+				MaxRounds:  3,
+				RoundTypes: service.RoundTypes(),
+				Timings:    defaultTimings,
+				Scorers:    []string{"streak", "streak"},
+			},
+		},
+		{
+			name: "Should accept plurality with revote tie break",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRulePlurality,
+				TieBreak:        service.TieBreakRevote,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+			},
+			valid: true,
+		},
+		{
+			name: "Should reject unknown reveal rule",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      "random",
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+			},
+		},
+		{
+			name: "Should reject revote tie break without plurality rule",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleMajority,
+				TieBreak:        service.TieBreakRevote,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+			},
+		},
+		{
+			name: "Should accept the most fibbers",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+				Fibbers:         service.MaxFibbers,
+			},
+			valid: true,
+		},
+		{
+			name: "Should reject too many fibbers",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+				Fibbers:         service.MaxFibbers + 1,
+			},
+		},
+		{
+			name: "Should accept the longest series",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+				SeriesGames:     service.MaxSeriesGames,
+			},
+			valid: true,
+		},
+		{
+			name: "Should reject too many games in a series",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+				SeriesGames:     service.MaxSeriesGames + 1,
+			},
+		},
+		{
+			name: "Should reject avoiding questions from too many recent games",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  service.FibberRotationRoundType,
+				RecentGames:     service.MaxRecentGames + 1,
+			},
+		},
+		{
+			name: "Should accept random fibbers for every question",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionRandom,
+				FibberRotation:  service.FibberRotationQuestion,
+			},
+			valid: true,
+		},
+		{
+			name: "Should reject unknown fibber selection",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: "host_picks",
+				FibberRotation:  service.FibberRotationRoundType,
+			},
+		},
+		{
+			name: "Should reject unknown fibber rotation",
+			settings: service.RoomSettings{
+				MaxRounds:       3,
+				RoundTypes:      service.RoundTypes(),
+				Timings:         defaultTimings,
+				RevealRule:      service.RevealRuleUnanimous,
+				TieBreak:        service.TieBreakNone,
+				FibberSelection: service.FibberSelectionFair,
+				FibberRotation:  "every_round",
+			},
+		},
+		{
+			name: "Should reject missing timers",
+			settings: service.RoomSettings{
+				MaxRounds:  3,
+				RoundTypes: service.RoundTypes(),
+			},
+		},
+		{
+			name: "Should reject voting timer that is too long",
+			settings: service.RoomSettings{
+				MaxRounds:  3,
+				RoundTypes: service.RoundTypes(),
+				Timings: service.Timings{
+					ShowQuestionScreenFor: 15 * time.Second,
+					ShowVotingScreenFor:   service.MaxPhaseDuration + time.Second,
+					ShowRevealScreenFor:   15 * time.Second,
+					ShowScoreScreenFor:    15 * time.Second,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.settings.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, service.ErrInvalidRoomSettings)
+			}
+		})
+	}
+}
+
+func TestLobbyServiceGetRoomSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should get room settings", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{ID: roomID}, nil)
+		mockStore.EXPECT().GetRoomSettings(ctx, roomID).Return(db.RoomSetting{
+			RoomID:          roomID,
+			MaxRounds:       5,
+			RoundTypes:      []string{"most_likely"},
+			QuestionSeconds: 30,
+			VotingSeconds:   90,
+			RevealSeconds:   10,
+			RevoteSeconds:   25,
+			ScoreSeconds:    20,
+			WinnerSeconds:   30,
+		}, nil)
+
+		settings, err := srv.GetRoomSettings(ctx, roomCode)
+		assert.NoError(t, err)
+		expected := service.RoomSettings{
+			MaxRounds:  5,
+			RoundTypes: []string{"most_likely"},
+			Timings: service.Timings{
+				ShowQuestionScreenFor: 30 * time.Second,
+				ShowVotingScreenFor:   90 * time.Second,
+				ShowRevealScreenFor:   10 * time.Second,
+				ShowRevoteScreenFor:   25 * time.Second,
+				ShowScoreScreenFor:    20 * time.Second,
+				ShowWinnerScreenFor:   30 * time.Second,
+			},
+			RevealRule:      service.RevealRuleUnanimous,
+			TieBreak:        service.TieBreakNone,
+			FibberSelection: service.FibberSelectionFair,
+			FibberRotation:  service.FibberRotationRoundType,
+		}
+		assert.Equal(t, expected, settings)
+	})
+
+	t.Run("Should get default room settings when host has not changed them", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		defaults := service.RoomSettings{MaxRounds: 4, Timings: defaultTimings}
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaults)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{ID: roomID}, nil)
+		mockStore.EXPECT().GetRoomSettings(ctx, roomID).Return(db.RoomSetting{}, sql.ErrNoRows)
+
+		settings, err := srv.GetRoomSettings(ctx, roomCode)
+		assert.NoError(t, err)
+		expected := service.RoomSettings{
+			MaxRounds:       4,
+			RoundTypes:      service.RoundTypes(),
+			Timings:         defaultTimings,
+			RevealRule:      service.RevealRuleUnanimous,
+			TieBreak:        service.TieBreakNone,
+			FibberSelection: service.FibberSelectionFair,
+			FibberRotation:  service.FibberRotationRoundType,
+		}
+		assert.Equal(t, expected, settings)
+	})
+
+	t.Run("Should fail to get room settings because DB call fails", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{ID: roomID}, nil)
+		mockStore.EXPECT().GetRoomSettings(ctx, roomID).Return(db.RoomSetting{}, errors.New("failed to get settings"))
+
+		_, err := srv.GetRoomSettings(ctx, roomCode)
+		assert.Error(t, err)
+	})
+}
+
+func TestLobbyServiceUpdateRoomSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should update room settings", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{
+			ID:         roomID,
+			HostPlayer: hostPlayerID,
+			RoomState:  db.Created.String(),
+		}, nil)
+		mockStore.EXPECT().UpsertRoomSettings(ctx, db.UpsertRoomSettingsParams{
+			RoomID:          roomID,
+			MaxRounds:       6,
+			RoundTypes:      []string{"free_form", "free_form", "most_likely"},
+			QuestionSeconds: 30,
+			VotingSeconds:   120,
+			RevealSeconds:   15,
+			RevoteSeconds:   10,
+			ScoreSeconds:    15,
+			WinnerSeconds:   20,
+			Scorers:         []string{"streak", "fibber_dodge"},
+			RevealRule:      "plurality",
+			TieBreak:        "revote",
+			FibberSelection: "random",
+			FibberRotation:  "question",
+		}).Return(db.RoomSetting{
+			RoomID:          roomID,
+			MaxRounds:       6,
+			RoundTypes:      []string{"free_form", "free_form", "most_likely"},
+			QuestionSeconds: 30,
+			VotingSeconds:   120,
+			RevealSeconds:   15,
+			RevoteSeconds:   10,
+			ScoreSeconds:    15,
+			WinnerSeconds:   20,
+			Scorers:         []string{"streak", "fibber_dodge"},
+			RevealRule:      "plurality",
+			TieBreak:        "revote",
+			FibberSelection: "random",
+			FibberRotation:  "question",
+		}, nil)
+
+		newSettings := service.RoomSettings{
+			MaxRounds:  6,
+			RoundTypes: []string{"free_form", "free_form", "most_likely"},
+			Timings: service.Timings{
+				ShowQuestionScreenFor: 30 * time.Second,
+				ShowVotingScreenFor:   2 * time.Minute,
+				ShowRevealScreenFor:   15 * time.Second,
+				ShowRevoteScreenFor:   10 * time.Second,
+				ShowScoreScreenFor:    15 * time.Second,
+				ShowWinnerScreenFor:   20 * time.Second,
+			},
+			Scorers:         []string{"streak", "fibber_dodge"},
+			RevealRule:      service.RevealRulePlurality,
+			TieBreak:        service.TieBreakRevote,
+			FibberSelection: service.FibberSelectionRandom,
+			FibberRotation:  service.FibberRotationQuestion,
+		}
+		settings, err := srv.UpdateRoomSettings(ctx, roomCode, hostPlayerID, newSettings)
+		assert.NoError(t, err)
+		assert.Equal(t, newSettings, settings)
+	})
+
+	t.Run("Should fail to update room settings because player is not host", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{
+			ID:         roomID,
+			HostPlayer: hostPlayerID,
+			RoomState:  db.Created.String(),
+		}, nil)
+
+		settings := service.RoomSettings{MaxRounds: 6, RoundTypes: service.RoundTypes(), Timings: defaultTimings}
+		_, err := srv.UpdateRoomSettings(ctx, roomCode, playerID, settings)
+		assert.ErrorContains(t, err, "player is not the host of the room")
+	})
+
+	t.Run("Should fail to update room settings because game has started", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{
+			ID:         roomID,
+			HostPlayer: hostPlayerID,
+			RoomState:  db.Playing.String(),
+		}, nil)
+
+		settings := service.RoomSettings{MaxRounds: 6, RoundTypes: service.RoundTypes(), Timings: defaultTimings}
+		_, err := srv.UpdateRoomSettings(ctx, roomCode, hostPlayerID, settings)
+		assert.ErrorContains(t, err, "room is not in CREATED state")
+	})
+
+	t.Run("Should fail to update room settings because max rounds is out of range", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockLobbyStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewLobbyService(mockStore, mockRandom, "en-GB", defaultRoomSettings)
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetRoomByCode(ctx, roomCode).Return(db.Room{
+			ID:         roomID,
+			HostPlayer: hostPlayerID,
+			RoomState:  db.Created.String(),
+		}, nil)
+
+		settings := service.RoomSettings{MaxRounds: 0, RoundTypes: service.RoundTypes(), Timings: defaultTimings}
+		_, err := srv.UpdateRoomSettings(ctx, roomCode, hostPlayerID, settings)
+		assert.ErrorIs(t, err, service.ErrInvalidRoomSettings)
+	})
+}
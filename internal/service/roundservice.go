@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -20,6 +21,8 @@ const (
 	RoundTypeFreeForm       = "free_form"
 	RoundTypeMultipleChoice = "multiple_choice"
 	RoundTypeMostLikely     = "most_likely"
+	RoundTypeNumeric        = "numeric"
+	RoundTypeRanking        = "ranking"
 	DefaultMaxRounds        = 3
 	MaxAnswerLength         = 500 // Maximum characters allowed in an answer
 )
@@ -31,7 +34,10 @@ type RoundStore interface {
 	GetLatestRoundByPlayerID(ctx context.Context, playerID uuid.UUID) (db.GetLatestRoundByPlayerIDRow, error)
 	GetLatestRoundByGameStateID(ctx context.Context, id uuid.UUID) (db.GetLatestRoundByGameStateIDRow, error)
 	CountTotalRoundsByGameStateID(ctx context.Context, gameStateID uuid.UUID) (int64, error)
-	GetFibberByRoundID(ctx context.Context, roundID uuid.UUID) (db.FibbingItPlayerRole, error)
+	GetFibbersByRoundID(ctx context.Context, roundID uuid.UUID) ([]db.FibbingItPlayerRole, error)
+	GetAudienceVotesByRoundID(ctx context.Context, roundID uuid.UUID) ([]db.GetAudienceVotesByRoundIDRow, error)
+	GetFibberCountsByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]db.GetFibberCountsByGameStateIDRow, error)
+	GetAnswerOptionsByPlayerID(ctx context.Context, playerID uuid.UUID) ([]db.GetAnswerOptionsByPlayerIDRow, error)
 	GetAllPlayersByGameStateID(ctx context.Context, id uuid.UUID) ([]db.GetAllPlayersByGameStateIDRow, error)
 	GetAllPlayersInRoom(ctx context.Context, playerID uuid.UUID) ([]db.GetAllPlayersInRoomRow, error)
 	GetAllPlayersQuestionStateByGameStateID(ctx context.Context, id uuid.UUID) ([]db.GetAllPlayersQuestionStateByGameStateIDRow, error)
@@ -41,21 +47,31 @@ type RoundStore interface {
 	GetAllPlayerAnswerIsReadyByPlayerID(ctx context.Context, playerID uuid.UUID) (bool, error)
 	GetCurrentQuestionByPlayerID(ctx context.Context, id uuid.UUID) (db.GetCurrentQuestionByPlayerIDRow, error)
 	GetQuestionWithLocalesById(ctx context.Context, id uuid.UUID) ([]db.GetQuestionWithLocalesByIdRow, error)
-	UpsertFibbingItVote(ctx context.Context, arg db.UpsertFibbingItVoteParams) error
-	ToggleVotingIsReady(ctx context.Context, playerID uuid.UUID) (db.FibbingItVote, error)
+	SubmitVote(ctx context.Context, arg db.SubmitVoteArgs) error
+	CountVotesByPlayerIDAndRoundID(ctx context.Context, arg db.CountVotesByPlayerIDAndRoundIDParams) (int64, error)
+	ToggleVotingIsReady(ctx context.Context, arg db.ToggleVotingIsReadyParams) (db.FibbingItVote, error)
 	GetAllPlayersVotingIsReady(ctx context.Context, id uuid.UUID) (bool, error)
 	GetAllPlayersVotingIsReadyByPlayerID(ctx context.Context, playerID uuid.UUID) (bool, error)
 	GetVotingState(ctx context.Context, roundID uuid.UUID) ([]db.GetVotingStateRow, error)
 	GetAllVotesForRoundByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]db.GetAllVotesForRoundByGameStateIDRow, error)
+	GetSeriesScores(ctx context.Context, arg db.GetSeriesScoresParams) ([]db.GetSeriesScoresRow, error)
 	GetTotalScoresByGameStateID(ctx context.Context, arg db.GetTotalScoresByGameStateIDParams) ([]db.GetTotalScoresByGameStateIDRow, error)
 	GetRoomByPlayerID(ctx context.Context, playerID uuid.UUID) (db.Room, error)
+	GetRoomByGameStateID(ctx context.Context, id uuid.UUID) (db.Room, error)
+	GetRecapAnswersByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]db.GetRecapAnswersByGameStateIDRow, error)
+	GetRecapVotesByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]db.GetRecapVotesByGameStateIDRow, error)
 	UpdateRoomState(ctx context.Context, arg db.UpdateRoomStateParams) (db.Room, error)
 	UpdateStateToVoting(ctx context.Context, arg db.UpdateStateToVotingArgs) (db.UpdateStateToVotingResult, error)
 	UpdateStateToReveal(ctx context.Context, arg db.UpdateStateToRevealArgs) (db.UpdateStateToRevealResult, error)
+	StartRevote(ctx context.Context, arg db.StartRevoteArgs) error
+	UpdateFibbingItRoundRevealedPlayers(ctx context.Context, arg db.UpdateFibbingItRoundRevealedPlayersParams) error
 	UpdateStateToScore(ctx context.Context, arg db.UpdateStateToScoreArgs) (db.UpdateStateToScoreResult, error)
 	UpdateStateToQuestion(ctx context.Context, arg db.UpdateStateToQuestionArgs) (db.UpdateStateToQuestionResult, error)
 	GetRandomQuestionByRound(ctx context.Context, arg db.GetRandomQuestionByRoundParams) ([]db.GetRandomQuestionByRoundRow, error)
 	GetRandomQuestionInGroup(ctx context.Context, arg db.GetRandomQuestionInGroupParams) ([]db.GetRandomQuestionInGroupRow, error)
+	GetRandomQuestionPair(ctx context.Context, arg db.GetRandomQuestionPairParams) (db.QuestionPair, error)
+	GetScoreReasonsByRoundID(ctx context.Context, roundID uuid.UUID) ([]db.GetScoreReasonsByRoundIDRow, error)
+	GetUsedQuestionIDs(ctx context.Context, arg db.GetUsedQuestionIDsParams) ([]db.GetUsedQuestionIDsRow, error)
 	PauseGame(ctx context.Context, arg db.PauseGameParams) (db.GameState, error)
 	ResumeGame(ctx context.Context, id uuid.UUID) (db.GameState, error)
 	GetPauseStatus(ctx context.Context, id uuid.UUID) (db.GetPauseStatusRow, error)
@@ -84,10 +100,11 @@ var ErrNoNormalQuestions = errors.New("no normal questions available")
 var ErrNoFibberQuestions = errors.New("no fibber questions available")
 var ErrNotInQuestionState = errors.New("game state is not in FIBBING_IT_QUESTION state")
 var ErrNotInVotingState = errors.New("game state is not in FIBBING_IT_VOTING state")
+var ErrNotRevoteCandidate = errors.New("can only vote for players who tied for the most votes")
 var ErrNotInRevealState = errors.New("game state is not in FIBBING_IT_REVEAL state")
 var ErrNotInScoringState = errors.New("game state is not in FIBBING_IT_SCORING_STATE state")
 var ErrAlreadyInQuestionState = errors.New("game state is already in FIBBING_IT_QUESTION state")
-var ErrNotHost = errors.New("only host can pause/resume game")
+var ErrNotHost = errors.New("only the host can do this")
 var ErrGameAlreadyPaused = errors.New("game is already paused")
 var ErrGameNotPaused = errors.New("game is not paused")
 var ErrNoPauseTimeRemaining = errors.New("no pause time remaining (5 minute limit reached)")
@@ -135,7 +152,21 @@ func (r *RoundService) SubmitAnswer(
 		return err
 	}
 
-	if len(answers) > 0 {
+	if round.RoundType == RoundTypeNumeric {
+		answer, err = getNumericAnswer(answer)
+		if err != nil {
+			return err
+		}
+	}
+
+	ranking := []string{}
+	if round.RoundType == RoundTypeRanking {
+		ranking, err = getRanking(answer, answers)
+		if err != nil {
+			return err
+		}
+		answer = strings.Join(ranking, RankingSeparator)
+	} else if len(answers) > 0 {
 		isAnswerValid := false
 		for _, validAnswer := range answers {
 			if answer == validAnswer {
@@ -158,6 +189,7 @@ func (r *RoundService) SubmitAnswer(
 		RoundID:  round.ID,
 		PlayerID: playerID,
 		Answer:   answer,
+		Ranking:  ranking,
 	})
 
 	return err
@@ -206,7 +238,7 @@ func (r *RoundService) UpdateStateToVoting(
 		return VotingState{}, err
 	}
 
-	votingState, err := r.getVotingState(ctx, result.RoundID, result.Round)
+	votingState, err := r.getVotingState(ctx, result.RoundID, result.Round, result.RevoteCandidates)
 	return votingState, err
 }
 
@@ -254,15 +286,27 @@ func (r *RoundService) SubmitVote(
 		return VotingState{}, errors.New("answer submission deadline has passed")
 	}
 
+	isRevote := len(round.RevoteCandidates) > 0
+	if isRevote && !slices.Contains(round.RevoteCandidates, votedPlayerID.String()) {
+		return VotingState{}, ErrNotRevoteCandidate
+	}
+
+	fibbers, err := r.store.GetFibbersByRoundID(ctx, round.ID)
+	if err != nil {
+		return VotingState{}, err
+	}
+
 	voteID, err := r.randomizer.GetID()
 	if err != nil {
 		return VotingState{}, err
 	}
-	err = r.store.UpsertFibbingItVote(ctx, db.UpsertFibbingItVoteParams{
+	err = r.store.SubmitVote(ctx, db.SubmitVoteArgs{
 		ID:               voteID,
 		RoundID:          round.ID,
 		PlayerID:         playerID,
 		VotedForPlayerID: votedPlayerID,
+		// INFO: In a revote players only vote for the spots that weren't already clearly won.
+		MaxVotes: len(fibbers) - len(round.RevoteWinners),
 	})
 	if err != nil {
 		return VotingState{}, err
@@ -277,11 +321,14 @@ func (r *RoundService) SubmitVote(
 	for _, p := range playersWithVoteAndAnswers {
 		voteCount := int(p.Votes)
 		votingPlayers = append(votingPlayers, PlayerWithVoting{
-			ID:       p.PlayerID,
-			Nickname: p.Nickname,
-			Avatar:   p.Avatar,
-			Votes:    voteCount,
-			Answer:   p.Answer.String,
+			ID:                p.PlayerID,
+			Nickname:          p.Nickname,
+			Avatar:            p.Avatar,
+			Votes:             voteCount,
+			Answer:            p.Answer.String,
+			Ranking:           p.Ranking,
+			IsRevoteCandidate: slices.Contains(round.RevoteCandidates, p.PlayerID.String()),
+			IsInactive:        p.InactiveAt.Valid,
 		})
 	}
 
@@ -291,10 +338,15 @@ func (r *RoundService) SubmitVote(
 
 	player := playersWithVoteAndAnswers[0]
 	votingState := VotingState{
-		Players:  votingPlayers,
-		Question: player.Question,
-		Round:    int(player.Round),
-		Deadline: time.Until(round.SubmitDeadline.Time),
+		Players:      votingPlayers,
+		Question:     player.Question,
+		Round:        int(player.Round),
+		Deadline:     time.Until(round.SubmitDeadline.Time),
+		IsRevote:     isRevote,
+		Fibbers:      len(fibbers),
+		RoundType:    round.RoundType,
+		AnswerSpread: getAnswerSpread(round.RoundType, votingPlayers),
+		Rankings:     getRankings(round.RoundType, votingPlayers, nil),
 	}
 
 	return votingState, err
@@ -306,7 +358,7 @@ func (r *RoundService) GetVotingState(ctx context.Context, playerID uuid.UUID) (
 		return VotingState{}, err
 	}
 
-	votingState, err := r.getVotingState(ctx, round.ID, round.Round)
+	votingState, err := r.getVotingState(ctx, round.ID, round.Round, round.RevoteCandidates)
 	return votingState, err
 }
 
@@ -316,11 +368,16 @@ func (r *RoundService) getVotingStateByGameStateID(ctx context.Context, gameStat
 		return VotingState{}, err
 	}
 
-	votingState, err := r.getVotingState(ctx, round.ID, round.Round)
+	votingState, err := r.getVotingState(ctx, round.ID, round.Round, round.RevoteCandidates)
 	return votingState, err
 }
 
-func (r *RoundService) getVotingState(ctx context.Context, roundID uuid.UUID, round int32) (VotingState, error) {
+func (r *RoundService) getVotingState(
+	ctx context.Context,
+	roundID uuid.UUID,
+	round int32,
+	revoteCandidates []string,
+) (VotingState, error) {
 	votes, err := r.store.GetVotingState(ctx, roundID)
 	if err != nil {
 		return VotingState{}, err
@@ -351,22 +408,28 @@ func (r *RoundService) getVotingState(ctx context.Context, roundID uuid.UUID, ro
 
 	var normalQuestion string
 	var votingPlayers []PlayerWithVoting
+	fibbers := 0
 	for _, p := range votes {
 		voteCount := int(p.Votes)
 
 		if p.Role.String != FibberRole {
 			normalQuestion = p.Question
+		} else {
+			fibbers++
 		}
 
 		votingPlayers = append(votingPlayers, PlayerWithVoting{
-			ID:       p.PlayerID,
-			Nickname: p.Nickname,
-			Avatar:   p.Avatar,
-			Votes:    voteCount,
-			Answer:   p.Answer.String,
-			IsReady:  p.IsReady,
-			IsHost:   p.PlayerID == hostPlayerID,
-			Role:     p.Role.String,
+			ID:                p.PlayerID,
+			Nickname:          p.Nickname,
+			Avatar:            p.Avatar,
+			Votes:             voteCount,
+			Answer:            p.Answer.String,
+			Ranking:           p.Ranking,
+			IsReady:           p.IsReady,
+			IsHost:            p.PlayerID == hostPlayerID,
+			Role:              p.Role.String,
+			IsRevoteCandidate: slices.Contains(revoteCandidates, p.PlayerID.String()),
+			IsInactive:        p.InactiveAt.Valid,
 		})
 	}
 
@@ -382,6 +445,11 @@ func (r *RoundService) getVotingState(ctx context.Context, roundID uuid.UUID, ro
 		Deadline:             time.Until(votes[0].SubmitDeadline.Time),
 		IsPaused:             gameState.PausedAt.Valid,
 		PauseTimeRemainingMs: gameState.PauseTimeRemainingMs.Int32,
+		IsRevote:             len(revoteCandidates) > 0,
+		Fibbers:              fibbers,
+		RoundType:            votes[0].RoundType,
+		AnswerSpread:         getAnswerSpread(votes[0].RoundType, votingPlayers),
+		Rankings:             getRankings(votes[0].RoundType, votingPlayers, nil),
 	}
 	return votingState, nil
 }
@@ -404,7 +472,33 @@ func (r *RoundService) ToggleVotingIsReady(
 		return false, errors.New("toggle ready deadline has passed")
 	}
 
-	_, err = r.store.ToggleVotingIsReady(ctx, playerID)
+	round, err := r.store.GetLatestRoundByPlayerID(ctx, playerID)
+	if err != nil {
+		return false, err
+	}
+
+	fibbers, err := r.store.GetFibbersByRoundID(ctx, round.ID)
+	if err != nil {
+		return false, err
+	}
+
+	votes, err := r.store.CountVotesByPlayerIDAndRoundID(ctx, db.CountVotesByPlayerIDAndRoundIDParams{
+		PlayerID: playerID,
+		RoundID:  round.ID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	// INFO: Players must cast every vote they have before they are ready, the same number SubmitVote lets them cast.
+	if votes < int64(max(len(fibbers)-len(round.RevoteWinners), 1)) {
+		return false, ErrMustSubmitVote
+	}
+
+	_, err = r.store.ToggleVotingIsReady(ctx, db.ToggleVotingIsReadyParams{
+		PlayerID: playerID,
+		RoundID:  round.ID,
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return false, ErrMustSubmitVote
@@ -451,7 +545,7 @@ func (r *RoundService) UpdateStateToReveal(
 	gameStateID uuid.UUID,
 	deadline time.Time,
 ) (RevealRoleState, error) {
-	_, err := r.store.UpdateStateToReveal(ctx, db.UpdateStateToRevealArgs{
+	result, err := r.store.UpdateStateToReveal(ctx, db.UpdateStateToRevealArgs{
 		GameStateID: gameStateID,
 		Deadline:    deadline,
 	})
@@ -460,7 +554,26 @@ func (r *RoundService) UpdateStateToReveal(
 	}
 
 	revealState, err := r.getRevealState(ctx, gameStateID, deadline)
-	return revealState, err
+	if err != nil {
+		return RevealRoleState{}, err
+	}
+
+	// INFO: The reveal depends on the room's reveal rule, so we store who was revealed for the account stats
+	// rather than working it out again from the votes.
+	revealedPlayers := []string{}
+	for _, p := range revealState.RevealedPlayers {
+		revealedPlayers = append(revealedPlayers, p.ID.String())
+	}
+
+	err = r.store.UpdateFibbingItRoundRevealedPlayers(ctx, db.UpdateFibbingItRoundRevealedPlayersParams{
+		RevealedPlayers: revealedPlayers,
+		ID:              result.RoundID,
+	})
+	if err != nil {
+		return RevealRoleState{}, err
+	}
+
+	return revealState, nil
 }
 
 func (r *RoundService) GetRevealState(ctx context.Context, playerID uuid.UUID) (RevealRoleState, error) {
@@ -483,34 +596,147 @@ func (r *RoundService) getRevealState(
 		return RevealRoleState{}, err
 	}
 
-	votingState, err := r.getVotingState(ctx, round.ID, round.Round)
+	votingState, err := r.getVotingState(ctx, round.ID, round.Round, round.RevoteCandidates)
 	if err != nil {
 		return RevealRoleState{}, err
 	}
 
+	revealRule := getRevealRule(round.RevealRule)
+	decision := decideReveal(
+		revealRule,
+		votingState.Players,
+		votingState.Fibbers,
+		len(round.RevoteCandidates) > 0,
+		getRevoteWinners(round.RevoteWinners),
+	)
 	reveal := RevealRoleState{
-		Deadline:     time.Until(deadline),
-		Round:        votingState.Round,
-		RoundType:    round.RoundType,
-		ShouldReveal: false,
+		Deadline:        time.Until(deadline),
+		Round:           votingState.Round,
+		MaxRounds:       getMaxRounds(round.MaxRounds),
+		RoundType:       round.RoundType,
+		IsLastRoundType: isLastRoundType(round.RoundTypes, round.RoundTypeIndex),
+		ShouldReveal:    false,
+		RevealRule:      revealRule,
+		Decision:        decision.Decision,
+		Votes:           decision.Votes,
+		Voters:          decision.Voters,
+		Fibbers:         votingState.Fibbers,
+		GameStateID:     gameStateID,
 	}
 	playerIDs := []uuid.UUID{}
-	playersLen := len(votingState.Players)
 
 	for _, p := range votingState.Players {
 		playerIDs = append(playerIDs, p.ID)
-		if p.Votes == playersLen-1 {
-			reveal.VotedForPlayerNickname = p.Nickname
-			reveal.VotedForPlayerAvatar = p.Avatar
-			reveal.VotedForPlayerRole = p.Role
+		if slices.Contains(decision.PlayerIDs, p.ID) {
+			reveal.RevealedPlayers = append(reveal.RevealedPlayers, RevealedPlayer{
+				ID:       p.ID,
+				Nickname: p.Nickname,
+				Avatar:   p.Avatar,
+				Role:     p.Role,
+				Votes:    p.Votes,
+			})
+			if p.Role == FibberRole {
+				reveal.FibbersFound++
+			}
 			reveal.ShouldReveal = true
 		}
 	}
 
 	reveal.PlayerIDs = playerIDs
+	reveal.Rankings = getRankings(round.RoundType, votingState.Players, decision.PlayerIDs)
+
+	audienceVotes, err := r.store.GetAudienceVotesByRoundID(ctx, round.ID)
+	if err != nil {
+		return RevealRoleState{}, fmt.Errorf("failed to get audience votes: %w", err)
+	}
+
+	for _, vote := range audienceVotes {
+		reveal.AudienceVotes = append(reveal.AudienceVotes, AudienceVote{
+			Nickname: vote.Nickname,
+			Votes:    int(vote.Votes),
+		})
+	}
 	return reveal, nil
 }
 
+// GetTiedPlayers returns the players who tied for the most votes, when the room breaks ties with a revote.
+// Returns no players if the round has already been revoted, so a second tie means nobody is revealed.
+func (r *RoundService) GetTiedPlayers(ctx context.Context, gameStateID uuid.UUID) ([]uuid.UUID, error) {
+	game, err := r.store.GetGameState(ctx, gameStateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.State != db.FibbingItVoting.String() {
+		return nil, nil
+	}
+
+	round, err := r.store.GetLatestRoundByGameStateID(ctx, gameStateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if getTieBreak(round.TieBreak) != TieBreakRevote || len(round.RevoteCandidates) > 0 {
+		return nil, nil
+	}
+
+	votingState, err := r.getVotingState(ctx, round.ID, round.Round, round.RevoteCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := decideReveal(getRevealRule(round.RevealRule), votingState.Players, votingState.Fibbers, false, nil)
+	return decision.Tied, nil
+}
+
+// UpdateStateToRevote clears the votes of the latest round so players can vote again between the tied players.
+func (r *RoundService) UpdateStateToRevote(
+	ctx context.Context,
+	gameStateID uuid.UUID,
+	candidates []uuid.UUID,
+	deadline time.Time,
+) (VotingState, error) {
+	if len(candidates) < 2 {
+		return VotingState{}, errors.New("at least two players must tie to revote")
+	}
+
+	round, err := r.store.GetLatestRoundByGameStateID(ctx, gameStateID)
+	if err != nil {
+		return VotingState{}, err
+	}
+
+	// INFO: The votes are cleared for the revote, so we keep who clearly won a spot before they are gone.
+	votingState, err := r.getVotingState(ctx, round.ID, round.Round, round.RevoteCandidates)
+	if err != nil {
+		return VotingState{}, err
+	}
+
+	revealRule := getRevealRule(round.RevealRule)
+	decision := decideReveal(revealRule, votingState.Players, votingState.Fibbers, false, nil)
+	winners := []string{}
+	for _, winner := range decision.PlayerIDs {
+		winners = append(winners, winner.String())
+	}
+
+	revoteCandidates := []string{}
+	for _, candidate := range candidates {
+		revoteCandidates = append(revoteCandidates, candidate.String())
+	}
+
+	err = r.store.StartRevote(ctx, db.StartRevoteArgs{
+		GameStateID: gameStateID,
+		RoundID:     round.ID,
+		Candidates:  revoteCandidates,
+		Winners:     winners,
+		Deadline:    deadline,
+	})
+	if err != nil {
+		return VotingState{}, err
+	}
+
+	return r.getVotingState(ctx, round.ID, round.Round, revoteCandidates)
+}
+
 // TODO: see if we can use this in start game lobbyservice
 func (r *RoundService) UpdateStateToQuestion(
 	ctx context.Context,
@@ -532,32 +758,76 @@ func (r *RoundService) UpdateStateToQuestion(
 	}
 
 	roundType := round.RoundType
+	roundTypeIndex := round.RoundTypeIndex
 	roundNumber := round.Round + 1
-	fibberLoc := -1
+	fibberLocs := []int{}
+	newRoundType := false
 
-	var maxRounds int32 = DefaultMaxRounds
-	if roundNumber == maxRounds+1 || nextRound {
-		nextRoundType := getNextRoundType(roundType)
+	if roundNumber > int32(getMaxRounds(round.MaxRounds)) || nextRound {
+		nextRoundType, nextRoundTypeIndex := getNextRoundType(round.RoundTypes, round.RoundTypeIndex)
 		if nextRoundType == "" {
 			return QuestionState{}, ErrGameCompleted
 		}
 		roundType = nextRoundType
+		roundTypeIndex = nextRoundTypeIndex
 		roundNumber = 1
-		fibberLoc = r.randomizer.GetFibberIndex(len(players))
+		newRoundType = true
+	}
+
+	// INFO: Fibbers stay the same for every question of a round type, unless the host wants new fibbers every question.
+	if newRoundType || getFibberRotation(round.FibberRotation) == FibberRotationQuestion {
+		// INFO: Inactive players aren't around to answer, so they can't be the fibber or count towards how many
+		// fibbers there are. activeLocs maps each active player back to their index in players.
+		activePlayers := []db.GetAllPlayersByGameStateIDRow{}
+		activeLocs := []int{}
+		for i, player := range players {
+			if !player.InactiveAt.Valid {
+				activePlayers = append(activePlayers, player)
+				activeLocs = append(activeLocs, i)
+			}
+		}
+
+		activeFibberLocs, err := r.getFibberLocs(
+			ctx,
+			gameStateID,
+			activePlayers,
+			getFibberCount(int(round.Fibbers), len(activePlayers)),
+			round.FibberSelection,
+		)
+		if err != nil {
+			return QuestionState{}, err
+		}
+
+		for _, loc := range activeFibberLocs {
+			fibberLocs = append(fibberLocs, activeLocs[loc])
+		}
 	} else {
-		fibber, err := r.store.GetFibberByRoundID(ctx, round.ID)
+		fibbers, err := r.store.GetFibbersByRoundID(ctx, round.ID)
 		if err != nil {
-			return QuestionState{}, fmt.Errorf("failed to get fibber in round: %w", err)
+			return QuestionState{}, fmt.Errorf("failed to get fibbers in round: %w", err)
 		}
 
 		for i, player := range players {
-			if player.ID == fibber.PlayerID {
-				fibberLoc = i
+			isFibber := slices.ContainsFunc(fibbers, func(fibber db.FibbingItPlayerRole) bool {
+				return fibber.PlayerID == player.ID
+			})
+			if isFibber {
+				fibberLocs = append(fibberLocs, i)
 			}
 		}
 	}
 
-	normalsQuestions, fibberQuestions, err := getQuestions(ctx, r.store, "fibbing_it", roundType)
+	playerIDs := []uuid.UUID{}
+	for _, player := range players {
+		playerIDs = append(playerIDs, player.ID)
+	}
+
+	used, err := getUsedQuestions(ctx, r.store, gameStateID, playerIDs, int(round.RecentGames))
+	if err != nil {
+		return QuestionState{}, err
+	}
+
+	normalsQuestions, fibberQuestions, err := getQuestions(ctx, r.store, round.GameName, roundType, used)
 	if err != nil {
 		return QuestionState{}, errors.New(err.Error())
 	}
@@ -570,8 +840,8 @@ func (r *RoundService) UpdateStateToQuestion(
 		return QuestionState{}, ErrNoFibberQuestions
 	}
 
-	if fibberLoc == -1 {
-		return QuestionState{}, errors.New("failed to set fibber location in players slice")
+	if len(fibberLocs) == 0 {
+		return QuestionState{}, errors.New("failed to set fibber locations in players slice")
 	}
 
 	result, err := r.store.UpdateStateToQuestion(ctx, db.UpdateStateToQuestionArgs{
@@ -581,33 +851,44 @@ func (r *RoundService) UpdateStateToQuestion(
 		NormalsQuestionID: normalsQuestions[0].QuestionID,
 		FibberQuestionID:  fibberQuestions[0].QuestionID,
 		RoundType:         roundType,
+		RoundTypeIndex:    roundTypeIndex,
 		RoundNumber:       roundNumber,
 		Players:           players,
-		FibberLoc:         fibberLoc,
+		FibberLocs:        fibberLocs,
 	})
 	if err != nil {
 		if err.Error() == "game state is already in FIBBING_IT_QUESTION state" {
-			return r.getQuestionStateByGameStateID(ctx, gameStateID)
+			return r.GetQuestionStateByGameStateID(ctx, gameStateID)
 		}
 		return QuestionState{}, err
 	}
 
+	nicknames := []string{}
+	for _, p := range result.Players {
+		nicknames = append(nicknames, p.Nickname)
+	}
+
 	playersWithRole := []PlayerWithRole{}
 	for i, player := range result.Players {
 		role := NormalRole
 
 		var question string
+		var answerOptions []string
 		for _, localeQuestion := range normalsQuestions {
 			if localeQuestion.Locale == player.Locale.String {
 				question = localeQuestion.Question
+				answerOptions = localeQuestion.AnswerOptions
 			} else if question == "" && localeQuestion.Locale == r.defaultLocale {
 				question = localeQuestion.Question
+				answerOptions = localeQuestion.AnswerOptions
 			}
 		}
 
-		if i == fibberLoc {
+		if slices.Contains(fibberLocs, i) {
 			role = FibberRole
 			question = ""
+			// INFO: Everyone picks from the normal question's options, otherwise the fibber's options give them away.
+			// In ranking rounds everyone ranks the same items, only the fibber's criterion is different.
 			for _, localeQuestion := range fibberQuestions {
 				if localeQuestion.Locale == player.Locale.String {
 					question = localeQuestion.Question
@@ -616,22 +897,12 @@ func (r *RoundService) UpdateStateToQuestion(
 				}
 			}
 		}
-		answers := []string{}
-		if roundType == RoundTypeMultipleChoice {
-			answers = []string{"Strongly Agree", "Agree", "Neutral", "Disagree", "Strongly Disagree"}
-		} else if roundType == RoundTypeMostLikely {
-			for _, p := range result.Players {
-				answers = append(answers, p.Nickname)
-			}
-			slices.Sort(answers)
-		}
-
 		playersWithRole = append(playersWithRole, PlayerWithRole{
 			ID:              player.ID,
 			Role:            role,
 			Question:        question,
 			IsAnswerReady:   false,
-			PossibleAnswers: answers,
+			PossibleAnswers: getPossibleAnswers(roundType, nicknames, answerOptions),
 		})
 	}
 
@@ -694,7 +965,7 @@ func (r *RoundService) GetQuestionState(ctx context.Context, playerID uuid.UUID)
 	return questionState, nil
 }
 
-func (r *RoundService) getQuestionStateByGameStateID(ctx context.Context, gameStateID uuid.UUID) (QuestionState, error) {
+func (r *RoundService) GetQuestionStateByGameStateID(ctx context.Context, gameStateID uuid.UUID) (QuestionState, error) {
 	playersData, err := r.store.GetAllPlayersQuestionStateByGameStateID(ctx, gameStateID)
 	if err != nil {
 		return QuestionState{}, err
@@ -768,6 +1039,7 @@ func (r *RoundService) getQuestionStateByGameStateID(ctx context.Context, gameSt
 			PossibleAnswers: answers,
 			CurrentAnswer:   playerData.CurrentAnswer,
 			IsHost:          playerData.PlayerID == hostPlayerID,
+			IsInactive:      playerData.InactiveAt.Valid,
 		})
 	}
 
@@ -825,6 +1097,23 @@ func (r *RoundService) GetGameStateByID(ctx context.Context, gameStateID uuid.UU
 	return gameState, err
 }
 
+// GetTimings returns the phase durations the host picked in the lobby before the game started.
+func (r *RoundService) GetTimings(ctx context.Context, gameStateID uuid.UUID) (Timings, error) {
+	game, err := r.store.GetGameState(ctx, gameStateID)
+	if err != nil {
+		return Timings{}, err
+	}
+
+	return newTimings(
+		game.QuestionSeconds,
+		game.VotingSeconds,
+		game.RevealSeconds,
+		game.RevoteSeconds,
+		game.ScoreSeconds,
+		game.WinnerSeconds,
+	), nil
+}
+
 func (r *RoundService) GetScoreState(ctx context.Context, scoring Scoring, playerID uuid.UUID) (ScoreState, error) {
 	gameState, err := r.store.GetGameStateByPlayerID(ctx, playerID)
 	if err != nil {
@@ -861,6 +1150,16 @@ func (r *RoundService) getScoreState(
 		return ScoreState{}, nil, err
 	}
 
+	fibbers, err := r.store.GetFibbersByRoundID(ctx, round.ID)
+	if err != nil {
+		return ScoreState{}, nil, err
+	}
+
+	fibberIDs := []uuid.UUID{}
+	for _, fibber := range fibbers {
+		fibberIDs = append(fibberIDs, fibber.PlayerID)
+	}
+
 	scoredByPlayerID, err := r.store.GetTotalScoresByGameStateID(ctx, db.GetTotalScoresByGameStateIDParams{
 		ID:   gameStateID,
 		ID_2: round.ID,
@@ -884,7 +1183,6 @@ func (r *RoundService) getScoreState(
 	fibberCaught := false
 
 	// TODO: add score to previous rounds
-	fibberID := allVotesInRoundType[0].FibberID
 	for _, p := range allVotesInRoundType {
 		player, ok := playerScoreMap[p.VoterID]
 		if !ok {
@@ -894,22 +1192,23 @@ func (r *RoundService) getScoreState(
 				Nickname: p.VoterNickname,
 				Score:    0,
 			}
-		}
 
-		// INFO: If user has an existing score add it on so we can show their total score.
-		// If this is the first round of scoring, then there won't be a score to show.
-		if currentScore, ok := currentScoreMap[p.VoterID]; ok {
-			player.Score += currentScore
+			// INFO: If user has an existing score add it on so we can show their total score.
+			// If this is the first round of scoring, then there won't be a score to show. Players vote once
+			// for every fibber, so we only add it the first time we see them.
+			if currentScore, ok := currentScoreMap[p.VoterID]; ok {
+				player.Score += currentScore
+			}
 		}
 
 		playerScoreMap[p.VoterID] = player
-		if p.VotedForID == fibberID {
+		if p.VotedForFibber {
 			player.Score += scoring.GuessedFibber
 
 			if p.RoundID == round.ID {
 				fibberVotesThisRound++
 			}
-		} else if p.VoterID != fibberID {
+		} else if !p.VoterIsFibber {
 			player.Score += scoring.FibberEvadeCapture
 		}
 
@@ -924,12 +1223,26 @@ func (r *RoundService) getScoreState(
 		fibberCaught = true
 	}
 
+	reasons, err := r.getScoreReasons(ctx, round, fibberIDs, allVotesInRoundType, currentScoreMap, scoring)
+	if err != nil {
+		return ScoreState{}, nil, err
+	}
+
+	bonuses := map[uuid.UUID]int{}
+	for playerID, playerReasons := range reasons {
+		for _, reason := range playerReasons {
+			bonuses[playerID] += reason.Points
+		}
+	}
+
 	// INFO: Some players may not have voted, so we want to give them a score of 0. Unless they are fibber,
 	// then they get a score for every round they evaded capture.
 	playersScore := []PlayerWithScoring{}
 	dbPlayerScores := []db.AddFibbingItScoreParams{}
 	for _, p := range allPlayers {
 		if player, ok := playerScoreMap[p.ID]; ok {
+			player.Score += bonuses[p.ID]
+			player.Reasons = reasons[p.ID]
 			playersScore = append(playersScore, player)
 		} else {
 			score := PlayerWithScoring{
@@ -939,7 +1252,7 @@ func (r *RoundService) getScoreState(
 				Score:    0,
 			}
 
-			if p.ID == fibberID {
+			if slices.Contains(fibberIDs, p.ID) {
 				// INFO: Fibber got caught this round
 				roundNumber := round.Round
 				if fibberCaught {
@@ -948,6 +1261,8 @@ func (r *RoundService) getScoreState(
 
 				score.Score = scoring.FibberEvadeCapture * int(roundNumber)
 			}
+			score.Score += bonuses[p.ID]
+			score.Reasons = reasons[p.ID]
 			playersScore = append(playersScore, score)
 		}
 
@@ -956,12 +1271,22 @@ func (r *RoundService) getScoreState(
 			return ScoreState{}, nil, err
 		}
 
+		reasonScorers := []string{}
+		reasonPoints := []int32{}
+		for _, reason := range reasons[p.ID] {
+			reasonScorers = append(reasonScorers, reason.Scorer)
+			//nolint:gosec // disable G115
+			reasonPoints = append(reasonPoints, int32(reason.Points))
+		}
+
 		dbPlayerScores = append(dbPlayerScores, db.AddFibbingItScoreParams{
 			ID:       scoreID,
 			RoundID:  round.ID,
 			PlayerID: p.ID,
 			//nolint:gosec // disable G115
-			Score: int32(playerScoreMap[p.ID].Score),
+			Score:         int32(playerScoreMap[p.ID].Score + bonuses[p.ID]),
+			ReasonScorers: reasonScorers,
+			ReasonPoints:  reasonPoints,
 		})
 	}
 
@@ -969,20 +1294,72 @@ func (r *RoundService) getScoreState(
 		return playersScore[i].Score > playersScore[j].Score
 	})
 
+	series, err := r.getSeriesState(ctx, gameStateID, round.SeriesID, round.SeriesGame, round.SeriesGames, playersScore)
+	if err != nil {
+		return ScoreState{}, nil, err
+	}
+
 	timeLeft := time.Until(deadline)
 	scoringState := ScoreState{
-		GameStateID:  gameStateID,
-		Players:      playersScore,
-		Deadline:     timeLeft,
-		RoundType:    round.RoundType,
-		RoundNumber:  int(round.Round),
-		TotalRounds:  int(totalRounds),
-		FibberCaught: fibberCaught,
+		GameStateID:     gameStateID,
+		Players:         playersScore,
+		Deadline:        timeLeft,
+		RoundType:       round.RoundType,
+		RoundNumber:     int(round.Round),
+		TotalRounds:     int(totalRounds),
+		MaxRounds:       getMaxRounds(round.MaxRounds),
+		IsLastRoundType: isLastRoundType(round.RoundTypes, round.RoundTypeIndex),
+		FibberCaught:    fibberCaught,
+		Series:          series,
 	}
 
 	return scoringState, dbPlayerScores, nil
 }
 
+// getScoreReasons works out the bonus points each scorer gives for the latest round. Once the round's scores have been
+// stored, the stored reasons are used, so the score screen shows the same reasons after a reconnect or recovery.
+func (r *RoundService) getScoreReasons(
+	ctx context.Context,
+	round db.GetLatestRoundByGameStateIDRow,
+	fibberIDs []uuid.UUID,
+	votes []db.GetAllVotesForRoundByGameStateIDRow,
+	currentScores map[uuid.UUID]int,
+	scoring Scoring,
+) (map[uuid.UUID][]ScoreReason, error) {
+	stored, err := r.store.GetScoreReasonsByRoundID(ctx, round.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	reasons := map[uuid.UUID][]ScoreReason{}
+	if len(stored) > 0 {
+		for _, row := range stored {
+			for i, scorer := range row.ReasonScorers {
+				if i >= len(row.ReasonPoints) {
+					break
+				}
+				reasons[row.PlayerID] = append(reasons[row.PlayerID], ScoreReason{
+					Scorer: scorer,
+					Points: int(row.ReasonPoints[i]),
+				})
+			}
+		}
+		return reasons, nil
+	}
+
+	scoringRound := newScoringRound(round.ID, fibberIDs, votes, currentScores)
+	for _, scorer := range getScorers(round.Scorers) {
+		for playerID, points := range scorer.Score(scoringRound, scoring) {
+			if points <= 0 {
+				continue
+			}
+			reasons[playerID] = append(reasons[playerID], ScoreReason{Scorer: scorer.Name(), Points: points})
+		}
+	}
+
+	return reasons, nil
+}
+
 func (r *RoundService) UpdateStateToWinner(
 	ctx context.Context,
 	gameStateID uuid.UUID,
@@ -1009,7 +1386,7 @@ func (r *RoundService) UpdateStateToWinner(
 		return WinnerState{}, err
 	}
 
-	return r.getWinnerState(ctx, gameStateID)
+	return r.getWinnerState(ctx, game)
 }
 
 func (r *RoundService) GetWinnerState(ctx context.Context, playerID uuid.UUID) (WinnerState, error) {
@@ -1018,10 +1395,11 @@ func (r *RoundService) GetWinnerState(ctx context.Context, playerID uuid.UUID) (
 		return WinnerState{}, err
 	}
 
-	return r.getWinnerState(ctx, gameState.ID)
+	return r.getWinnerState(ctx, gameState)
 }
 
-func (r *RoundService) getWinnerState(ctx context.Context, gameStateID uuid.UUID) (WinnerState, error) {
+func (r *RoundService) getWinnerState(ctx context.Context, game db.GameState) (WinnerState, error) {
+	gameStateID := game.ID
 	// INFO: Query adds all scored that don't include a certain round ID, so we use a fake round ID, so it adds
 	// all player scores.
 	fakeRoundID, err := r.randomizer.GetID()
@@ -1052,25 +1430,82 @@ func (r *RoundService) getWinnerState(ctx context.Context, gameStateID uuid.UUID
 		players = append(players, player)
 	}
 
+	room, err := r.store.GetRoomByGameStateID(ctx, gameStateID)
+	if err != nil {
+		return WinnerState{}, err
+	}
+
+	series, err := r.getSeriesState(ctx, gameStateID, game.SeriesID, game.SeriesGame, game.SeriesGames, players)
+	if err != nil {
+		return WinnerState{}, err
+	}
+
+	answers, err := r.store.GetRecapAnswersByGameStateID(ctx, gameStateID)
+	if err != nil {
+		return WinnerState{}, err
+	}
+
+	votes, err := r.store.GetRecapVotesByGameStateID(ctx, gameStateID)
+	if err != nil {
+		return WinnerState{}, err
+	}
+
 	return WinnerState{
-		Players: players,
+		GameStateID:  gameStateID,
+		Players:      players,
+		HostPlayerID: room.HostPlayer,
+		Series:       series,
+		Awards:       getAwards(answers, votes),
 	}, nil
 }
 
-func getNextRoundType(roundType string) string {
-	nextRoundMap := map[string]string{
-		RoundTypeFreeForm:       RoundTypeMultipleChoice,
-		RoundTypeMultipleChoice: RoundTypeMostLikely,
-		RoundTypeMostLikely:     "", // No next round type - game should end
+// RoundTypes returns every round type in the order they are played by default.
+func RoundTypes() []string {
+	return []string{RoundTypeFreeForm, RoundTypeMultipleChoice, RoundTypeMostLikely}
+}
+
+// AllRoundTypes returns every round type a host can add to the round order, including the ones not played by default.
+func AllRoundTypes() []string {
+	return append(RoundTypes(), RoundTypeNumeric, RoundTypeRanking)
+}
+
+// getNextRoundType returns the round type after the one at roundTypeIndex, or an empty string if the game is over.
+func getNextRoundType(roundTypes []string, roundTypeIndex int32) (string, int32) {
+	roundTypes = getRoundTypes(roundTypes)
+	nextIndex := roundTypeIndex + 1
+	if int(nextIndex) >= len(roundTypes) {
+		return "", nextIndex
 	}
 
-	return nextRoundMap[roundType]
+	return roundTypes[nextIndex], nextIndex
 }
 
-func (r *RoundService) getValidAnswers(ctx context.Context, roundType string, playerID uuid.UUID) ([]string, error) {
+// getPossibleAnswers returns the answers players pick from, free form rounds have none.
+func getPossibleAnswers(roundType string, nicknames []string, answerOptions []string) []string {
 	answers := []string{}
 	if roundType == RoundTypeMultipleChoice {
-		answers = []string{"Strongly Agree", "Agree", "Neutral", "Disagree", "Strongly Disagree"}
+		answers = getMultipleChoiceAnswers(answerOptions)
+	} else if roundType == RoundTypeRanking {
+		answers = append(answers, answerOptions...)
+	} else if roundType == RoundTypeMostLikely {
+		answers = append(answers, nicknames...)
+		slices.Sort(answers)
+	}
+	return answers
+}
+
+func isLastRoundType(roundTypes []string, roundTypeIndex int32) bool {
+	return int(roundTypeIndex) >= len(getRoundTypes(roundTypes))-1
+}
+
+func (r *RoundService) getValidAnswers(ctx context.Context, roundType string, playerID uuid.UUID) ([]string, error) {
+	answers := []string{}
+	if roundType == RoundTypeMultipleChoice || roundType == RoundTypeRanking {
+		answerOptions, err := r.getPlayerAnswerOptions(ctx, playerID)
+		if err != nil {
+			return nil, err
+		}
+		return getPossibleAnswers(roundType, nil, answerOptions), nil
 	} else if roundType == RoundTypeMostLikely {
 		players, err := r.store.GetAllPlayersInRoom(ctx, playerID)
 		if err != nil {
@@ -1231,3 +1666,21 @@ func (r *RoundService) GetPauseStatus(ctx context.Context, gameStateID uuid.UUID
 func (r *RoundService) GetAllPlayersByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]db.GetAllPlayersByGameStateIDRow, error) {
 	return r.store.GetAllPlayersByGameStateID(ctx, gameStateID)
 }
+
+// getMaxRounds falls back to the default for games started before max rounds was stored on the game state.
+func getMaxRounds(maxRounds int32) int {
+	if maxRounds <= 0 {
+		return DefaultMaxRounds
+	}
+
+	return int(maxRounds)
+}
+
+// getRoundTypes falls back to the default order for games started before round types could be picked.
+func getRoundTypes(roundTypes []string) []string {
+	if len(roundTypes) == 0 {
+		return RoundTypes()
+	}
+
+	return roundTypes
+}
@@ -28,7 +28,7 @@ func TestIntegrationRoundServiceSubmitAnswer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		playerService := service.NewPlayerService(str, randomizer)
 		roundService := service.NewRoundService(str, randomizer, "en-GB")
 
@@ -64,7 +64,7 @@ func TestIntegrationRoundServiceSubmitAnswer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		playerService := service.NewPlayerService(str, randomizer)
 		roundService := service.NewRoundService(str, randomizer, "en-GB")
 
@@ -89,7 +89,7 @@ func TestIntegrationRoundServiceSubmitAnswer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		playerService := service.NewPlayerService(str, randomizer)
 		roundService := service.NewRoundService(str, randomizer, "en-GB")
 
@@ -117,7 +117,7 @@ func TestIntegrationRoundServiceSubmitAnswer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		playerService := service.NewPlayerService(str, randomizer)
 		roundService := service.NewRoundService(str, randomizer, "en-GB")
 
@@ -142,7 +142,7 @@ func TestIntegrationRoundServiceSubmitAnswer(t *testing.T) {
 		ctx, err := getI18nCtx(t.Context())
 		require.NoError(t, err)
 
-		lobbyService := service.NewLobbyService(str, randomizer, "en-GB")
+		lobbyService := service.NewLobbyService(str, randomizer, "en-GB", defaultRoomSettings)
 		playerService := service.NewPlayerService(str, randomizer)
 		roundService := service.NewRoundService(str, randomizer, "en-GB")
 
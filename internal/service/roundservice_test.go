@@ -1,14 +1,17 @@
 package service_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -60,6 +63,7 @@ func TestRoundServiceSubmitAnswer(t *testing.T) {
 			RoundID:  roundID,
 			PlayerID: playerID,
 			Answer:   "My answer",
+			Ranking:  []string{},
 		}).Return(db.FibbingItAnswer{}, nil)
 
 		err = srv.SubmitAnswer(ctx, playerID, "My answer", now)
@@ -112,6 +116,7 @@ func TestRoundServiceSubmitAnswer(t *testing.T) {
 			RoundID:  roundID,
 			PlayerID: playerID,
 			Answer:   "other_player",
+			Ranking:  []string{},
 		}).Return(db.FibbingItAnswer{}, nil)
 
 		err = srv.SubmitAnswer(ctx, playerID, "other_player", now)
@@ -144,6 +149,9 @@ func TestRoundServiceSubmitAnswer(t *testing.T) {
 				SubmitDeadline: pgtype.Timestamp{Time: now.Add(1 * time.Hour)},
 				RoundType:      "multiple_choice",
 			}, nil)
+		mockStore.EXPECT().GetAnswerOptionsByPlayerID(ctx, playerID).Return([]db.GetAnswerOptionsByPlayerIDRow{
+			{Locale: "en-GB", AnswerOptions: []string{}, PlayerLocale: pgtype.Text{String: "en-GB"}},
+		}, nil)
 
 		u, err := uuid.NewV7()
 		require.NoError(t, err)
@@ -153,12 +161,213 @@ func TestRoundServiceSubmitAnswer(t *testing.T) {
 			RoundID:  roundID,
 			PlayerID: playerID,
 			Answer:   "Strongly Agree",
+			Ranking:  []string{},
 		}).Return(db.FibbingItAnswer{}, nil)
 
 		err = srv.SubmitAnswer(ctx, playerID, "Strongly Agree", now)
 		assert.NoError(t, err)
 	})
 
+	t.Run("Should successfully submit answer using the question's answer options", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		now := time.Now()
+		ctx := t.Context()
+
+		roomID, err := uuid.NewV7()
+		require.NoError(t, err)
+		roundID, err := uuid.NewV7()
+		require.NoError(t, err)
+		playerID, err := uuid.NewV7()
+		require.NoError(t, err)
+
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
+			ID:        roomID,
+			RoomState: db.Playing.String(),
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(
+			db.GetLatestRoundByPlayerIDRow{
+				ID:             roundID,
+				SubmitDeadline: pgtype.Timestamp{Time: now.Add(1 * time.Hour)},
+				RoundType:      "multiple_choice",
+			}, nil)
+		mockStore.EXPECT().GetAnswerOptionsByPlayerID(ctx, playerID).Return([]db.GetAnswerOptionsByPlayerIDRow{
+			{Locale: "en-GB", AnswerOptions: []string{"Never", "Always"}, PlayerLocale: pgtype.Text{String: "pt-PT"}},
+			{Locale: "pt-PT", AnswerOptions: []string{"Nunca", "Sempre"}, PlayerLocale: pgtype.Text{String: "pt-PT"}},
+		}, nil)
+
+		u, err := uuid.NewV7()
+		require.NoError(t, err)
+		mockRandom.EXPECT().GetID().Return(u, nil)
+		mockStore.EXPECT().UpsertFibbingItAnswer(ctx, db.UpsertFibbingItAnswerParams{
+			ID:       u,
+			RoundID:  roundID,
+			PlayerID: playerID,
+			Answer:   "Nunca",
+			Ranking:  []string{},
+		}).Return(db.FibbingItAnswer{}, nil)
+
+		err = srv.SubmitAnswer(ctx, playerID, "Nunca", now)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should successfully submit answer in numeric round", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		now := time.Now()
+		ctx := t.Context()
+
+		roomID, err := uuid.NewV7()
+		require.NoError(t, err)
+		roundID, err := uuid.NewV7()
+		require.NoError(t, err)
+		playerID, err := uuid.NewV7()
+		require.NoError(t, err)
+
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
+			ID:        roomID,
+			RoomState: db.Playing.String(),
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(
+			db.GetLatestRoundByPlayerIDRow{
+				ID:             roundID,
+				SubmitDeadline: pgtype.Timestamp{Time: now.Add(1 * time.Hour)},
+				RoundType:      "numeric",
+			}, nil)
+
+		u, err := uuid.NewV7()
+		require.NoError(t, err)
+		mockRandom.EXPECT().GetID().Return(u, nil)
+		mockStore.EXPECT().UpsertFibbingItAnswer(ctx, db.UpsertFibbingItAnswerParams{
+			ID:       u,
+			RoundID:  roundID,
+			PlayerID: playerID,
+			Answer:   "1500",
+			Ranking:  []string{},
+		}).Return(db.FibbingItAnswer{}, nil)
+
+		err = srv.SubmitAnswer(ctx, playerID, " 1.5e3 ", now)
+		assert.NoError(t, err)
+	})
+
+	numericTests := []struct {
+		name   string
+		answer string
+	}{
+		{name: "not a number", answer: "a lot"},
+		{name: "NaN", answer: "NaN"},
+		{name: "infinity", answer: "Inf"},
+	}
+
+	for _, tt := range numericTests {
+		t.Run("Should fail to submit answer in numeric round, "+tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockStore := mockService.NewMockRoundStore(t)
+			mockRandom := mockService.NewMockRandomizer(t)
+			srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+			now := time.Now()
+			ctx := t.Context()
+
+			mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
+				ID:        roomID,
+				RoomState: db.Playing.String(),
+			}, nil)
+			mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(
+				db.GetLatestRoundByPlayerIDRow{
+					ID:             roundID,
+					SubmitDeadline: pgtype.Timestamp{Time: now.Add(1 * time.Hour)},
+					RoundType:      "numeric",
+				}, nil)
+
+			err := srv.SubmitAnswer(ctx, playerID, tt.answer, now)
+			assert.ErrorIs(t, err, service.ErrInvalidNumericAnswer)
+		})
+	}
+
+	t.Run("Should successfully submit answer in ranking round", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		now := time.Now()
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
+			ID:        roomID,
+			RoomState: db.Playing.String(),
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(
+			db.GetLatestRoundByPlayerIDRow{
+				ID:             roundID,
+				SubmitDeadline: pgtype.Timestamp{Time: now.Add(1 * time.Hour)},
+				RoundType:      "ranking",
+			}, nil)
+		mockStore.EXPECT().GetAnswerOptionsByPlayerID(ctx, playerID).Return([]db.GetAnswerOptionsByPlayerIDRow{
+			{Locale: "en-GB", AnswerOptions: []string{"Tea", "Coffee", "Water"}, PlayerLocale: pgtype.Text{String: "en-GB"}},
+		}, nil)
+
+		u, err := uuid.NewV7()
+		require.NoError(t, err)
+		mockRandom.EXPECT().GetID().Return(u, nil)
+		mockStore.EXPECT().UpsertFibbingItAnswer(ctx, db.UpsertFibbingItAnswerParams{
+			ID:       u,
+			RoundID:  roundID,
+			PlayerID: playerID,
+			Answer:   "Water > Tea > Coffee",
+			Ranking:  []string{"Water", "Tea", "Coffee"},
+		}).Return(db.FibbingItAnswer{}, nil)
+
+		err = srv.SubmitAnswer(ctx, playerID, `["Water","Tea","Coffee"]`, now)
+		assert.NoError(t, err)
+	})
+
+	rankingTests := []struct {
+		name   string
+		answer string
+	}{
+		{name: "not a list", answer: "Water"},
+		{name: "missing an item", answer: `["Water","Tea"]`},
+		{name: "repeated item", answer: `["Water","Tea","Tea"]`},
+		{name: "unknown item", answer: `["Water","Tea","Juice"]`},
+	}
+
+	for _, tt := range rankingTests {
+		t.Run("Should fail to submit answer in ranking round, "+tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockStore := mockService.NewMockRoundStore(t)
+			mockRandom := mockService.NewMockRandomizer(t)
+			srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+			now := time.Now()
+			ctx := t.Context()
+
+			mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
+				ID:        roomID,
+				RoomState: db.Playing.String(),
+			}, nil)
+			mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(
+				db.GetLatestRoundByPlayerIDRow{
+					ID:             roundID,
+					SubmitDeadline: pgtype.Timestamp{Time: now.Add(1 * time.Hour)},
+					RoundType:      "ranking",
+				}, nil)
+			mockStore.EXPECT().GetAnswerOptionsByPlayerID(ctx, playerID).Return([]db.GetAnswerOptionsByPlayerIDRow{
+				{Locale: "en-GB", AnswerOptions: []string{"Tea", "Coffee", "Water"}, PlayerLocale: pgtype.Text{String: "en-GB"}},
+			}, nil)
+
+			err := srv.SubmitAnswer(ctx, playerID, tt.answer, now)
+			assert.ErrorIs(t, err, service.ErrInvalidRanking)
+		})
+	}
+
 	t.Run("Should fail to submit answer because we fail to get room details", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockRoundStore(t)
@@ -263,6 +472,7 @@ func TestRoundServiceSubmitAnswer(t *testing.T) {
 			RoundID:  roundID,
 			PlayerID: playerID,
 			Answer:   "My answer",
+			Ranking:  []string{},
 		}).Return(db.FibbingItAnswer{}, errors.New("failed to add answer to DB"))
 
 		err := srv.SubmitAnswer(ctx, playerID, "My answer", now)
@@ -349,11 +559,66 @@ func TestRoundServiceSubmitAnswer(t *testing.T) {
 				SubmitDeadline: pgtype.Timestamp{Time: now.Add(1 * time.Hour)},
 				RoundType:      "multiple_choice",
 			}, nil)
+		mockStore.EXPECT().GetAnswerOptionsByPlayerID(ctx, playerID).Return([]db.GetAnswerOptionsByPlayerIDRow{
+			{Locale: "en-GB", AnswerOptions: []string{}, PlayerLocale: pgtype.Text{String: "en-GB"}},
+		}, nil)
 
 		err := srv.SubmitAnswer(ctx, playerID, "invalid answer", now)
 		assert.ErrorContains(t, err, "must be one of")
 	})
 
+	t.Run("Should fail to submit default answer when question has its own answer options", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		now := time.Now()
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
+			ID:        roomID,
+			RoomState: db.Playing.String(),
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(
+			db.GetLatestRoundByPlayerIDRow{
+				ID:             roundID,
+				SubmitDeadline: pgtype.Timestamp{Time: now.Add(1 * time.Hour)},
+				RoundType:      "multiple_choice",
+			}, nil)
+		mockStore.EXPECT().GetAnswerOptionsByPlayerID(ctx, playerID).Return([]db.GetAnswerOptionsByPlayerIDRow{
+			{Locale: "en-GB", AnswerOptions: []string{"Never", "Always"}, PlayerLocale: pgtype.Text{String: "de-DE"}},
+		}, nil)
+
+		err := srv.SubmitAnswer(ctx, playerID, "Strongly Agree", now)
+		assert.ErrorContains(t, err, "must be one of")
+	})
+
+	t.Run("Should fail to submit answer in multiple_choice round, fail to get answer options", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		now := time.Now()
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{
+			ID:        roomID,
+			RoomState: db.Playing.String(),
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(
+			db.GetLatestRoundByPlayerIDRow{
+				ID:             roundID,
+				SubmitDeadline: pgtype.Timestamp{Time: now.Add(1 * time.Hour)},
+				RoundType:      "multiple_choice",
+			}, nil)
+		mockStore.EXPECT().GetAnswerOptionsByPlayerID(ctx, playerID).Return(nil, fmt.Errorf("failed to get answer options"))
+
+		err := srv.SubmitAnswer(ctx, playerID, "Strongly Agree", now)
+		assert.Error(t, err)
+	})
+
 	t.Run("Should handle single character answer", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockRoundStore(t)
@@ -814,11 +1079,15 @@ func TestRoundServiceSubmitVote(t *testing.T) {
 		u, err := uuid.NewV7()
 		require.NoError(t, err)
 		mockRandom.EXPECT().GetID().Return(u, nil)
-		mockStore.EXPECT().UpsertFibbingItVote(ctx, db.UpsertFibbingItVoteParams{
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{
+			{PlayerID: defaultOtherPlayerID, PlayerRole: "fibber"},
+		}, nil)
+		mockStore.EXPECT().SubmitVote(ctx, db.SubmitVoteArgs{
 			ID:               u,
 			RoundID:          roundID,
 			PlayerID:         defaultHostPlayerID,
 			VotedForPlayerID: defaultOtherPlayerID,
+			MaxVotes:         1,
 		}).Return(nil)
 		mockStore.EXPECT().GetVotingState(ctx, roundID).Return([]db.GetVotingStateRow{
 			{
@@ -866,6 +1135,7 @@ func TestRoundServiceSubmitVote(t *testing.T) {
 					Answer:   "My other answer",
 				},
 			},
+			Fibbers: 1,
 		}
 
 		diffOpts := cmpopts.IgnoreFields(votingState, "Deadline")
@@ -873,6 +1143,40 @@ func TestRoundServiceSubmitVote(t *testing.T) {
 		assert.LessOrEqual(t, int(votingState.Deadline.Seconds()), 5)
 	})
 
+	t.Run("Should fail to vote for player who did not tie in revote", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetGameStateByPlayerID(ctx, defaultHostPlayerID).Return(db.GameState{
+			State: db.FibbingItVoting.String(),
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersInRoom(ctx, defaultHostPlayerID).Return([]db.GetAllPlayersInRoomRow{
+			{
+				ID:       defaultHostPlayerID,
+				Nickname: "Player 1",
+			},
+			{
+				ID:       defaultOtherPlayerID,
+				Nickname: "Player 2",
+			},
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, defaultHostPlayerID).Return(db.GetLatestRoundByPlayerIDRow{
+			ID:             roundID,
+			SubmitDeadline: pgtype.Timestamp{Time: time.Now().Add(5 * time.Second)},
+			RevoteCandidates: []string{
+				"0193a62a-4dff-774c-850a-b1fe78e2a8e3",
+				"0193a62a-4dff-774c-850a-b1fe78e2a8e4",
+			},
+		}, nil)
+
+		_, err := srv.SubmitVote(ctx, defaultHostPlayerID, "Player 2", time.Now())
+		assert.ErrorIs(t, err, service.ErrNotRevoteCandidate)
+	})
+
 	t.Run("Should fail because we fail to get game state", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockRoundStore(t)
@@ -1072,11 +1376,15 @@ func TestRoundServiceSubmitVote(t *testing.T) {
 		u, err := uuid.NewV7()
 		require.NoError(t, err)
 		mockRandom.EXPECT().GetID().Return(u, nil)
-		mockStore.EXPECT().UpsertFibbingItVote(ctx, db.UpsertFibbingItVoteParams{
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{
+			{PlayerID: defaultOtherPlayerID, PlayerRole: "fibber"},
+		}, nil)
+		mockStore.EXPECT().SubmitVote(ctx, db.SubmitVoteArgs{
 			ID:               u,
 			RoundID:          roundID,
 			PlayerID:         defaultHostPlayerID,
 			VotedForPlayerID: defaultOtherPlayerID,
+			MaxVotes:         1,
 		}).Return(errors.New("failed to upsert fibbing it vote"))
 
 		_, err = srv.SubmitVote(ctx, defaultHostPlayerID, "Player 2", now)
@@ -1112,11 +1420,15 @@ func TestRoundServiceSubmitVote(t *testing.T) {
 		u, err := uuid.NewV7()
 		require.NoError(t, err)
 		mockRandom.EXPECT().GetID().Return(u, nil)
-		mockStore.EXPECT().UpsertFibbingItVote(ctx, db.UpsertFibbingItVoteParams{
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{
+			{PlayerID: defaultOtherPlayerID, PlayerRole: "fibber"},
+		}, nil)
+		mockStore.EXPECT().SubmitVote(ctx, db.SubmitVoteArgs{
 			ID:               u,
 			RoundID:          roundID,
 			PlayerID:         defaultHostPlayerID,
 			VotedForPlayerID: defaultOtherPlayerID,
+			MaxVotes:         1,
 		}).Return(nil)
 		mockStore.EXPECT().GetVotingState(ctx, roundID).Return(
 			[]db.GetVotingStateRow{}, errors.New("failed to get vote count"),
@@ -1205,7 +1517,7 @@ func TestRoundServiceGetVotingState(t *testing.T) {
 		assert.LessOrEqual(t, int(votingState.Deadline.Seconds()), 5)
 	})
 
-	t.Run("Should fail to get voting state because fail to get round info from DB", func(t *testing.T) {
+	t.Run("Should successfully get voting state with answer spread in numeric round", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockRoundStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
@@ -1213,14 +1525,143 @@ func TestRoundServiceGetVotingState(t *testing.T) {
 
 		ctx := t.Context()
 
+		deadline := time.Now().Add(5 * time.Second)
 		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(
-			db.GetLatestRoundByPlayerIDRow{}, errors.New("failed to get round info"),
-		)
-		_, err := srv.GetVotingState(ctx, playerID)
-		assert.Error(t, err)
+			db.GetLatestRoundByPlayerIDRow{
+				ID:             roundID,
+				Round:          1,
+				SubmitDeadline: pgtype.Timestamp{Time: deadline},
+			}, nil)
+
+		otherPlayerIDs := []uuid.UUID{uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())}
+		mockStore.EXPECT().GetVotingState(ctx, roundID).Return(
+			[]db.GetVotingStateRow{
+				{
+					GameStateID: gameStateID,
+					PlayerID:    playerID,
+					Nickname:    "nickname",
+					Question:    "How many cups of tea do you drink a week",
+					Round:       1,
+					RoundType:   "numeric",
+					Answer:      pgtype.Text{String: "14"},
+				},
+				{
+					GameStateID: gameStateID,
+					PlayerID:    otherPlayerIDs[0],
+					Nickname:    "other",
+					Question:    "How many cups of tea do you drink a week",
+					Round:       1,
+					RoundType:   "numeric",
+					Answer:      pgtype.Text{String: "2"},
+				},
+				{
+					GameStateID: gameStateID,
+					PlayerID:    otherPlayerIDs[1],
+					Nickname:    "another",
+					Question:    "How many cups of tea do you drink a week",
+					Round:       1,
+					RoundType:   "numeric",
+					Answer:      pgtype.Text{String: "7"},
+				},
+				{
+					GameStateID: gameStateID,
+					PlayerID:    otherPlayerIDs[2],
+					Nickname:    "fibber",
+					Question:    "How many times do you go running a week",
+					Round:       1,
+					RoundType:   "numeric",
+					Role:        pgtype.Text{String: "fibber"},
+					Answer:      pgtype.Text{String: "100"},
+				},
+			}, nil)
+
+		mockStore.EXPECT().GetGameState(ctx, gameStateID).Return(db.GameState{ID: gameStateID}, nil)
+		mockStore.EXPECT().GetAllPlayersByGameStateID(ctx, gameStateID).Return([]db.GetAllPlayersByGameStateIDRow{
+			{ID: playerID, Nickname: "nickname", Avatar: "avatar"},
+		}, nil)
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{HostPlayer: playerID}, nil)
+
+		votingState, err := srv.GetVotingState(ctx, playerID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "numeric", votingState.RoundType)
+		assert.Equal(t, &service.AnswerSpread{Min: 2, Max: 100, Median: 10.5}, votingState.AnswerSpread)
 	})
 
-	t.Run("Should fail to get voting state because fail to get votes from DB", func(t *testing.T) {
+	t.Run("Should successfully get voting state with rankings in ranking round", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		deadline := time.Now().Add(5 * time.Second)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(
+			db.GetLatestRoundByPlayerIDRow{
+				ID:             roundID,
+				Round:          1,
+				SubmitDeadline: pgtype.Timestamp{Time: deadline},
+			}, nil)
+
+		otherPlayerID := uuid.Must(uuid.NewV7())
+		mockStore.EXPECT().GetVotingState(ctx, roundID).Return(
+			[]db.GetVotingStateRow{
+				{
+					GameStateID: gameStateID,
+					PlayerID:    playerID,
+					Nickname:    "nickname",
+					Question:    "Rank these drinks from best to worst",
+					Round:       1,
+					RoundType:   "ranking",
+					Answer:      pgtype.Text{String: "Tea > Coffee"},
+					Ranking:     []string{"Tea", "Coffee"},
+				},
+				{
+					GameStateID: gameStateID,
+					PlayerID:    otherPlayerID,
+					Nickname:    "fibber",
+					Question:    "Rank these drinks from most to least caffeine",
+					Round:       1,
+					RoundType:   "ranking",
+					Role:        pgtype.Text{String: "fibber"},
+					Answer:      pgtype.Text{String: "Coffee > Tea"},
+					Ranking:     []string{"Coffee", "Tea"},
+				},
+			}, nil)
+
+		mockStore.EXPECT().GetGameState(ctx, gameStateID).Return(db.GameState{ID: gameStateID}, nil)
+		mockStore.EXPECT().GetAllPlayersByGameStateID(ctx, gameStateID).Return([]db.GetAllPlayersByGameStateIDRow{
+			{ID: playerID, Nickname: "nickname", Avatar: "avatar"},
+		}, nil)
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, playerID).Return(db.Room{HostPlayer: playerID}, nil)
+
+		votingState, err := srv.GetVotingState(ctx, playerID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, votingState.AnswerSpread)
+		assert.Equal(t, []service.PlayerRanking{
+			{Nickname: "nickname", Ranking: []string{"Tea", "Coffee"}},
+			{Nickname: "fibber", Ranking: []string{"Coffee", "Tea"}},
+		}, votingState.Rankings)
+	})
+
+	t.Run("Should fail to get voting state because fail to get round info from DB", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(
+			db.GetLatestRoundByPlayerIDRow{}, errors.New("failed to get round info"),
+		)
+		_, err := srv.GetVotingState(ctx, playerID)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail to get voting state because fail to get votes from DB", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockRoundStore(t)
 		mockRandom := mockService.NewMockRandomizer(t)
@@ -1244,6 +1685,8 @@ func TestRoundServiceGetVotingState(t *testing.T) {
 func TestRoundServiceToggleVotingIsReady(t *testing.T) {
 	t.Parallel()
 
+	roundID := uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e"))
+
 	t.Run("Should successfully toggle voting ready state", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockRoundStore(t)
@@ -1256,7 +1699,18 @@ func TestRoundServiceToggleVotingIsReady(t *testing.T) {
 			State:          db.FibbingItVoting.String(),
 			SubmitDeadline: pgtype.Timestamp{Time: time.Now().Add(1 * time.Hour)},
 		}, nil)
-		mockStore.EXPECT().ToggleVotingIsReady(ctx, playerID).Return(db.FibbingItVote{}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(db.GetLatestRoundByPlayerIDRow{
+			ID: roundID,
+		}, nil)
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{{}}, nil)
+		mockStore.EXPECT().CountVotesByPlayerIDAndRoundID(ctx, db.CountVotesByPlayerIDAndRoundIDParams{
+			PlayerID: playerID,
+			RoundID:  roundID,
+		}).Return(1, nil)
+		mockStore.EXPECT().ToggleVotingIsReady(ctx, db.ToggleVotingIsReadyParams{
+			PlayerID: playerID,
+			RoundID:  roundID,
+		}).Return(db.FibbingItVote{}, nil)
 		mockStore.EXPECT().GetAllPlayersVotingIsReadyByPlayerID(ctx, playerID).Return(false, nil)
 
 		allReady, err := srv.ToggleVotingIsReady(ctx, playerID, time.Now().UTC())
@@ -1276,7 +1730,18 @@ func TestRoundServiceToggleVotingIsReady(t *testing.T) {
 			State:          db.FibbingItVoting.String(),
 			SubmitDeadline: pgtype.Timestamp{Time: time.Now().Add(1 * time.Hour)},
 		}, nil)
-		mockStore.EXPECT().ToggleVotingIsReady(ctx, playerID).Return(db.FibbingItVote{}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(db.GetLatestRoundByPlayerIDRow{
+			ID: roundID,
+		}, nil)
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{{}}, nil)
+		mockStore.EXPECT().CountVotesByPlayerIDAndRoundID(ctx, db.CountVotesByPlayerIDAndRoundIDParams{
+			PlayerID: playerID,
+			RoundID:  roundID,
+		}).Return(1, nil)
+		mockStore.EXPECT().ToggleVotingIsReady(ctx, db.ToggleVotingIsReadyParams{
+			PlayerID: playerID,
+			RoundID:  roundID,
+		}).Return(db.FibbingItVote{}, nil)
 		mockStore.EXPECT().GetAllPlayersVotingIsReadyByPlayerID(ctx, playerID).Return(true, nil)
 
 		allReady, err := srv.ToggleVotingIsReady(ctx, playerID, time.Now().UTC())
@@ -1284,6 +1749,63 @@ func TestRoundServiceToggleVotingIsReady(t *testing.T) {
 		assert.True(t, allReady)
 	})
 
+	t.Run("Should fail to toggle voting ready state because the player has not used all their votes", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetGameStateByPlayerID(ctx, playerID).Return(db.GameState{
+			State:          db.FibbingItVoting.String(),
+			SubmitDeadline: pgtype.Timestamp{Time: time.Now().Add(1 * time.Hour)},
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(db.GetLatestRoundByPlayerIDRow{
+			ID: roundID,
+		}, nil)
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{{}, {}}, nil)
+		mockStore.EXPECT().CountVotesByPlayerIDAndRoundID(ctx, db.CountVotesByPlayerIDAndRoundIDParams{
+			PlayerID: playerID,
+			RoundID:  roundID,
+		}).Return(1, nil)
+
+		_, err := srv.ToggleVotingIsReady(ctx, playerID, time.Now().UTC())
+		assert.ErrorIs(t, err, service.ErrMustSubmitVote)
+	})
+
+	t.Run("Should toggle voting ready state in a revote once the player has voted for the spots left", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		mockStore.EXPECT().GetGameStateByPlayerID(ctx, playerID).Return(db.GameState{
+			State:          db.FibbingItVoting.String(),
+			SubmitDeadline: pgtype.Timestamp{Time: time.Now().Add(1 * time.Hour)},
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(db.GetLatestRoundByPlayerIDRow{
+			ID:            roundID,
+			RevoteWinners: []string{defaultOtherPlayerID.String()},
+		}, nil)
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{{}, {}}, nil)
+		mockStore.EXPECT().CountVotesByPlayerIDAndRoundID(ctx, db.CountVotesByPlayerIDAndRoundIDParams{
+			PlayerID: playerID,
+			RoundID:  roundID,
+		}).Return(1, nil)
+		mockStore.EXPECT().ToggleVotingIsReady(ctx, db.ToggleVotingIsReadyParams{
+			PlayerID: playerID,
+			RoundID:  roundID,
+		}).Return(db.FibbingItVote{}, nil)
+		mockStore.EXPECT().GetAllPlayersVotingIsReadyByPlayerID(ctx, playerID).Return(false, nil)
+
+		allReady, err := srv.ToggleVotingIsReady(ctx, playerID, time.Now().UTC())
+		assert.NoError(t, err)
+		assert.False(t, allReady)
+	})
+
 	t.Run("Should fail to toggle voting ready state, because we fail to get game state", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockRoundStore(t)
@@ -1351,7 +1873,18 @@ func TestRoundServiceToggleVotingIsReady(t *testing.T) {
 				State:          db.FibbingItVoting.String(),
 				SubmitDeadline: pgtype.Timestamp{Time: time.Now().Add(1 * time.Hour)},
 			}, nil)
-			mockStore.EXPECT().ToggleVotingIsReady(ctx, playerID).Return(
+			mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(db.GetLatestRoundByPlayerIDRow{
+				ID: roundID,
+			}, nil)
+			mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{{}}, nil)
+			mockStore.EXPECT().CountVotesByPlayerIDAndRoundID(ctx, db.CountVotesByPlayerIDAndRoundIDParams{
+				PlayerID: playerID,
+				RoundID:  roundID,
+			}).Return(1, nil)
+			mockStore.EXPECT().ToggleVotingIsReady(ctx, db.ToggleVotingIsReadyParams{
+				PlayerID: playerID,
+				RoundID:  roundID,
+			}).Return(
 				db.FibbingItVote{}, errors.New("failed to toggle voting is ready"),
 			)
 
@@ -1374,7 +1907,18 @@ func TestRoundServiceToggleVotingIsReady(t *testing.T) {
 				State:          db.FibbingItVoting.String(),
 				SubmitDeadline: pgtype.Timestamp{Time: time.Now().Add(1 * time.Hour)},
 			}, nil)
-			mockStore.EXPECT().ToggleVotingIsReady(ctx, playerID).Return(db.FibbingItVote{}, nil)
+			mockStore.EXPECT().GetLatestRoundByPlayerID(ctx, playerID).Return(db.GetLatestRoundByPlayerIDRow{
+				ID: roundID,
+			}, nil)
+			mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{{}}, nil)
+			mockStore.EXPECT().CountVotesByPlayerIDAndRoundID(ctx, db.CountVotesByPlayerIDAndRoundIDParams{
+				PlayerID: playerID,
+				RoundID:  roundID,
+			}).Return(1, nil)
+			mockStore.EXPECT().ToggleVotingIsReady(ctx, db.ToggleVotingIsReadyParams{
+				PlayerID: playerID,
+				RoundID:  roundID,
+			}).Return(db.FibbingItVote{}, nil)
 			mockStore.EXPECT().GetAllPlayersVotingIsReadyByPlayerID(ctx, playerID).Return(
 				false, errors.New("failed to get player voting is ready status"),
 			)
@@ -1392,37 +1936,123 @@ func TestRoundServiceUpdateStateToReveal(t *testing.T) {
 	roundID := uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e"))
 
 	tests := []struct {
-		name                   string
-		votesPlayerOne         int
-		votesPlayerTwo         int
-		expectedPlayerRole     string
-		expectedPlayerNickname string
-		expectedPlayerAvatar   string
-		expectedShouldReveal   bool
+		name                 string
+		votesPlayerOne       int
+		votesPlayerTwo       int
+		playerTwoInactive    bool
+		expectedRevealed     []service.RevealedPlayer
+		expectedFibbersFound int
+		expectedShouldReveal bool
+		revealRule           string
+		revoteCandidates     []string
+		expectedRevealRule   string
+		expectedDecision     string
+		expectedVotes        int
 	}{
 		{
-			name:                   "Should show reveal state revealing normal player",
-			votesPlayerOne:         0,
-			votesPlayerTwo:         1,
-			expectedPlayerNickname: "Player 2",
-			expectedPlayerAvatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+2",
-			expectedPlayerRole:     "normal",
-			expectedShouldReveal:   true,
+			name:           "Should show reveal state revealing normal player",
+			votesPlayerOne: 0,
+			votesPlayerTwo: 1,
+			expectedRevealed: []service.RevealedPlayer{
+				{
+					ID:       defaultOtherPlayerID,
+					Nickname: "Player 2",
+					Avatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+2",
+					Role:     "normal",
+					Votes:    1,
+				},
+			},
+			expectedShouldReveal: true,
+			expectedRevealRule:   "unanimous",
+			expectedDecision:     "unanimous",
+			expectedVotes:        1,
 		},
 		{
-			name:                   "Should show reveal state revealing fibber player",
-			votesPlayerOne:         1,
-			votesPlayerTwo:         0,
-			expectedPlayerNickname: "Player 1",
-			expectedPlayerAvatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
-			expectedPlayerRole:     "fibber",
-			expectedShouldReveal:   true,
+			name:           "Should show reveal state revealing fibber player",
+			votesPlayerOne: 1,
+			votesPlayerTwo: 0,
+			expectedRevealed: []service.RevealedPlayer{
+				{
+					ID:       defaultHostPlayerID,
+					Nickname: "Player 1",
+					Avatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
+					Role:     "fibber",
+					Votes:    1,
+				},
+			},
+			expectedFibbersFound: 1,
+			expectedShouldReveal: true,
+			expectedRevealRule:   "unanimous",
+			expectedDecision:     "unanimous",
+			expectedVotes:        1,
 		},
 		{
 			name:                 "Should show reveal state and not reveal any player",
 			votesPlayerOne:       0,
 			votesPlayerTwo:       0,
 			expectedShouldReveal: false,
+			expectedRevealRule:   "unanimous",
+			expectedDecision:     "no_votes",
+		},
+		{
+			name:                 "Should not reveal tied players with unanimous rule",
+			votesPlayerOne:       1,
+			votesPlayerTwo:       1,
+			expectedShouldReveal: false,
+			expectedRevealRule:   "unanimous",
+			expectedDecision:     "no_consensus",
+			expectedVotes:        1,
+		},
+		{
+			name:                 "Should not reveal tied players with plurality rule",
+			votesPlayerOne:       1,
+			votesPlayerTwo:       1,
+			revealRule:           "plurality",
+			expectedShouldReveal: false,
+			expectedRevealRule:   "plurality",
+			expectedDecision:     "tie",
+			expectedVotes:        1,
+		},
+		{
+			name:             "Should reveal player picked in revote",
+			votesPlayerOne:   1,
+			votesPlayerTwo:   0,
+			revealRule:       "plurality",
+			revoteCandidates: []string{defaultHostPlayerID.String(), defaultOtherPlayerID.String()},
+			expectedRevealed: []service.RevealedPlayer{
+				{
+					ID:       defaultHostPlayerID,
+					Nickname: "Player 1",
+					Avatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
+					Role:     "fibber",
+					Votes:    1,
+				},
+			},
+			expectedFibbersFound: 1,
+			expectedShouldReveal: true,
+			expectedRevealRule:   "plurality",
+			expectedDecision:     "revote",
+			expectedVotes:        1,
+		},
+		{
+			name:              "Should not count inactive player as a voter",
+			votesPlayerOne:    1,
+			votesPlayerTwo:    0,
+			playerTwoInactive: true,
+			expectedRevealed: []service.RevealedPlayer{
+				{
+					ID:       defaultHostPlayerID,
+					Nickname: "Player 1",
+					Avatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
+					Role:     "fibber",
+					Votes:    1,
+				},
+			},
+			expectedFibbersFound: 1,
+			expectedShouldReveal: true,
+			expectedRevealRule:   "unanimous",
+			expectedDecision:     "unanimous",
+			expectedVotes:        1,
 		},
 	}
 
@@ -1445,9 +2075,12 @@ func TestRoundServiceUpdateStateToReveal(t *testing.T) {
 			}, nil)
 
 			mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
-				ID:        roundID,
-				Round:     1,
-				RoundType: "free_form",
+				ID:               roundID,
+				Round:            1,
+				RoundType:        "free_form",
+				MaxRounds:        3,
+				RevealRule:       tt.revealRule,
+				RevoteCandidates: tt.revoteCandidates,
 			}, nil)
 			mockStore.EXPECT().GetVotingState(ctx, roundID).Return([]db.GetVotingStateRow{
 				{
@@ -1469,6 +2102,7 @@ func TestRoundServiceUpdateStateToReveal(t *testing.T) {
 					Votes:          int64(tt.votesPlayerTwo),
 					SubmitDeadline: pgtype.Timestamp{Time: now},
 					Role:           pgtype.Text{String: "normal"},
+					InactiveAt:     pgtype.Timestamp{Time: now, Valid: tt.playerTwoInactive},
 				},
 			}, nil)
 
@@ -1489,20 +2123,46 @@ func TestRoundServiceUpdateStateToReveal(t *testing.T) {
 				HostPlayer: defaultHostPlayerID,
 			}, nil)
 
+			mockStore.EXPECT().GetAudienceVotesByRoundID(ctx, roundID).Return([]db.GetAudienceVotesByRoundIDRow{
+				{Nickname: "Player 1", Votes: 2},
+			}, nil)
+
+			revealedPlayers := []string{}
+			for _, p := range tt.expectedRevealed {
+				revealedPlayers = append(revealedPlayers, p.ID.String())
+			}
+			mockStore.EXPECT().UpdateFibbingItRoundRevealedPlayers(ctx, db.UpdateFibbingItRoundRevealedPlayersParams{
+				RevealedPlayers: revealedPlayers,
+				ID:              roundID,
+			}).Return(nil)
+
 			reveal, err := srv.UpdateStateToReveal(ctx, gameStateID, now)
 			assert.NoError(t, err)
+			expectedVoters := 2
+			if tt.playerTwoInactive {
+				expectedVoters = 1
+			}
 			expectedReveal := service.RevealRoleState{
-				VotedForPlayerNickname: tt.expectedPlayerNickname,
-				VotedForPlayerAvatar:   tt.expectedPlayerAvatar,
-				VotedForPlayerRole:     tt.expectedPlayerRole,
-				Round:                  1,
-				RoundType:              "free_form",
-				ShouldReveal:           tt.expectedShouldReveal,
-				Deadline:               time.Until(now),
+				RevealedPlayers: tt.expectedRevealed,
+				Round:           1,
+				MaxRounds:       3,
+				RoundType:       "free_form",
+				ShouldReveal:    tt.expectedShouldReveal,
+				Deadline:        time.Until(now),
 				PlayerIDs: []uuid.UUID{
 					defaultOtherPlayerID,
 					defaultHostPlayerID,
 				},
+				RevealRule:   tt.expectedRevealRule,
+				Decision:     tt.expectedDecision,
+				Votes:        tt.expectedVotes,
+				Voters:       expectedVoters,
+				Fibbers:      1,
+				FibbersFound: tt.expectedFibbersFound,
+				AudienceVotes: []service.AudienceVote{
+					{Nickname: "Player 1", Votes: 2},
+				},
+				GameStateID: gameStateID,
 			}
 
 			diffOpts := cmpopts.IgnoreFields(reveal, "Deadline")
@@ -1629,6 +2289,262 @@ func TestRoundServiceUpdateStateToReveal(t *testing.T) {
 	)
 }
 
+func TestRoundServiceGetTiedPlayers(t *testing.T) {
+	t.Parallel()
+
+	gameStateID := uuid.Must(uuid.FromString("fbb75599-9f7a-4392-b523-fd433b3208ea"))
+	roundID := uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e"))
+
+	t.Run("Should return tied players when room breaks ties with a revote", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGameState(ctx, gameStateID).Return(db.GameState{
+			ID:    gameStateID,
+			State: db.FibbingItVoting.String(),
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			ID:         roundID,
+			Round:      1,
+			RevealRule: service.RevealRulePlurality,
+			TieBreak:   service.TieBreakRevote,
+		}, nil)
+		mockStore.EXPECT().GetVotingState(ctx, roundID).Return([]db.GetVotingStateRow{
+			{GameStateID: gameStateID, PlayerID: defaultHostPlayerID, Nickname: "Player 1", Votes: 1},
+			{GameStateID: gameStateID, PlayerID: defaultOtherPlayerID, Nickname: "Player 2", Votes: 1},
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersByGameStateID(ctx, gameStateID).Return([]db.GetAllPlayersByGameStateIDRow{
+			{ID: defaultHostPlayerID, Nickname: "Player 1"},
+			{ID: defaultOtherPlayerID, Nickname: "Player 2"},
+		}, nil)
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, defaultHostPlayerID).Return(db.Room{
+			HostPlayer: defaultHostPlayerID,
+		}, nil)
+
+		tied, err := srv.GetTiedPlayers(ctx, gameStateID)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []uuid.UUID{defaultHostPlayerID, defaultOtherPlayerID}, tied)
+	})
+
+	t.Run("Should not return tied players when round was already revoted", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGameState(ctx, gameStateID).Return(db.GameState{
+			ID:    gameStateID,
+			State: db.FibbingItVoting.String(),
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			ID:               roundID,
+			RevealRule:       service.RevealRulePlurality,
+			TieBreak:         service.TieBreakRevote,
+			RevoteCandidates: []string{defaultHostPlayerID.String(), defaultOtherPlayerID.String()},
+		}, nil)
+
+		tied, err := srv.GetTiedPlayers(ctx, gameStateID)
+		assert.NoError(t, err)
+		assert.Empty(t, tied)
+	})
+
+	t.Run("Should not return tied players when room does not break ties", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGameState(ctx, gameStateID).Return(db.GameState{
+			ID:    gameStateID,
+			State: db.FibbingItVoting.String(),
+		}, nil)
+		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			ID:         roundID,
+			RevealRule: service.RevealRulePlurality,
+			TieBreak:   service.TieBreakNone,
+		}, nil)
+
+		tied, err := srv.GetTiedPlayers(ctx, gameStateID)
+		assert.NoError(t, err)
+		assert.Empty(t, tied)
+	})
+
+	t.Run("Should not return tied players when game already left voting", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		mockStore.EXPECT().GetGameState(ctx, gameStateID).Return(db.GameState{
+			ID:    gameStateID,
+			State: db.FibbingItReveal.String(),
+		}, nil)
+
+		tied, err := srv.GetTiedPlayers(ctx, gameStateID)
+		assert.NoError(t, err)
+		assert.Empty(t, tied)
+	})
+}
+
+func TestRoundServiceUpdateStateToRevote(t *testing.T) {
+	t.Parallel()
+
+	gameStateID := uuid.Must(uuid.FromString("fbb75599-9f7a-4392-b523-fd433b3208ea"))
+	roundID := uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e"))
+	thirdPlayerID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d3"))
+
+	expectVotingState := func(
+		ctx context.Context,
+		mockStore *mockService.MockRoundStore,
+		deadline time.Time,
+		votes map[uuid.UUID]int64,
+		fibbers []uuid.UUID,
+	) {
+		rows := []db.GetVotingStateRow{}
+		players := []db.GetAllPlayersByGameStateIDRow{}
+		for i, playerID := range []uuid.UUID{defaultHostPlayerID, defaultOtherPlayerID, thirdPlayerID} {
+			role := "normal"
+			if slices.Contains(fibbers, playerID) {
+				role = "fibber"
+			}
+			nickname := fmt.Sprintf("Player %d", i+1)
+			rows = append(rows, db.GetVotingStateRow{
+				GameStateID:    gameStateID,
+				PlayerID:       playerID,
+				Nickname:       nickname,
+				Votes:          votes[playerID],
+				SubmitDeadline: pgtype.Timestamp{Time: deadline},
+				Role:           pgtype.Text{String: role},
+				Question:       "My question",
+			})
+			players = append(players, db.GetAllPlayersByGameStateIDRow{ID: playerID, Nickname: nickname})
+		}
+
+		mockStore.EXPECT().GetVotingState(ctx, roundID).Return(rows, nil).Once()
+		mockStore.EXPECT().GetGameState(ctx, gameStateID).Return(db.GameState{ID: gameStateID}, nil).Once()
+		mockStore.EXPECT().GetAllPlayersByGameStateID(ctx, gameStateID).Return(players, nil).Once()
+		mockStore.EXPECT().GetRoomByPlayerID(ctx, defaultHostPlayerID).Return(db.Room{
+			HostPlayer: defaultHostPlayerID,
+		}, nil).Once()
+	}
+
+	t.Run("Should start revote between tied players", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		deadline := time.Now().Add(20 * time.Second)
+		candidates := []uuid.UUID{defaultHostPlayerID, defaultOtherPlayerID}
+		fibbers := []uuid.UUID{defaultOtherPlayerID}
+
+		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			ID:         roundID,
+			Round:      1,
+			RevealRule: service.RevealRulePlurality,
+		}, nil)
+		expectVotingState(ctx, mockStore, deadline, map[uuid.UUID]int64{
+			defaultHostPlayerID:  1,
+			defaultOtherPlayerID: 1,
+		}, fibbers)
+		mockStore.EXPECT().StartRevote(ctx, db.StartRevoteArgs{
+			GameStateID: gameStateID,
+			RoundID:     roundID,
+			Candidates:  []string{defaultHostPlayerID.String(), defaultOtherPlayerID.String()},
+			Winners:     []string{},
+			Deadline:    deadline,
+		}).Return(nil)
+		expectVotingState(ctx, mockStore, deadline, map[uuid.UUID]int64{}, fibbers)
+
+		votingState, err := srv.UpdateStateToRevote(ctx, gameStateID, candidates, deadline)
+		assert.NoError(t, err)
+		assert.True(t, votingState.IsRevote)
+		for _, player := range votingState.Players {
+			assert.Equal(t, slices.Contains(candidates, player.ID), player.IsRevoteCandidate)
+		}
+	})
+
+	t.Run("Should keep players who clearly won a spot out of the revote", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		deadline := time.Now().Add(20 * time.Second)
+		candidates := []uuid.UUID{defaultOtherPlayerID, thirdPlayerID}
+		fibbers := []uuid.UUID{defaultHostPlayerID, defaultOtherPlayerID}
+
+		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			ID:         roundID,
+			Round:      1,
+			RevealRule: service.RevealRulePlurality,
+		}, nil)
+		expectVotingState(ctx, mockStore, deadline, map[uuid.UUID]int64{
+			defaultHostPlayerID:  5,
+			defaultOtherPlayerID: 3,
+			thirdPlayerID:        3,
+		}, fibbers)
+		mockStore.EXPECT().StartRevote(ctx, db.StartRevoteArgs{
+			GameStateID: gameStateID,
+			RoundID:     roundID,
+			Candidates:  []string{defaultOtherPlayerID.String(), thirdPlayerID.String()},
+			Winners:     []string{defaultHostPlayerID.String()},
+			Deadline:    deadline,
+		}).Return(nil)
+		expectVotingState(ctx, mockStore, deadline, map[uuid.UUID]int64{}, fibbers)
+
+		_, err := srv.UpdateStateToRevote(ctx, gameStateID, candidates, deadline)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should fail to start revote without a tie", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		_, err := srv.UpdateStateToRevote(t.Context(), gameStateID, []uuid.UUID{defaultHostPlayerID}, time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail to start revote because DB call fails", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		deadline := time.Now().Add(20 * time.Second)
+		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			ID:         roundID,
+			RevealRule: service.RevealRulePlurality,
+		}, nil)
+		expectVotingState(ctx, mockStore, deadline, map[uuid.UUID]int64{
+			defaultHostPlayerID:  1,
+			defaultOtherPlayerID: 1,
+		}, []uuid.UUID{defaultOtherPlayerID})
+		mockStore.EXPECT().StartRevote(ctx, db.StartRevoteArgs{
+			GameStateID: gameStateID,
+			RoundID:     roundID,
+			Candidates:  []string{defaultHostPlayerID.String(), defaultOtherPlayerID.String()},
+			Winners:     []string{},
+			Deadline:    deadline,
+		}).Return(errors.New("failed to start revote"))
+
+		candidates := []uuid.UUID{defaultHostPlayerID, defaultOtherPlayerID}
+		_, err := srv.UpdateStateToRevote(ctx, gameStateID, candidates, deadline)
+		assert.Error(t, err)
+	})
+}
+
 func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 	t.Parallel()
 
@@ -1636,14 +2552,23 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 	groupID := uuid.Must(uuid.FromString("0193a629-1fcf-79dd-ac70-760bedbdffa9"))
 
 	tests := []struct {
-		name            string
-		roundNumber     int32
-		roundType       string
-		expectedRound   int32
-		expectedType    string
-		expectedAnswers []string
-		normalQuestion  string
-		fibberQuestion  string
+		name              string
+		roundNumber       int32
+		roundType         string
+		roundTypes        []string
+		roundTypeIndex    int32
+		maxRounds         int32
+		expectedRound     int32
+		expectedType      string
+		expectedTypeIndex int32
+		expectedAnswers   []string
+		normalQuestion    string
+		fibberQuestion    string
+		fibberSelection   string
+		fibberRotation    string
+		answerOptions     []string
+		// fibberAnswerOptions are the fibber question's own options, which the fibber should never be shown.
+		fibberAnswerOptions []string
 	}{
 		{
 			name:            "Should update state to question state successfully with round 2 and free_form",
@@ -1656,24 +2581,111 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 			fibberQuestion:  "What is your favourite hotel",
 		},
 		{
-			name:            "Should update state to question state successfully with round 1 and multiple_choice",
-			roundNumber:     3,
+			name:              "Should update state to question state successfully with round 1 and multiple_choice",
+			roundNumber:       3,
+			roundType:         "free_form",
+			expectedRound:     1,
+			expectedType:      "multiple_choice",
+			expectedTypeIndex: 1,
+			expectedAnswers:   []string{"Strongly Agree", "Agree", "Neutral", "Disagree", "Strongly Disagree"},
+			normalQuestion:    "I love pizza",
+			fibberQuestion:    "I love burgers",
+		},
+		{
+			name:              "Should update state to question state successfully with round 1 and multiple_choice, max rounds 1",
+			roundNumber:       1,
+			roundType:         "free_form",
+			maxRounds:         1,
+			expectedRound:     1,
+			expectedType:      "multiple_choice",
+			expectedTypeIndex: 1,
+			expectedAnswers:   []string{"Strongly Agree", "Agree", "Neutral", "Disagree", "Strongly Disagree"},
+			normalQuestion:    "I love pizza",
+			fibberQuestion:    "I love burgers",
+		},
+		{
+			name:              "Should update state to question state successfully with round 1 and most_likely",
+			roundNumber:       3,
+			roundType:         "multiple_choice",
+			roundTypeIndex:    1,
+			expectedRound:     1,
+			expectedType:      "most_likely",
+			expectedTypeIndex: 2,
+			expectedAnswers:   []string{"Player 1", "Player 2"},
+			normalQuestion:    "go to prison",
+			fibberQuestion:    "go to a bank",
+		},
+		{
+			name:              "Should update state to question state successfully with repeated free_form round type",
+			roundNumber:       3,
+			roundType:         "free_form",
+			roundTypes:        []string{"free_form", "free_form"},
+			expectedRound:     1,
+			expectedType:      "free_form",
+			expectedTypeIndex: 1,
+			expectedAnswers:   []string{},
+			normalQuestion:    "What if your favourite city",
+			fibberQuestion:    "What is your favourite hotel",
+		},
+		{
+			name:              "Should pick fibbers at random for a new round type",
+			roundNumber:       3,
+			roundType:         "free_form",
+			expectedRound:     1,
+			expectedType:      "multiple_choice",
+			expectedTypeIndex: 1,
+			expectedAnswers:   []string{"Strongly Agree", "Agree", "Neutral", "Disagree", "Strongly Disagree"},
+			normalQuestion:    "I love pizza",
+			fibberQuestion:    "I love burgers",
+			fibberSelection:   "random",
+		},
+		{
+			name:              "Should update state to question state successfully with the question's answer options",
+			roundNumber:       3,
+			roundType:         "free_form",
+			expectedRound:     1,
+			expectedType:      "multiple_choice",
+			expectedTypeIndex: 1,
+			expectedAnswers:   []string{"Never", "Sometimes", "Always"},
+			normalQuestion:    "I go to the gym",
+			fibberQuestion:    "I go running",
+			answerOptions:     []string{"Never", "Sometimes", "Always"},
+		},
+		{
+			name:                "Should give the fibber the normal question's answer options",
+			roundNumber:         3,
+			roundType:           "free_form",
+			expectedRound:       1,
+			expectedType:        "multiple_choice",
+			expectedTypeIndex:   1,
+			expectedAnswers:     []string{"Never", "Sometimes", "Always"},
+			normalQuestion:      "I go to the gym",
+			fibberQuestion:      "I go running",
+			answerOptions:       []string{"Never", "Sometimes", "Always"},
+			fibberAnswerOptions: []string{"Once a week", "Every day"},
+		},
+		{
+			name:            "Should pick new fibbers for every question",
+			roundNumber:     1,
 			roundType:       "free_form",
-			expectedRound:   1,
-			expectedType:    "multiple_choice",
-			expectedAnswers: []string{"Strongly Agree", "Agree", "Neutral", "Disagree", "Strongly Disagree"},
-			normalQuestion:  "I love pizza",
-			fibberQuestion:  "I love burgers",
+			expectedRound:   2,
+			expectedType:    "free_form",
+			expectedAnswers: []string{},
+			normalQuestion:  "What if your favourite city",
+			fibberQuestion:  "What is your favourite hotel",
+			fibberRotation:  "question",
 		},
 		{
-			name:            "Should update state to question state successfully with round 1 and most_likely",
-			roundNumber:     3,
-			roundType:       "multiple_choice",
-			expectedRound:   1,
-			expectedType:    "most_likely",
-			expectedAnswers: []string{"Player 1", "Player 2"},
-			normalQuestion:  "go to prison",
-			fibberQuestion:  "go to a bank",
+			name:              "Should update state to question state successfully with custom round type order",
+			roundNumber:       3,
+			roundType:         "most_likely",
+			roundTypes:        []string{"most_likely", "free_form"},
+			expectedRound:     1,
+			expectedType:      "free_form",
+			expectedTypeIndex: 1,
+			expectedAnswers:   []string{},
+			normalQuestion:    "What if your favourite city",
+			fibberQuestion:    "What is your favourite hotel",
 		},
 	}
 
@@ -1689,9 +2701,15 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 			deadline := time.Now().Add(5 * time.Second).UTC()
 
 			mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
-				ID:        uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff")),
-				RoundType: tt.roundType,
-				Round:     tt.roundNumber,
+				GameName:        gameName,
+				ID:              uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff")),
+				RoundType:       tt.roundType,
+				RoundTypeIndex:  tt.roundTypeIndex,
+				Round:           tt.roundNumber,
+				MaxRounds:       tt.maxRounds,
+				RoundTypes:      tt.roundTypes,
+				FibberSelection: tt.fibberSelection,
+				FibberRotation:  tt.fibberRotation,
 			}, nil)
 
 			mockStore.EXPECT().GetAllPlayersByGameStateID(ctx, gameStateID).Return(
@@ -1708,36 +2726,59 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 				nil,
 			)
 
+			mockStore.EXPECT().GetUsedQuestionIDs(ctx, db.GetUsedQuestionIDsParams{
+				GameStateID: gameStateID,
+				PlayerIds:   []uuid.UUID{defaultHostPlayerID, defaultOtherPlayerID},
+			}).Return([]db.GetUsedQuestionIDsRow{}, nil)
+
+			mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+				GameName:            gameName,
+				RoundType:           tt.expectedType,
+				ExcludedQuestionIds: []uuid.UUID{},
+			}).Return(db.QuestionPair{}, pgx.ErrNoRows)
 			mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-				GameName:  gameName,
-				RoundType: tt.expectedType,
+				GameName:            gameName,
+				RoundType:           tt.expectedType,
+				ExcludedQuestionIds: []uuid.UUID{},
 			}).Return([]db.GetRandomQuestionByRoundRow{
 				{
-					ID:         uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aca")),
-					QuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
-					Question:   tt.normalQuestion,
-					Locale:     "en-GB",
-					GroupID:    groupID,
+					ID:            uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aca")),
+					QuestionID:    uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+					Question:      tt.normalQuestion,
+					Locale:        "en-GB",
+					GroupID:       groupID,
+					AnswerOptions: tt.answerOptions,
 				},
 			}, nil)
 			mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
-				GroupType:          "",
-				GroupID:            groupID,
-				ExcludedQuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
-				RoundType:          tt.expectedType,
+				GroupType:           "",
+				GroupID:             groupID,
+				ExcludedQuestionID:  uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+				ExcludedQuestionIds: []uuid.UUID{},
+				RoundType:           tt.expectedType,
 			}).Return([]db.GetRandomQuestionInGroupRow{
 				{
-					QuestionID: uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
-					Question:   tt.fibberQuestion,
+					QuestionID:    uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
+					Question:      tt.fibberQuestion,
+					AnswerOptions: tt.fibberAnswerOptions,
 				},
 			}, nil)
 
-			if tt.roundNumber == 3 {
-				mockRandom.EXPECT().GetFibberIndex(2).Return(1)
-			} else {
-				mockStore.EXPECT().GetFibberByRoundID(ctx, uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff"))).Return(
-					db.FibbingItPlayerRole{
-						PlayerID: defaultOtherPlayerID,
+			switch {
+			case tt.fibberSelection == "random":
+				mockRandom.EXPECT().GetFibberIndexes(2, 1).Return([]int{1})
+			case tt.expectedRound == 1 || tt.fibberRotation == "question":
+				mockStore.EXPECT().GetFibberCountsByGameStateID(ctx, gameStateID).Return(
+					[]db.GetFibberCountsByGameStateIDRow{
+						{PlayerID: defaultHostPlayerID, FibberCount: 3},
+					},
+					nil,
+				)
+				mockRandom.EXPECT().GetFairFibberIndexes([]int{3, 0}, 1).Return([]int{1})
+			default:
+				mockStore.EXPECT().GetFibbersByRoundID(ctx, uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff"))).Return(
+					[]db.FibbingItPlayerRole{
+						{PlayerID: defaultOtherPlayerID},
 					},
 					nil,
 				)
@@ -1750,6 +2791,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 				NormalsQuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
 				FibberQuestionID:  uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
 				RoundType:         tt.expectedType,
+				RoundTypeIndex:    tt.expectedTypeIndex,
 				RoundNumber:       tt.expectedRound,
 				Players: []db.GetAllPlayersByGameStateIDRow{
 					{
@@ -1761,7 +2803,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 						Nickname: "Player 2",
 					},
 				},
-				FibberLoc: 1,
+				FibberLocs: []int{1},
 			}).Return(db.UpdateStateToQuestionResult{
 				RoundType:   tt.expectedType,
 				RoundNumber: tt.expectedRound,
@@ -1799,13 +2841,275 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 				RoundType: tt.expectedType,
 			}
 
-			assert.NoError(t, err)
+			assert.NoError(t, err)
+
+			diffOpts := cmpopts.IgnoreFields(gameState, "Deadline")
+			PartialEqual(t, expectedGameState, gameState, diffOpts)
+			assert.LessOrEqual(t, int(gameState.Deadline.Seconds()), 5)
+		})
+	}
+
+	t.Run("Should repeat questions used in this game when there are no unused questions left", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		deadline := time.Now().Add(5 * time.Second).UTC()
+		normalQuestionID := uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7"))
+		fibberQuestionID := uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f"))
+		players := []db.GetAllPlayersByGameStateIDRow{
+			{
+				ID:       defaultHostPlayerID,
+				Nickname: "Player 1",
+			},
+			{
+				ID:       defaultOtherPlayerID,
+				Nickname: "Player 2",
+			},
+		}
+
+		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			GameName:    gameName,
+			ID:          uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff")),
+			RoundType:   "free_form",
+			Round:       1,
+			RecentGames: 2,
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersByGameStateID(ctx, gameStateID).Return(players, nil)
+		mockStore.EXPECT().GetUsedQuestionIDs(ctx, db.GetUsedQuestionIDsParams{
+			GameStateID: gameStateID,
+			PlayerIds:   []uuid.UUID{defaultHostPlayerID, defaultOtherPlayerID},
+			RecentGames: 2,
+		}).Return([]db.GetUsedQuestionIDsRow{
+			{
+				GameStateID:      gameStateID,
+				NormalQuestionID: normalQuestionID,
+				FibberQuestionID: fibberQuestionID,
+			},
+		}, nil)
+
+		for _, excluded := range [][]uuid.UUID{{normalQuestionID, fibberQuestionID}, {}} {
+			mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+				GameName:            gameName,
+				RoundType:           "free_form",
+				ExcludedQuestionIds: excluded,
+			}).Return(db.QuestionPair{}, pgx.ErrNoRows)
+		}
+		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{normalQuestionID, fibberQuestionID},
+		}).Return([]db.GetRandomQuestionByRoundRow{}, nil)
+		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return([]db.GetRandomQuestionByRoundRow{
+			{
+				QuestionID: normalQuestionID,
+				Question:   "What if your favourite city",
+				Locale:     "en-GB",
+				GroupID:    groupID,
+			},
+		}, nil)
+		mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
+			GroupType:           "",
+			GroupID:             groupID,
+			ExcludedQuestionID:  normalQuestionID,
+			ExcludedQuestionIds: []uuid.UUID{},
+			RoundType:           "free_form",
+		}).Return([]db.GetRandomQuestionInGroupRow{
+			{
+				QuestionID: fibberQuestionID,
+				Question:   "What is your favourite hotel",
+			},
+		}, nil)
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff"))).Return(
+			[]db.FibbingItPlayerRole{
+				{PlayerID: defaultOtherPlayerID},
+			},
+			nil,
+		)
+		mockStore.EXPECT().UpdateStateToQuestion(ctx, db.UpdateStateToQuestionArgs{
+			GameStateID:       gameStateID,
+			Deadline:          deadline,
+			NextRound:         false,
+			NormalsQuestionID: normalQuestionID,
+			FibberQuestionID:  fibberQuestionID,
+			RoundType:         "free_form",
+			RoundNumber:       2,
+			Players:           players,
+			FibberLocs:        []int{1},
+		}).Return(db.UpdateStateToQuestionResult{
+			RoundType:   "free_form",
+			RoundNumber: 2,
+			Players:     players,
+		}, nil)
+
+		gameState, err := srv.UpdateStateToQuestion(ctx, gameStateID, deadline, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "What if your favourite city", gameState.Players[0].Question)
+		assert.Equal(t, "What is your favourite hotel", gameState.Players[1].Question)
+	})
+
+	t.Run("Should not pick inactive players or count them when working out the fibbers", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		deadline := time.Now().Add(5 * time.Second).UTC()
+		normalQuestionID := uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7"))
+		fibberQuestionID := uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f"))
+		players := []db.GetAllPlayersByGameStateIDRow{
+			{ID: defaultHostPlayerID, Nickname: "Player 1"},
+			{
+				ID:         defaultOtherPlayerID,
+				Nickname:   "Player 2",
+				InactiveAt: pgtype.Timestamp{Time: time.Now().UTC(), Valid: true},
+			},
+			{ID: uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d3")), Nickname: "Player 3"},
+			{ID: uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d4")), Nickname: "Player 4"},
+			{ID: uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d5")), Nickname: "Player 5"},
+		}
+		playerIDs := []uuid.UUID{}
+		for _, player := range players {
+			playerIDs = append(playerIDs, player.ID)
+		}
+
+		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			GameName:        gameName,
+			ID:              uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff")),
+			RoundType:       "free_form",
+			Round:           1,
+			Fibbers:         2,
+			FibberSelection: "random",
+			FibberRotation:  "question",
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersByGameStateID(ctx, gameStateID).Return(players, nil)
+		mockStore.EXPECT().GetUsedQuestionIDs(ctx, db.GetUsedQuestionIDsParams{
+			GameStateID: gameStateID,
+			PlayerIds:   playerIDs,
+		}).Return([]db.GetUsedQuestionIDsRow{}, nil)
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return(db.QuestionPair{}, pgx.ErrNoRows)
+		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return([]db.GetRandomQuestionByRoundRow{
+			{
+				QuestionID: normalQuestionID,
+				Question:   "What if your favourite city",
+				Locale:     "en-GB",
+				GroupID:    groupID,
+			},
+		}, nil)
+		mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
+			GroupType:           "",
+			GroupID:             groupID,
+			ExcludedQuestionID:  normalQuestionID,
+			ExcludedQuestionIds: []uuid.UUID{},
+			RoundType:           "free_form",
+		}).Return([]db.GetRandomQuestionInGroupRow{
+			{
+				QuestionID: fibberQuestionID,
+				Question:   "What is your favourite hotel",
+			},
+		}, nil)
+		// With the inactive player there would be 2 fibbers out of 5, only 4 players are left to pick from.
+		mockRandom.EXPECT().GetFibberIndexes(4, 1).Return([]int{1})
+		mockStore.EXPECT().UpdateStateToQuestion(ctx, db.UpdateStateToQuestionArgs{
+			GameStateID:       gameStateID,
+			Deadline:          deadline,
+			NextRound:         false,
+			NormalsQuestionID: normalQuestionID,
+			FibberQuestionID:  fibberQuestionID,
+			RoundType:         "free_form",
+			RoundNumber:       2,
+			Players:           players,
+			FibberLocs:        []int{2},
+		}).Return(db.UpdateStateToQuestionResult{
+			RoundType:   "free_form",
+			RoundNumber: 2,
+			Players:     players,
+		}, nil)
+
+		gameState, err := srv.UpdateStateToQuestion(ctx, gameStateID, deadline, false)
+		assert.NoError(t, err)
+		assert.Equal(t, service.NormalRole, gameState.Players[1].Role)
+		assert.Equal(t, service.FibberRole, gameState.Players[2].Role)
+	})
+
+	t.Run("Should fail to update state to question because the last round type has been played", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		deadline := time.Now().Add(5 * time.Second).UTC()
+
+		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			GameName:   gameName,
+			ID:         uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff")),
+			RoundType:  "most_likely",
+			Round:      3,
+			RoundTypes: []string{"most_likely"},
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersByGameStateID(ctx, gameStateID).Return(
+			[]db.GetAllPlayersByGameStateIDRow{
+				{
+					ID:       defaultHostPlayerID,
+					Nickname: "Player 1",
+				},
+			},
+			nil,
+		)
+
+		_, err := srv.UpdateStateToQuestion(ctx, gameStateID, deadline, false)
+		assert.ErrorIs(t, err, service.ErrGameCompleted)
+	})
 
-			diffOpts := cmpopts.IgnoreFields(gameState, "Deadline")
-			PartialEqual(t, expectedGameState, gameState, diffOpts)
-			assert.LessOrEqual(t, int(gameState.Deadline.Seconds()), 5)
-		})
-	}
+	t.Run("Should fail to update state to question because we fail to get fibber counts", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		deadline := time.Now().Add(5 * time.Second).UTC()
+
+		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			GameName:   gameName,
+			ID:         uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff")),
+			RoundType:  "free_form",
+			Round:      3,
+			RoundTypes: []string{"free_form", "most_likely"},
+		}, nil)
+		mockStore.EXPECT().GetAllPlayersByGameStateID(ctx, gameStateID).Return(
+			[]db.GetAllPlayersByGameStateIDRow{
+				{
+					ID:       defaultHostPlayerID,
+					Nickname: "Player 1",
+				},
+			},
+			nil,
+		)
+		mockStore.EXPECT().GetFibberCountsByGameStateID(ctx, gameStateID).Return(
+			nil,
+			errors.New("failed to get fibber counts"),
+		)
+
+		_, err := srv.UpdateStateToQuestion(ctx, gameStateID, deadline, false)
+		assert.ErrorContains(t, err, "failed to get how often players have been the fibber")
+	})
 
 	t.Run("Should fail to update state to question because we fail to get game state", func(t *testing.T) {
 		t.Parallel()
@@ -1838,6 +3142,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 		groupID := uuid.Must(uuid.NewV4())
 
 		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			GameName:  gameName,
 			ID:        roundID,
 			RoundType: "free_form",
 			Round:     1,
@@ -1852,13 +3157,24 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 			},
 		}, nil)
 
-		mockStore.EXPECT().GetFibberByRoundID(ctx, roundID).Return(db.FibbingItPlayerRole{
-			PlayerID: playerID,
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{
+			{PlayerID: playerID},
 		}, nil)
 
+		mockStore.EXPECT().GetUsedQuestionIDs(ctx, db.GetUsedQuestionIDsParams{
+			GameStateID: gameStateID,
+			PlayerIds:   []uuid.UUID{playerID},
+		}).Return([]db.GetUsedQuestionIDsRow{}, nil)
+
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            "fibbing_it",
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return(db.QuestionPair{}, pgx.ErrNoRows)
 		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-			GameName:  "fibbing_it",
-			RoundType: "free_form",
+			GameName:            "fibbing_it",
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
 		}).Return([]db.GetRandomQuestionByRoundRow{
 			{
 				ID:         uuid.Must(uuid.NewV4()),
@@ -1870,10 +3186,11 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 		}, nil)
 
 		mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
-			GroupType:          "",
-			GroupID:            groupID,
-			ExcludedQuestionID: normalQuestionID,
-			RoundType:          "free_form",
+			GroupType:           "",
+			GroupID:             groupID,
+			ExcludedQuestionID:  normalQuestionID,
+			ExcludedQuestionIds: []uuid.UUID{},
+			RoundType:           "free_form",
 		}).Return([]db.GetRandomQuestionInGroupRow{
 			{
 				QuestionID: fibberQuestionID,
@@ -1897,7 +3214,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 					Locale:   pgtype.Text{String: "en-GB", Valid: true},
 				},
 			},
-			FibberLoc: 0,
+			FibberLocs: []int{0},
 		}).Return(db.UpdateStateToQuestionResult{
 			RoundType: "free_form",
 		}, nil)
@@ -1919,6 +3236,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 			deadline := time.Now().Add(5 * time.Second).UTC()
 
 			mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+				GameName:  gameName,
 				ID:        uuid.Must(uuid.NewV4()),
 				RoundType: "free_form",
 				Round:     1,
@@ -1943,6 +3261,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 		roundID := uuid.Must(uuid.NewV4())
 
 		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			GameName:  gameName,
 			ID:        roundID,
 			RoundType: "free_form",
 			Round:     1,
@@ -1960,8 +3279,8 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 			},
 			nil,
 		)
-		mockStore.EXPECT().GetFibberByRoundID(ctx, roundID).Return(
-			db.FibbingItPlayerRole{},
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return(
+			nil,
 			errors.New("failed to get latest round by game state ID"),
 		)
 
@@ -1982,6 +3301,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 			roundID := uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff"))
 
 			mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+				GameName:  gameName,
 				ID:        roundID,
 				RoundType: "free_form",
 				Round:     1,
@@ -2000,11 +3320,11 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 				nil,
 			)
 			mockStore.EXPECT().
-				GetFibberByRoundID(ctx, roundID).
-				Return(db.FibbingItPlayerRole{}, fmt.Errorf("failed to access DB"))
+				GetFibbersByRoundID(ctx, roundID).
+				Return(nil, fmt.Errorf("failed to access DB"))
 
 			_, err := srv.UpdateStateToQuestion(ctx, gameStateID, deadline, false)
-			assert.ErrorContains(t, err, "failed to get fibber in round")
+			assert.ErrorContains(t, err, "failed to get fibbers in round")
 		},
 	)
 
@@ -2022,6 +3342,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 			roundID := uuid.Must(uuid.FromString("0193ea48-c27f-74bd-8a17-523f69350aff"))
 
 			mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+				GameName:  gameName,
 				ID:        roundID,
 				RoundType: "free_form",
 				Round:     1,
@@ -2039,12 +3360,23 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 				},
 				nil,
 			)
-			mockStore.EXPECT().GetFibberByRoundID(ctx, roundID).Return(db.FibbingItPlayerRole{
-				PlayerID: defaultOtherPlayerID,
+			mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{
+				{PlayerID: defaultOtherPlayerID},
 			}, nil)
+			mockStore.EXPECT().GetUsedQuestionIDs(ctx, db.GetUsedQuestionIDsParams{
+				GameStateID: gameStateID,
+				PlayerIds:   []uuid.UUID{defaultHostPlayerID, defaultOtherPlayerID},
+			}).Return([]db.GetUsedQuestionIDsRow{}, nil)
+
+			mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+				GameName:            gameName,
+				RoundType:           "free_form",
+				ExcludedQuestionIds: []uuid.UUID{},
+			}).Return(db.QuestionPair{}, pgx.ErrNoRows)
 			mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-				GameName:  gameName,
-				RoundType: "free_form",
+				GameName:            gameName,
+				RoundType:           "free_form",
+				ExcludedQuestionIds: []uuid.UUID{},
 			}).Return([]db.GetRandomQuestionByRoundRow{}, errors.New("failed to get random question by round"))
 
 			_, err := srv.UpdateStateToQuestion(ctx, gameStateID, deadline, false)
@@ -2064,6 +3396,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 		groupID := uuid.Must(uuid.NewV4())
 
 		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			GameName:  gameName,
 			ID:        roundID,
 			RoundType: "free_form",
 			Round:     1,
@@ -2081,12 +3414,23 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 			},
 			nil,
 		)
-		mockStore.EXPECT().GetFibberByRoundID(ctx, roundID).Return(db.FibbingItPlayerRole{
-			PlayerID: defaultOtherPlayerID,
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{
+			{PlayerID: defaultOtherPlayerID},
 		}, nil)
+		mockStore.EXPECT().GetUsedQuestionIDs(ctx, db.GetUsedQuestionIDsParams{
+			GameStateID: gameStateID,
+			PlayerIds:   []uuid.UUID{defaultHostPlayerID, defaultOtherPlayerID},
+		}).Return([]db.GetUsedQuestionIDsRow{}, nil)
+
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return(db.QuestionPair{}, pgx.ErrNoRows)
 		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-			GameName:  gameName,
-			RoundType: "free_form",
+			GameName:            gameName,
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
 		}).Return([]db.GetRandomQuestionByRoundRow{
 			{
 				ID:         uuid.Must(uuid.NewV4()),
@@ -2116,6 +3460,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 		groupID := uuid.Must(uuid.NewV4())
 
 		mockStore.EXPECT().GetLatestRoundByGameStateID(ctx, gameStateID).Return(db.GetLatestRoundByGameStateIDRow{
+			GameName:  gameName,
 			ID:        roundID,
 			RoundType: "free_form",
 			Round:     1,
@@ -2133,12 +3478,23 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 			},
 			nil,
 		)
-		mockStore.EXPECT().GetFibberByRoundID(ctx, roundID).Return(db.FibbingItPlayerRole{
-			PlayerID: defaultOtherPlayerID,
+		mockStore.EXPECT().GetFibbersByRoundID(ctx, roundID).Return([]db.FibbingItPlayerRole{
+			{PlayerID: defaultOtherPlayerID},
 		}, nil)
+		mockStore.EXPECT().GetUsedQuestionIDs(ctx, db.GetUsedQuestionIDsParams{
+			GameStateID: gameStateID,
+			PlayerIds:   []uuid.UUID{defaultHostPlayerID, defaultOtherPlayerID},
+		}).Return([]db.GetUsedQuestionIDsRow{}, nil)
+
+		mockStore.EXPECT().GetRandomQuestionPair(ctx, db.GetRandomQuestionPairParams{
+			GameName:            "fibbing_it",
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
+		}).Return(db.QuestionPair{}, pgx.ErrNoRows)
 		mockStore.EXPECT().GetRandomQuestionByRound(ctx, db.GetRandomQuestionByRoundParams{
-			GameName:  "fibbing_it",
-			RoundType: "free_form",
+			GameName:            "fibbing_it",
+			RoundType:           "free_form",
+			ExcludedQuestionIds: []uuid.UUID{},
 		}).Return([]db.GetRandomQuestionByRoundRow{
 			{
 				ID:         uuid.Must(uuid.NewV4()),
@@ -2149,10 +3505,11 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 			},
 		}, nil)
 		mockStore.EXPECT().GetRandomQuestionInGroup(ctx, db.GetRandomQuestionInGroupParams{
-			GroupType:          "",
-			GroupID:            groupID,
-			ExcludedQuestionID: uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
-			RoundType:          "free_form",
+			GroupType:           "",
+			GroupID:             groupID,
+			ExcludedQuestionID:  uuid.Must(uuid.FromString("0193a629-7dcc-78ad-822f-fd5d83c89ae7")),
+			ExcludedQuestionIds: []uuid.UUID{},
+			RoundType:           "free_form",
 		}).Return([]db.GetRandomQuestionInGroupRow{
 			{
 				QuestionID: uuid.Must(uuid.FromString("0193a629-a9ac-7fc4-828c-a1334c282e0f")),
@@ -2177,7 +3534,7 @@ func TestRoundServiceUpdateStateToQuestion(t *testing.T) {
 					Nickname: "Player 2",
 				},
 			},
-			FibberLoc: 1,
+			FibberLocs: []int{1},
 		}).Return(db.UpdateStateToQuestionResult{}, errors.New("failed to add a new round"))
 
 		_, err := srv.UpdateStateToQuestion(ctx, gameStateID, deadline, false)
@@ -2208,12 +3565,12 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 			GetAllVotesForRoundByGameStateID(ctx, gameStateID).
 			Return([]db.GetAllVotesForRoundByGameStateIDRow{
 				{
-					VoterID:       defaultHostPlayerID,
-					VotedForID:    defaultOtherPlayerID,
-					VoterAvatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
-					VoterNickname: "Player 1",
-					FibberID:      defaultOtherPlayerID,
-					RoundID:       uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
+					VoterID:        defaultHostPlayerID,
+					VotedForID:     defaultOtherPlayerID,
+					VoterAvatar:    "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
+					VoterNickname:  "Player 1",
+					VotedForFibber: true,
+					RoundID:        uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
 				},
 			}, nil)
 		mockStore.EXPECT().
@@ -2233,19 +3590,27 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 		mockStore.EXPECT().
 			GetLatestRoundByGameStateID(ctx, gameStateID).
 			Return(db.GetLatestRoundByGameStateIDRow{
-				ID:        uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
-				Round:     1,
-				RoundType: "free_form",
+				ID:         uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
+				Round:      1,
+				RoundType:  "free_form",
+				MaxRounds:  5,
+				RoundTypes: []string{"free_form"},
 			}, nil)
 		mockStore.EXPECT().
 			CountTotalRoundsByGameStateID(ctx, gameStateID).
 			Return(int64(1), nil)
+		mockStore.EXPECT().
+			GetFibbersByRoundID(ctx, uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e"))).
+			Return([]db.FibbingItPlayerRole{{PlayerID: defaultOtherPlayerID}}, nil)
 		mockStore.EXPECT().
 			GetTotalScoresByGameStateID(ctx, db.GetTotalScoresByGameStateIDParams{
 				ID:   gameStateID,
 				ID_2: uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
 			}).
 			Return([]db.GetTotalScoresByGameStateIDRow{}, nil)
+		mockStore.EXPECT().
+			GetScoreReasonsByRoundID(ctx, uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e"))).
+			Return([]db.GetScoreReasonsByRoundIDRow{}, nil)
 		mockStore.EXPECT().
 			UpdateStateToScore(ctx, mock.MatchedBy(func(args db.UpdateStateToScoreArgs) bool {
 				return args.GameStateID == gameStateID &&
@@ -2261,12 +3626,14 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 		assert.NoError(t, err)
 
 		expectedScoreState := service.ScoreState{
-			GameStateID:  gameStateID,
-			RoundNumber:  1,
-			RoundType:    "free_form",
-			TotalRounds:  1,
-			FibberCaught: true,
-			Deadline:     time.Until(now),
+			GameStateID:     gameStateID,
+			RoundNumber:     1,
+			RoundType:       "free_form",
+			TotalRounds:     1,
+			MaxRounds:       5,
+			IsLastRoundType: true,
+			FibberCaught:    true,
+			Deadline:        time.Until(now),
 			Players: []service.PlayerWithScoring{
 				{
 					ID:       defaultHostPlayerID,
@@ -2288,6 +3655,106 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 		assert.LessOrEqual(t, int(scoreState.Deadline.Seconds()), 15)
 	})
 
+	t.Run("Should successfully update score state, with bonus points from scorers", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		now := time.Now().Add(15 * time.Second)
+		roundID := uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e"))
+		scoringWithBonus := service.Scoring{
+			GuessedFibber:      100,
+			FibberEvadeCapture: 150,
+			SpeedBonus:         50,
+			DodgedVote:         25,
+		}
+
+		mockStore.EXPECT().
+			GetAllVotesForRoundByGameStateID(ctx, gameStateID).
+			Return([]db.GetAllVotesForRoundByGameStateIDRow{
+				{
+					VoterID:        defaultHostPlayerID,
+					VotedForID:     defaultOtherPlayerID,
+					VoterAvatar:    "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
+					VoterNickname:  "Player 1",
+					VotedForFibber: true,
+					RoundID:        roundID,
+				},
+			}, nil)
+		mockStore.EXPECT().
+			GetAllPlayersByGameStateID(ctx, gameStateID).
+			Return([]db.GetAllPlayersByGameStateIDRow{
+				{
+					ID:       defaultHostPlayerID,
+					Nickname: "Player 1",
+					Avatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
+				},
+				{
+					ID:       defaultOtherPlayerID,
+					Nickname: "Player 2",
+					Avatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+2",
+				},
+			}, nil)
+		mockStore.EXPECT().
+			GetLatestRoundByGameStateID(ctx, gameStateID).
+			Return(db.GetLatestRoundByGameStateIDRow{
+				ID:         roundID,
+				Round:      1,
+				RoundType:  "free_form",
+				MaxRounds:  5,
+				RoundTypes: []string{"free_form"},
+				Scorers:    []string{"speed_bonus", "fibber_dodge"},
+			}, nil)
+		mockStore.EXPECT().
+			CountTotalRoundsByGameStateID(ctx, gameStateID).
+			Return(int64(1), nil)
+		mockStore.EXPECT().
+			GetFibbersByRoundID(ctx, roundID).
+			Return([]db.FibbingItPlayerRole{{PlayerID: defaultOtherPlayerID}}, nil)
+		mockStore.EXPECT().
+			GetTotalScoresByGameStateID(ctx, db.GetTotalScoresByGameStateIDParams{
+				ID:   gameStateID,
+				ID_2: roundID,
+			}).
+			Return([]db.GetTotalScoresByGameStateIDRow{}, nil)
+		mockStore.EXPECT().
+			GetScoreReasonsByRoundID(ctx, roundID).
+			Return([]db.GetScoreReasonsByRoundIDRow{}, nil)
+		mockStore.EXPECT().
+			UpdateStateToScore(ctx, mock.MatchedBy(func(args db.UpdateStateToScoreArgs) bool {
+				return len(args.Scores) == 2 &&
+					args.Scores[0].PlayerID == defaultHostPlayerID &&
+					args.Scores[0].Score == 150 &&
+					slices.Equal(args.Scores[0].ReasonScorers, []string{"speed_bonus"}) &&
+					slices.Equal(args.Scores[0].ReasonPoints, []int32{50}) &&
+					args.Scores[1].PlayerID == defaultOtherPlayerID &&
+					args.Scores[1].Score == 0 &&
+					len(args.Scores[1].ReasonScorers) == 0
+			})).Return(db.UpdateStateToScoreResult{}, nil)
+
+		scoreState, err := srv.UpdateStateToScore(ctx, gameStateID, now, scoringWithBonus)
+		assert.NoError(t, err)
+
+		expectedPlayers := []service.PlayerWithScoring{
+			{
+				ID:       defaultHostPlayerID,
+				Avatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
+				Nickname: "Player 1",
+				Score:    150,
+				Reasons:  []service.ScoreReason{{Scorer: "speed_bonus", Points: 50}},
+			},
+			{
+				ID:       defaultOtherPlayerID,
+				Avatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+2",
+				Nickname: "Player 2",
+				Score:    0,
+			},
+		}
+		assert.Equal(t, expectedPlayers, scoreState.Players)
+	})
+
 	t.Run("Should fail to update score state, fail to get game state", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockRoundStore(t)
@@ -2318,7 +3785,7 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 		mockStore.EXPECT().
 			GetAllVotesForRoundByGameStateID(ctx, gameStateID).
 			Return([]db.GetAllVotesForRoundByGameStateIDRow{
-				{VoterID: defaultHostPlayerID, FibberID: defaultOtherPlayerID},
+				{VoterID: defaultHostPlayerID},
 			}, nil)
 		mockStore.EXPECT().
 			GetAllPlayersByGameStateID(ctx, gameStateID).
@@ -2333,12 +3800,18 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 		mockStore.EXPECT().
 			CountTotalRoundsByGameStateID(ctx, gameStateID).
 			Return(int64(1), nil)
+		mockStore.EXPECT().
+			GetFibbersByRoundID(ctx, roundID).
+			Return([]db.FibbingItPlayerRole{{PlayerID: defaultOtherPlayerID}}, nil)
 		mockStore.EXPECT().
 			GetTotalScoresByGameStateID(ctx, db.GetTotalScoresByGameStateIDParams{
 				ID:   gameStateID,
 				ID_2: roundID,
 			}).
 			Return([]db.GetTotalScoresByGameStateIDRow{}, nil)
+		mockStore.EXPECT().
+			GetScoreReasonsByRoundID(ctx, roundID).
+			Return([]db.GetScoreReasonsByRoundIDRow{}, nil)
 		mockStore.EXPECT().
 			UpdateStateToScore(ctx, mock.MatchedBy(func(args db.UpdateStateToScoreArgs) bool {
 				return args.GameStateID == gameStateID && args.Deadline == now
@@ -2361,7 +3834,7 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 		mockStore.EXPECT().
 			GetAllVotesForRoundByGameStateID(ctx, gameStateID).
 			Return([]db.GetAllVotesForRoundByGameStateIDRow{
-				{FibberID: uuid.Must(uuid.NewV4())},
+				{VoterID: uuid.Must(uuid.NewV4())},
 			}, nil)
 		mockStore.EXPECT().
 			GetAllPlayersByGameStateID(ctx, gameStateID).
@@ -2372,12 +3845,18 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 		mockStore.EXPECT().
 			CountTotalRoundsByGameStateID(ctx, gameStateID).
 			Return(int64(1), nil)
+		mockStore.EXPECT().
+			GetFibbersByRoundID(ctx, uuid.UUID{}).
+			Return([]db.FibbingItPlayerRole{{PlayerID: defaultOtherPlayerID}}, nil)
 		mockStore.EXPECT().
 			GetTotalScoresByGameStateID(ctx, db.GetTotalScoresByGameStateIDParams{
 				ID:   gameStateID,
 				ID_2: uuid.UUID{},
 			}).
 			Return([]db.GetTotalScoresByGameStateIDRow{}, nil)
+		mockStore.EXPECT().
+			GetScoreReasonsByRoundID(ctx, uuid.UUID{}).
+			Return([]db.GetScoreReasonsByRoundIDRow{}, nil)
 		mockStore.EXPECT().
 			UpdateStateToScore(ctx, db.UpdateStateToScoreArgs{
 				GameStateID: gameStateID,
@@ -2420,12 +3899,12 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 			GetAllVotesForRoundByGameStateID(ctx, gameStateID).
 			Return([]db.GetAllVotesForRoundByGameStateIDRow{
 				{
-					VoterID:       defaultHostPlayerID,
-					VotedForID:    defaultOtherPlayerID,
-					VoterAvatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
-					VoterNickname: "Player 1",
-					FibberID:      defaultOtherPlayerID,
-					RoundID:       uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
+					VoterID:        defaultHostPlayerID,
+					VotedForID:     defaultOtherPlayerID,
+					VoterAvatar:    "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
+					VoterNickname:  "Player 1",
+					VotedForFibber: true,
+					RoundID:        uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
 				},
 			}, nil)
 		mockStore.EXPECT().
@@ -2449,12 +3928,12 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 			GetAllVotesForRoundByGameStateID(ctx, gameStateID).
 			Return([]db.GetAllVotesForRoundByGameStateIDRow{
 				{
-					VoterID:       defaultHostPlayerID,
-					VotedForID:    defaultOtherPlayerID,
-					VoterAvatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
-					VoterNickname: "Player 1",
-					FibberID:      defaultOtherPlayerID,
-					RoundID:       uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
+					VoterID:        defaultHostPlayerID,
+					VotedForID:     defaultOtherPlayerID,
+					VoterAvatar:    "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
+					VoterNickname:  "Player 1",
+					VotedForFibber: true,
+					RoundID:        uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
 				},
 			}, nil)
 		mockStore.EXPECT().
@@ -2486,12 +3965,12 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 			GetAllVotesForRoundByGameStateID(ctx, gameStateID).
 			Return([]db.GetAllVotesForRoundByGameStateIDRow{
 				{
-					VoterID:       defaultHostPlayerID,
-					VotedForID:    defaultOtherPlayerID,
-					VoterAvatar:   "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
-					VoterNickname: "Player 1",
-					FibberID:      defaultOtherPlayerID,
-					RoundID:       uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
+					VoterID:        defaultHostPlayerID,
+					VotedForID:     defaultOtherPlayerID,
+					VoterAvatar:    "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+1",
+					VoterNickname:  "Player 1",
+					VotedForFibber: true,
+					RoundID:        uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
 				},
 			}, nil)
 		mockStore.EXPECT().
@@ -2517,12 +3996,18 @@ func TestRoundServiceUpdateStateToScore(t *testing.T) {
 		mockStore.EXPECT().
 			CountTotalRoundsByGameStateID(ctx, gameStateID).
 			Return(int64(1), nil)
+		mockStore.EXPECT().
+			GetFibbersByRoundID(ctx, uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e"))).
+			Return([]db.FibbingItPlayerRole{{PlayerID: defaultOtherPlayerID}}, nil)
 		mockStore.EXPECT().
 			GetTotalScoresByGameStateID(ctx, db.GetTotalScoresByGameStateIDParams{
 				ID:   gameStateID,
 				ID_2: uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e")),
 			}).
 			Return([]db.GetTotalScoresByGameStateIDRow{}, nil)
+		mockStore.EXPECT().
+			GetScoreReasonsByRoundID(ctx, uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e"))).
+			Return([]db.GetScoreReasonsByRoundIDRow{}, nil)
 		mockStore.EXPECT().
 			UpdateStateToScore(ctx, mock.MatchedBy(func(args db.UpdateStateToScoreArgs) bool {
 				return args.GameStateID == gameStateID &&
@@ -2596,6 +4081,54 @@ func TestRoundServiceGetGameState(t *testing.T) {
 	})
 }
 
+func TestRoundServiceGetTimings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully get timings picked by the host", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		gameStateID := uuid.Must(uuid.NewV7())
+		mockStore.EXPECT().GetGameState(ctx, gameStateID).Return(db.GameState{
+			ID:              gameStateID,
+			QuestionSeconds: 30,
+			VotingSeconds:   120,
+			RevealSeconds:   10,
+			RevoteSeconds:   15,
+			ScoreSeconds:    5,
+			WinnerSeconds:   20,
+		}, nil)
+
+		timings, err := srv.GetTimings(ctx, gameStateID)
+		assert.NoError(t, err)
+		assert.Equal(t, service.Timings{
+			ShowQuestionScreenFor: 30 * time.Second,
+			ShowVotingScreenFor:   2 * time.Minute,
+			ShowRevealScreenFor:   10 * time.Second,
+			ShowRevoteScreenFor:   15 * time.Second,
+			ShowScoreScreenFor:    5 * time.Second,
+			ShowWinnerScreenFor:   20 * time.Second,
+		}, timings)
+	})
+
+	t.Run("Should fail to get timings because we fail to get game state DB", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		gameStateID := uuid.Must(uuid.NewV7())
+		mockStore.EXPECT().GetGameState(ctx, gameStateID).Return(db.GameState{}, errors.New("failed to get game state"))
+
+		_, err := srv.GetTimings(ctx, gameStateID)
+		assert.Error(t, err)
+	})
+}
+
 func TestRoundServiceGetQuestionState(t *testing.T) {
 	t.Parallel()
 
@@ -2739,6 +4272,9 @@ func TestRoundServiceGetQuestionState(t *testing.T) {
 			RoomCode:       "ABC12",
 			SubmitDeadline: pgtype.Timestamp{Time: deadline},
 		}, nil)
+		mockStore.EXPECT().GetAnswerOptionsByPlayerID(ctx, playerID).Return([]db.GetAnswerOptionsByPlayerIDRow{
+			{Locale: "en-GB", AnswerOptions: []string{}, PlayerLocale: pgtype.Text{String: "en-GB"}},
+		}, nil)
 
 		mockStore.EXPECT().GetGameState(ctx, gameStateID).Return(db.GameState{
 			ID:                   gameStateID,
@@ -2907,11 +4443,17 @@ func TestRoundServiceUpdateStateToWinning(t *testing.T) {
 				Avatar:     "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+2",
 			},
 		}, nil)
+		mockStore.EXPECT().GetRoomByGameStateID(ctx, gameID).Return(db.Room{HostPlayer: playerID}, nil)
+		mockStore.EXPECT().GetRecapAnswersByGameStateID(ctx, gameID).Return(nil, nil)
+		mockStore.EXPECT().GetRecapVotesByGameStateID(ctx, gameID).Return(nil, nil)
 
 		winnerState, err := srv.UpdateStateToWinner(ctx, gameID, deadline)
 		assert.NoError(t, err)
 
 		expectedWinnerState := service.WinnerState{
+			GameStateID:  gameID,
+			HostPlayerID: playerID,
+			Awards:       []service.Award{},
 			Players: []service.PlayerWithScoring{
 				{
 					ID:       defaultOtherPlayerID,
@@ -3070,11 +4612,43 @@ func TestRoundServiceGetWinnerState(t *testing.T) {
 				Avatar:     "https://api.dicebear.com/9.x/bottts-neutral/svg?radius=20&seed=Player+2",
 			},
 		}, nil)
+		mockStore.EXPECT().GetRoomByGameStateID(ctx, gameID).Return(db.Room{HostPlayer: playerID}, nil)
+		roundStartedAt := time.Now().UTC()
+		mockStore.EXPECT().GetRecapAnswersByGameStateID(ctx, gameID).Return([]db.GetRecapAnswersByGameStateIDRow{
+			{
+				RoundID:        roundID,
+				PlayerID:       playerID,
+				Nickname:       "Player 1",
+				Role:           service.NormalRole,
+				RoundStartedAt: pgtype.Timestamp{Time: roundStartedAt, Valid: true},
+				AnsweredAt:     pgtype.Timestamp{Time: roundStartedAt.Add(5 * time.Second), Valid: true},
+			},
+			{
+				RoundID:        roundID,
+				PlayerID:       defaultOtherPlayerID,
+				Nickname:       "Player 2",
+				Role:           service.FibberRole,
+				RoundStartedAt: pgtype.Timestamp{Time: roundStartedAt, Valid: true},
+				AnsweredAt:     pgtype.Timestamp{Time: roundStartedAt.Add(3 * time.Second), Valid: true},
+			},
+		}, nil)
+		mockStore.EXPECT().GetRecapVotesByGameStateID(ctx, gameID).Return([]db.GetRecapVotesByGameStateIDRow{
+			{RoundID: roundID, VoterID: playerID, VotedForID: defaultOtherPlayerID},
+			{RoundID: roundID, VoterID: defaultOtherPlayerID, VotedForID: playerID},
+		}, nil)
 
 		winnerState, err := srv.GetWinnerState(ctx, playerID)
 		assert.NoError(t, err)
 
 		expectedWinnerState := service.WinnerState{
+			GameStateID:  gameID,
+			HostPlayerID: playerID,
+			Awards: []service.Award{
+				{Name: service.AwardMasterFibber, PlayerID: defaultOtherPlayerID, Nickname: "Player 2", Score: 1},
+				{Name: service.AwardDetective, PlayerID: playerID, Nickname: "Player 1", Score: 1},
+				{Name: service.AwardMostSuspicious, PlayerID: playerID, Nickname: "Player 1", Score: 1},
+				{Name: service.AwardFastestAnswer, PlayerID: defaultOtherPlayerID, Nickname: "Player 2", Score: 3000},
+			},
 			Players: []service.PlayerWithScoring{
 				{
 					ID:       defaultOtherPlayerID,
@@ -3093,6 +4667,62 @@ func TestRoundServiceGetWinnerState(t *testing.T) {
 		assert.Equal(t, expectedWinnerState, winnerState)
 	})
 
+	t.Run("Should add earlier games to the series standings", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+
+		gameID, err := uuid.NewV7()
+		require.NoError(t, err)
+		seriesID := uuid.NullUUID{UUID: uuid.Must(uuid.NewV7()), Valid: true}
+		mockStore.EXPECT().GetGameStateByPlayerID(ctx, playerID).Return(db.GameState{
+			State:       db.FibbingItWinner.String(),
+			ID:          gameID,
+			SeriesID:    seriesID,
+			SeriesGame:  3,
+			SeriesGames: 3,
+		}, nil)
+
+		u, err := uuid.NewV7()
+		require.NoError(t, err)
+		mockRandom.EXPECT().GetID().Return(u, nil)
+
+		mockStore.EXPECT().GetTotalScoresByGameStateID(ctx, db.GetTotalScoresByGameStateIDParams{
+			ID:   gameID,
+			ID_2: u,
+		}).Return([]db.GetTotalScoresByGameStateIDRow{
+			{PlayerID: playerID, TotalScore: int64(100), Nickname: "Player 1"},
+			{PlayerID: defaultOtherPlayerID, TotalScore: int64(200), Nickname: "Player 2"},
+		}, nil)
+		mockStore.EXPECT().GetRoomByGameStateID(ctx, gameID).Return(db.Room{HostPlayer: playerID}, nil)
+		mockStore.EXPECT().GetSeriesScores(ctx, db.GetSeriesScoresParams{
+			SeriesID: seriesID,
+			ID:       gameID,
+		}).Return([]db.GetSeriesScoresRow{
+			{PlayerID: playerID, TotalScore: int64(500), Nickname: "Player 1"},
+			{PlayerID: defaultOtherPlayerID, TotalScore: int64(300), Nickname: "Player 2"},
+		}, nil)
+		mockStore.EXPECT().GetRecapAnswersByGameStateID(ctx, gameID).Return(nil, nil)
+		mockStore.EXPECT().GetRecapVotesByGameStateID(ctx, gameID).Return(nil, nil)
+
+		winnerState, err := srv.GetWinnerState(ctx, playerID)
+		assert.NoError(t, err)
+
+		expectedSeries := service.SeriesState{
+			Game:  3,
+			Games: 3,
+			Players: []service.PlayerWithScoring{
+				{ID: playerID, Score: 600, Nickname: "Player 1"},
+				{ID: defaultOtherPlayerID, Score: 500, Nickname: "Player 2"},
+			},
+		}
+		assert.Equal(t, expectedSeries, winnerState.Series)
+		assert.True(t, winnerState.Series.IsOver())
+	})
+
 	t.Run("Should fail to get winner state, cannot get game state", func(t *testing.T) {
 		t.Parallel()
 		mockStore := mockService.NewMockRoundStore(t)
@@ -3344,6 +4974,7 @@ func TestRoundServiceGetScoreState(t *testing.T) {
 	t.Parallel()
 
 	playerID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d2"))
+	gameStateID := uuid.Must(uuid.FromString("fbb75599-9f7a-4392-b523-fd433b3208ea"))
 
 	t.Run("Should return error when player not found", func(t *testing.T) {
 		t.Parallel()
@@ -3364,7 +4995,102 @@ func TestRoundServiceGetScoreState(t *testing.T) {
 		assert.Error(t, err)
 		assert.Empty(t, scoreState)
 	})
+
+	t.Run("Should use the stored score reasons once the round has been scored", func(t *testing.T) {
+		t.Parallel()
+		mockStore := mockService.NewMockRoundStore(t)
+		mockRandom := mockService.NewMockRandomizer(t)
+		srv := service.NewRoundService(mockStore, mockRandom, "en-GB")
+
+		ctx := t.Context()
+		roundID := uuid.Must(uuid.FromString("0193a62a-364e-751a-9088-cf3b9711153e"))
+		scoring := service.Scoring{
+			GuessedFibber:      100,
+			FibberEvadeCapture: 150,
+			SpeedBonus:         50,
+		}
+
+		mockStore.EXPECT().GetGameStateByPlayerID(ctx, playerID).Return(db.GameState{ID: gameStateID}, nil)
+		mockStore.EXPECT().
+			GetAllVotesForRoundByGameStateID(ctx, gameStateID).
+			Return([]db.GetAllVotesForRoundByGameStateIDRow{
+				{
+					VoterID:        defaultHostPlayerID,
+					VotedForID:     defaultOtherPlayerID,
+					VoterNickname:  "Player 1",
+					VotedForFibber: true,
+					RoundID:        roundID,
+				},
+			}, nil)
+		mockStore.EXPECT().
+			GetAllPlayersByGameStateID(ctx, gameStateID).
+			Return([]db.GetAllPlayersByGameStateIDRow{
+				{
+					ID:       defaultHostPlayerID,
+					Nickname: "Player 1",
+				},
+				{
+					ID:       defaultOtherPlayerID,
+					Nickname: "Player 2",
+				},
+			}, nil)
+		mockStore.EXPECT().
+			GetLatestRoundByGameStateID(ctx, gameStateID).
+			Return(db.GetLatestRoundByGameStateIDRow{
+				ID:         roundID,
+				Round:      1,
+				RoundType:  "free_form",
+				MaxRounds:  5,
+				RoundTypes: []string{"free_form"},
+				Scorers:    []string{"speed_bonus"},
+			}, nil)
+		mockStore.EXPECT().
+			CountTotalRoundsByGameStateID(ctx, gameStateID).
+			Return(int64(1), nil)
+		mockStore.EXPECT().
+			GetFibbersByRoundID(ctx, roundID).
+			Return([]db.FibbingItPlayerRole{{PlayerID: defaultOtherPlayerID}}, nil)
+		mockStore.EXPECT().
+			GetTotalScoresByGameStateID(ctx, db.GetTotalScoresByGameStateIDParams{
+				ID:   gameStateID,
+				ID_2: roundID,
+			}).
+			Return([]db.GetTotalScoresByGameStateIDRow{}, nil)
+		mockStore.EXPECT().
+			GetScoreReasonsByRoundID(ctx, roundID).
+			Return([]db.GetScoreReasonsByRoundIDRow{
+				{
+					PlayerID:      defaultHostPlayerID,
+					ReasonScorers: []string{"speed_bonus"},
+					ReasonPoints:  []int32{30},
+				},
+				{
+					PlayerID:      defaultOtherPlayerID,
+					ReasonScorers: []string{},
+					ReasonPoints:  []int32{},
+				},
+			}, nil)
+
+		scoreState, err := srv.GetScoreState(ctx, scoring, playerID)
+		assert.NoError(t, err)
+
+		expectedPlayers := []service.PlayerWithScoring{
+			{
+				ID:       defaultHostPlayerID,
+				Nickname: "Player 1",
+				Score:    130,
+				Reasons:  []service.ScoreReason{{Scorer: "speed_bonus", Points: 30}},
+			},
+			{
+				ID:       defaultOtherPlayerID,
+				Nickname: "Player 2",
+				Score:    0,
+			},
+		}
+		assert.Equal(t, expectedPlayers, scoreState.Players)
+	})
 }
+
 func TestRoundServicePauseGame(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+const (
+	SpeedBonusScorer  = "speed_bonus"
+	StreakScorer      = "streak"
+	CatchUpScorer     = "catch_up"
+	FibberDodgeScorer = "fibber_dodge"
+
+	maxStreak = 4
+)
+
+// Scorer gives out bonus points on top of the base score for the latest round of a game.
+type Scorer interface {
+	Name() string
+	Score(round ScoringRound, scoring Scoring) map[uuid.UUID]int
+}
+
+// ScoringRound is everything a Scorer can use to work out the points for the latest round.
+type ScoringRound struct {
+	FibberIDs []uuid.UUID
+	// Votes in the latest round, ordered by when they were cast.
+	Votes []RoundVote
+	// PreviousRounds are the votes of the earlier rounds of the same round type, most recent round first.
+	PreviousRounds [][]RoundVote
+	// CurrentScores are the total scores of each player before the latest round.
+	CurrentScores map[uuid.UUID]int
+}
+
+// RoundVote is a single vote, a player casts one vote for every fibber in the round.
+type RoundVote struct {
+	VoterID        uuid.UUID
+	VotedForID     uuid.UUID
+	VoterIsFibber  bool
+	VotedForFibber bool
+	VotedAt        time.Time
+}
+
+func (v RoundVote) FoundFibber() bool {
+	return v.VotedForFibber
+}
+
+// ScoreReason is shown on the score screen, so players know where their bonus points came from.
+type ScoreReason struct {
+	Scorer string
+	Points int
+}
+
+// Scorers returns the built-in scorers a host can pick for their room.
+func Scorers() []Scorer {
+	return []Scorer{speedBonusScorer{}, streakScorer{}, catchUpScorer{}, fibberDodgeScorer{}}
+}
+
+func ScorerNames() []string {
+	names := []string{}
+	for _, scorer := range Scorers() {
+		names = append(names, scorer.Name())
+	}
+	return names
+}
+
+func getScorers(names []string) []Scorer {
+	scorers := []Scorer{}
+	for _, scorer := range Scorers() {
+		if slices.Contains(names, scorer.Name()) {
+			scorers = append(scorers, scorer)
+		}
+	}
+	return scorers
+}
+
+// getScorerNames makes sure we store an empty list rather than NULL when no scorers were picked.
+func getScorerNames(names []string) []string {
+	if names == nil {
+		return []string{}
+	}
+	return names
+}
+
+// speedBonusScorer gives the most points to whoever found the fibber first, the later you vote the less you get.
+type speedBonusScorer struct{}
+
+func (speedBonusScorer) Name() string {
+	return SpeedBonusScorer
+}
+
+func (speedBonusScorer) Score(round ScoringRound, scoring Scoring) map[uuid.UUID]int {
+	correct := []RoundVote{}
+	for _, vote := range round.Votes {
+		if vote.FoundFibber() && !vote.VoterIsFibber {
+			correct = append(correct, vote)
+		}
+	}
+
+	points := map[uuid.UUID]int{}
+	for i, vote := range correct {
+		points[vote.VoterID] += scoring.SpeedBonus * (len(correct) - i) / len(correct)
+	}
+	return points
+}
+
+// streakScorer multiplies the streak bonus by how many rounds in a row a player has found the fibber.
+type streakScorer struct{}
+
+func (streakScorer) Name() string {
+	return StreakScorer
+}
+
+func (streakScorer) Score(round ScoringRound, scoring Scoring) map[uuid.UUID]int {
+	points := map[uuid.UUID]int{}
+	for _, vote := range round.Votes {
+		if !vote.FoundFibber() || vote.VoterIsFibber {
+			continue
+		}
+
+		streak := 1
+		for _, previous := range round.PreviousRounds {
+			found := slices.ContainsFunc(previous, func(v RoundVote) bool {
+				return v.VoterID == vote.VoterID && v.FoundFibber()
+			})
+			if !found {
+				break
+			}
+			streak++
+		}
+
+		if streak > 1 {
+			points[vote.VoterID] = scoring.StreakBonus * (min(streak, maxStreak) - 1)
+		}
+	}
+	return points
+}
+
+// catchUpScorer helps players who are behind, the further behind the leader the more a correct vote is worth.
+type catchUpScorer struct{}
+
+func (catchUpScorer) Name() string {
+	return CatchUpScorer
+}
+
+func (catchUpScorer) Score(round ScoringRound, scoring Scoring) map[uuid.UUID]int {
+	leaderScore := 0
+	for _, score := range round.CurrentScores {
+		leaderScore = max(leaderScore, score)
+	}
+
+	points := map[uuid.UUID]int{}
+	for _, vote := range round.Votes {
+		if !vote.FoundFibber() || vote.VoterIsFibber {
+			continue
+		}
+
+		behindBy := leaderScore - round.CurrentScores[vote.VoterID]
+		if behindBy > 0 {
+			points[vote.VoterID] = behindBy * scoring.CatchUpPercent / 100
+		}
+	}
+	return points
+}
+
+// fibberDodgeScorer gives every fibber points for each vote that missed all of the fibbers.
+type fibberDodgeScorer struct{}
+
+func (fibberDodgeScorer) Name() string {
+	return FibberDodgeScorer
+}
+
+func (fibberDodgeScorer) Score(round ScoringRound, scoring Scoring) map[uuid.UUID]int {
+	dodged := 0
+	for _, vote := range round.Votes {
+		if !vote.VoterIsFibber && !vote.FoundFibber() {
+			dodged++
+		}
+	}
+
+	points := map[uuid.UUID]int{}
+	if dodged > 0 {
+		for _, fibberID := range round.FibberIDs {
+			points[fibberID] = scoring.DodgedVote * dodged
+		}
+	}
+	return points
+}
+
+func newScoringRound(
+	latestRoundID uuid.UUID,
+	fibberIDs []uuid.UUID,
+	votes []db.GetAllVotesForRoundByGameStateIDRow,
+	currentScores map[uuid.UUID]int,
+) ScoringRound {
+	round := ScoringRound{FibberIDs: fibberIDs, CurrentScores: currentScores}
+
+	// INFO: Votes come back ordered by round with the latest round first.
+	previousRoundIDs := []uuid.UUID{}
+	previousRounds := map[uuid.UUID][]RoundVote{}
+	for _, v := range votes {
+		vote := RoundVote{
+			VoterID:        v.VoterID,
+			VotedForID:     v.VotedForID,
+			VoterIsFibber:  v.VoterIsFibber,
+			VotedForFibber: v.VotedForFibber,
+			VotedAt:        v.VotedAt.Time,
+		}
+
+		if v.RoundID == latestRoundID {
+			round.Votes = append(round.Votes, vote)
+			continue
+		}
+
+		if _, ok := previousRounds[v.RoundID]; !ok {
+			previousRoundIDs = append(previousRoundIDs, v.RoundID)
+		}
+		previousRounds[v.RoundID] = append(previousRounds[v.RoundID], vote)
+	}
+
+	slices.SortStableFunc(round.Votes, func(a, b RoundVote) int {
+		return a.VotedAt.Compare(b.VotedAt)
+	})
+
+	for _, roundID := range previousRoundIDs {
+		round.PreviousRounds = append(round.PreviousRounds, previousRounds[roundID])
+	}
+
+	return round
+}
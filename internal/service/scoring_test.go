@@ -0,0 +1,155 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+)
+
+func getScorer(t *testing.T, name string) service.Scorer {
+	t.Helper()
+	for _, scorer := range service.Scorers() {
+		if scorer.Name() == name {
+			return scorer
+		}
+	}
+	require.Failf(t, "scorer not found", "no scorer called %s", name)
+	return nil
+}
+
+func TestScorers(t *testing.T) {
+	t.Parallel()
+
+	fibberID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8e1"))
+	firstID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8e2"))
+	secondID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8e3"))
+	wrongID := uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8e4"))
+
+	scoring := service.Scoring{
+		GuessedFibber:      100,
+		FibberEvadeCapture: 150,
+		SpeedBonus:         50,
+		StreakBonus:        25,
+		CatchUpPercent:     10,
+		DodgedVote:         25,
+	}
+
+	now := time.Now()
+	votes := []service.RoundVote{
+		{VoterID: firstID, VotedForID: fibberID, VotedForFibber: true, VotedAt: now},
+		{VoterID: secondID, VotedForID: fibberID, VotedForFibber: true, VotedAt: now.Add(time.Second)},
+		{VoterID: wrongID, VotedForID: firstID, VotedAt: now.Add(2 * time.Second)},
+		{VoterID: fibberID, VotedForID: wrongID, VoterIsFibber: true, VotedAt: now.Add(3 * time.Second)},
+	}
+
+	tests := []struct {
+		name     string
+		scorer   string
+		round    service.ScoringRound
+		expected map[uuid.UUID]int
+	}{
+		{
+			name:   "Should give the first correct voter the biggest speed bonus",
+			scorer: service.SpeedBonusScorer,
+			round:  service.ScoringRound{FibberIDs: []uuid.UUID{fibberID}, Votes: votes},
+			expected: map[uuid.UUID]int{
+				firstID:  50,
+				secondID: 25,
+			},
+		},
+		{
+			name:   "Should give streak bonus to players who found the fibber in previous rounds",
+			scorer: service.StreakScorer,
+			round: service.ScoringRound{
+				FibberIDs: []uuid.UUID{fibberID},
+				Votes:     votes,
+				PreviousRounds: [][]service.RoundVote{
+					{
+						{VoterID: firstID, VotedForID: secondID, VotedForFibber: true},
+						{VoterID: secondID, VotedForID: firstID, VoterIsFibber: true},
+					},
+					{
+						{VoterID: firstID, VotedForID: wrongID, VotedForFibber: true},
+						{VoterID: secondID, VotedForID: wrongID, VotedForFibber: true},
+					},
+				},
+			},
+			expected: map[uuid.UUID]int{
+				firstID: 50,
+			},
+		},
+		{
+			name:   "Should cap the streak bonus",
+			scorer: service.StreakScorer,
+			round: service.ScoringRound{
+				FibberIDs: []uuid.UUID{fibberID},
+				Votes:     votes[:1],
+				PreviousRounds: [][]service.RoundVote{
+					{{VoterID: firstID, VotedForID: wrongID, VotedForFibber: true}},
+					{{VoterID: firstID, VotedForID: wrongID, VotedForFibber: true}},
+					{{VoterID: firstID, VotedForID: wrongID, VotedForFibber: true}},
+					{{VoterID: firstID, VotedForID: wrongID, VotedForFibber: true}},
+				},
+			},
+			expected: map[uuid.UUID]int{
+				firstID: 75,
+			},
+		},
+		{
+			name:   "Should give catch up bonus to correct voters behind the leader",
+			scorer: service.CatchUpScorer,
+			round: service.ScoringRound{
+				FibberIDs: []uuid.UUID{fibberID},
+				Votes:     votes,
+				CurrentScores: map[uuid.UUID]int{
+					firstID:  500,
+					secondID: 200,
+					wrongID:  0,
+				},
+			},
+			expected: map[uuid.UUID]int{
+				secondID: 30,
+			},
+		},
+		{
+			name:     "Should give the fibber points for every dodged vote",
+			scorer:   service.FibberDodgeScorer,
+			round:    service.ScoringRound{FibberIDs: []uuid.UUID{fibberID}, Votes: votes},
+			expected: map[uuid.UUID]int{fibberID: 25},
+		},
+		{
+			name:   "Should give every fibber points for votes that missed all of the fibbers",
+			scorer: service.FibberDodgeScorer,
+			round: service.ScoringRound{
+				FibberIDs: []uuid.UUID{fibberID, wrongID},
+				Votes: []service.RoundVote{
+					{VoterID: firstID, VotedForID: secondID},
+					{VoterID: firstID, VotedForID: fibberID, VotedForFibber: true},
+					{VoterID: secondID, VotedForID: firstID},
+					{VoterID: secondID, VotedForID: wrongID, VotedForFibber: true},
+					{VoterID: fibberID, VotedForID: firstID, VoterIsFibber: true},
+				},
+			},
+			expected: map[uuid.UUID]int{fibberID: 50, wrongID: 50},
+		},
+		{
+			name:     "Should give no points when there are no votes",
+			scorer:   service.FibberDodgeScorer,
+			round:    service.ScoringRound{FibberIDs: []uuid.UUID{fibberID}},
+			expected: map[uuid.UUID]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			points := getScorer(t, tt.scorer).Score(tt.round, scoring)
+			assert.Equal(t, tt.expected, points)
+		})
+	}
+}
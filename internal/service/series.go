@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+)
+
+// IsSeries is false for rooms playing a single game, which don't have a series leaderboard.
+func (s SeriesState) IsSeries() bool {
+	return s.Games > 1
+}
+
+// IsOver is true on the last game of the series, when the overall series winner is declared.
+func (s SeriesState) IsOver() bool {
+	return s.IsSeries() && s.Game >= s.Games
+}
+
+// MaxScore is the leading score in the series, used to scale the series scoreboard.
+func (s SeriesState) MaxScore() int {
+	if len(s.Players) == 0 {
+		return 0
+	}
+	return s.Players[0].Score
+}
+
+// getSeriesState adds the scores from this game on to the scores from the earlier games in the series.
+func (r *RoundService) getSeriesState(
+	ctx context.Context,
+	gameStateID uuid.UUID,
+	seriesID uuid.NullUUID,
+	seriesGame int32,
+	seriesGames int32,
+	players []PlayerWithScoring,
+) (SeriesState, error) {
+	if !seriesID.Valid || seriesGames <= 1 {
+		return SeriesState{}, nil
+	}
+
+	earlierScores, err := r.store.GetSeriesScores(ctx, db.GetSeriesScoresParams{
+		SeriesID: seriesID,
+		ID:       gameStateID,
+	})
+	if err != nil {
+		return SeriesState{}, err
+	}
+
+	standings := map[uuid.UUID]PlayerWithScoring{}
+	for _, p := range earlierScores {
+		standings[p.PlayerID] = PlayerWithScoring{
+			ID:       p.PlayerID,
+			Nickname: p.Nickname,
+			Avatar:   p.Avatar,
+			Score:    int(p.TotalScore),
+		}
+	}
+
+	for _, p := range players {
+		standing := standings[p.ID]
+		standing.ID = p.ID
+		standing.Nickname = p.Nickname
+		standing.Avatar = p.Avatar
+		standing.Score += p.Score
+		standings[p.ID] = standing
+	}
+
+	series := SeriesState{
+		Game:    int(seriesGame),
+		Games:   int(seriesGames),
+		Players: []PlayerWithScoring{},
+	}
+	for _, standing := range standings {
+		series.Players = append(series.Players, standing)
+	}
+
+	sort.Slice(series.Players, func(i, j int) bool {
+		if series.Players[i].Score != series.Players[j].Score {
+			return series.Players[i].Score > series.Players[j].Score
+		}
+		return series.Players[i].Nickname < series.Players[j].Nickname
+	})
+
+	return series, nil
+}
@@ -11,7 +11,8 @@ type Randomizer interface {
 	GetAvatar(nickname string) string
 	GetRoomCode() string
 	GetID() (uuid.UUID, error)
-	GetFibberIndex(playersLen int) int
+	GetFibberIndexes(playersLen int, fibbers int) []int
+	GetFairFibberIndexes(timesFibber []int, fibbers int) []int
 }
 
 func getLobbyPlayers(playerRows []db.GetAllPlayersInRoomRow, roomCode string) Lobby {
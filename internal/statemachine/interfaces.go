@@ -16,6 +16,13 @@ type RoundService interface {
 	AreAllPlayersAnswerReady(ctx context.Context, gameStateID uuid.UUID) (bool, error)
 	UpdateStateToVoting(ctx context.Context, gameStateID uuid.UUID, deadline time.Time) (service.VotingState, error)
 	AreAllPlayersVotingReady(ctx context.Context, gameStateID uuid.UUID) (bool, error)
+	GetTiedPlayers(ctx context.Context, gameStateID uuid.UUID) ([]uuid.UUID, error)
+	UpdateStateToRevote(
+		ctx context.Context,
+		gameStateID uuid.UUID,
+		candidates []uuid.UUID,
+		deadline time.Time,
+	) (service.VotingState, error)
 	UpdateStateToReveal(ctx context.Context, gameStateID uuid.UUID, deadline time.Time) (service.RevealRoleState, error)
 	UpdateStateToScore(ctx context.Context, gameStateID uuid.UUID, deadline time.Time, scoring service.Scoring) (service.ScoreState, error)
 	UpdateStateToWinner(ctx context.Context, gameStateID uuid.UUID, deadline time.Time) (service.WinnerState, error)
@@ -39,6 +46,7 @@ type Timings struct {
 	ShowQuestionScreenFor time.Duration
 	ShowVotingScreenFor   time.Duration
 	ShowRevealScreenFor   time.Duration
+	ShowRevoteScreenFor   time.Duration
 	ShowScoreScreenFor    time.Duration
 	ShowWinnerScreenFor   time.Duration
 }
@@ -296,6 +296,74 @@ func (_c *MockRoundService_GetGameStateByID_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetTiedPlayers provides a mock function for the type MockRoundService
+func (_mock *MockRoundService) GetTiedPlayers(ctx context.Context, gameStateID uuid.UUID) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, gameStateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTiedPlayers")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, gameStateID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, gameStateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, gameStateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundService_GetTiedPlayers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTiedPlayers'
+type MockRoundService_GetTiedPlayers_Call struct {
+	*mock.Call
+}
+
+// GetTiedPlayers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameStateID uuid.UUID
+func (_e *MockRoundService_Expecter) GetTiedPlayers(ctx interface{}, gameStateID interface{}) *MockRoundService_GetTiedPlayers_Call {
+	return &MockRoundService_GetTiedPlayers_Call{Call: _e.mock.On("GetTiedPlayers", ctx, gameStateID)}
+}
+
+func (_c *MockRoundService_GetTiedPlayers_Call) Run(run func(ctx context.Context, gameStateID uuid.UUID)) *MockRoundService_GetTiedPlayers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundService_GetTiedPlayers_Call) Return(uUIDs []uuid.UUID, err error) *MockRoundService_GetTiedPlayers_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *MockRoundService_GetTiedPlayers_Call) RunAndReturn(run func(ctx context.Context, gameStateID uuid.UUID) ([]uuid.UUID, error)) *MockRoundService_GetTiedPlayers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateToQuestion provides a mock function for the type MockRoundService
 func (_mock *MockRoundService) UpdateStateToQuestion(ctx context.Context, gameStateID uuid.UUID, deadline time.Time, nextRound bool) (service.QuestionState, error) {
 	ret := _mock.Called(ctx, gameStateID, deadline, nextRound)
@@ -446,6 +514,84 @@ func (_c *MockRoundService_UpdateStateToReveal_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// UpdateStateToRevote provides a mock function for the type MockRoundService
+func (_mock *MockRoundService) UpdateStateToRevote(ctx context.Context, gameStateID uuid.UUID, candidates []uuid.UUID, deadline time.Time) (service.VotingState, error) {
+	ret := _mock.Called(ctx, gameStateID, candidates, deadline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStateToRevote")
+	}
+
+	var r0 service.VotingState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID, time.Time) (service.VotingState, error)); ok {
+		return returnFunc(ctx, gameStateID, candidates, deadline)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID, time.Time) service.VotingState); ok {
+		r0 = returnFunc(ctx, gameStateID, candidates, deadline)
+	} else {
+		r0 = ret.Get(0).(service.VotingState)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, gameStateID, candidates, deadline)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundService_UpdateStateToRevote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStateToRevote'
+type MockRoundService_UpdateStateToRevote_Call struct {
+	*mock.Call
+}
+
+// UpdateStateToRevote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameStateID uuid.UUID
+//   - candidates []uuid.UUID
+//   - deadline time.Time
+func (_e *MockRoundService_Expecter) UpdateStateToRevote(ctx interface{}, gameStateID interface{}, candidates interface{}, deadline interface{}) *MockRoundService_UpdateStateToRevote_Call {
+	return &MockRoundService_UpdateStateToRevote_Call{Call: _e.mock.On("UpdateStateToRevote", ctx, gameStateID, candidates, deadline)}
+}
+
+func (_c *MockRoundService_UpdateStateToRevote_Call) Run(run func(ctx context.Context, gameStateID uuid.UUID, candidates []uuid.UUID, deadline time.Time)) *MockRoundService_UpdateStateToRevote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 []uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].([]uuid.UUID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundService_UpdateStateToRevote_Call) Return(votingState service.VotingState, err error) *MockRoundService_UpdateStateToRevote_Call {
+	_c.Call.Return(votingState, err)
+	return _c
+}
+
+func (_c *MockRoundService_UpdateStateToRevote_Call) RunAndReturn(run func(ctx context.Context, gameStateID uuid.UUID, candidates []uuid.UUID, deadline time.Time) (service.VotingState, error)) *MockRoundService_UpdateStateToRevote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateToScore provides a mock function for the type MockRoundService
 func (_mock *MockRoundService) UpdateStateToScore(ctx context.Context, gameStateID uuid.UUID, deadline time.Time, scoring service.Scoring) (service.ScoreState, error) {
 	ret := _mock.Called(ctx, gameStateID, deadline, scoring)
@@ -29,6 +29,10 @@ func NewRevealState(gameStateID uuid.UUID, deps *StateDependencies) (*RevealStat
 }
 
 func (r *RevealState) Start(ctx context.Context) error {
+	if r.startRevoteOnTie(ctx) {
+		return nil
+	}
+
 	stateCtx, cleanup := startStateExecution(
 		ctx,
 		"reveal",
@@ -70,6 +74,35 @@ func (r *RevealState) Start(ctx context.Context) error {
 	return nil
 }
 
+// startRevoteOnTie hands over to a revote instead of revealing, when players tied and the room breaks ties with a revote.
+func (r *RevealState) startRevoteOnTie(ctx context.Context) bool {
+	tied, err := r.Dependencies.RoundService.GetTiedPlayers(ctx, r.GameStateID)
+	if err != nil {
+		r.Dependencies.Logger.WarnContext(ctx, "failed to check for tied players, revealing without a revote",
+			slog.Any("error", err),
+			slog.String("game_state_id", r.GameStateID.String()))
+		return false
+	}
+
+	if len(tied) == 0 {
+		return false
+	}
+
+	revote, err := NewRevoteState(r.GameStateID, tied, r.Dependencies)
+	if err != nil {
+		r.Dependencies.Logger.ErrorContext(ctx, "failed to create revote state",
+			slog.Any("error", err),
+			slog.String("game_state_id", r.GameStateID.String()))
+		return false
+	}
+
+	r.Dependencies.Logger.InfoContext(ctx, "players tied, starting revote",
+		slog.Int("tied_players", len(tied)),
+		slog.String("game_state_id", r.GameStateID.String()))
+	r.Dependencies.Transitioner.StartStateMachine(ctx, r.GameStateID, revote)
+	return true
+}
+
 func (r *RevealState) updateToRevealWithRetry(stateCtx *stateExecutionContext, deadline time.Time) (service.RevealRoleState, error) {
 	revealState, err := r.Dependencies.RoundService.UpdateStateToReveal(stateCtx.ctx, r.GameStateID, deadline)
 	if err != nil {
@@ -87,14 +120,14 @@ func (r *RevealState) updateToRevealWithRetry(stateCtx *stateExecutionContext, d
 }
 
 func (r *RevealState) determineNextState(stateCtx *stateExecutionContext, revealState service.RevealRoleState) db.FibbingItGameState {
-	maxRounds := 3
-	finalRound := revealState.Round == maxRounds
-	fibberFound := revealState.ShouldReveal && revealState.VotedForPlayerRole == service.FibberRole
+	finalRound := revealState.Round >= revealState.MaxRounds
+	// INFO: With more than one fibber, the round only ends early once all of them have been found.
+	fibberFound := revealState.ShouldReveal && revealState.FibbersFound == revealState.Fibbers
 	nextState := db.FibbingITQuestion
 
 	if finalRound || fibberFound {
 		nextState = db.FibbingItScoring
-		if revealState.RoundType == service.RoundTypeMostLikely {
+		if revealState.RoundType == service.RoundTypeMostLikely && revealState.IsLastRoundType {
 			nextState = db.FibbingItWinner
 		}
 	}
@@ -103,6 +136,7 @@ func (r *RevealState) determineNextState(stateCtx *stateExecutionContext, reveal
 		slog.String("next_state", nextState.String()),
 		slog.Bool("final_round", finalRound),
 		slog.Bool("fibber_found", fibberFound),
+		slog.Bool("last_round_type", revealState.IsLastRoundType),
 		slog.String("game_state_id", r.GameStateID.String()))
 
 	stateCtx.addTransition(nextState.String(), "timeout",
@@ -0,0 +1,77 @@
+package statemachine
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// RevoteState is a short extra voting phase between the players who tied for the most votes.
+type RevoteState struct {
+	GameStateID  uuid.UUID
+	Candidates   []uuid.UUID
+	Dependencies *StateDependencies
+}
+
+func NewRevoteState(gameStateID uuid.UUID, candidates []uuid.UUID, deps *StateDependencies) (*RevoteState, error) {
+	if deps == nil {
+		return nil, errors.New("dependencies cannot be nil")
+	}
+	if len(candidates) < 2 {
+		return nil, errors.New("at least two candidates are needed for a revote")
+	}
+	return &RevoteState{
+		GameStateID:  gameStateID,
+		Candidates:   candidates,
+		Dependencies: deps,
+	}, nil
+}
+
+func (v *RevoteState) Start(ctx context.Context) error {
+	stateCtx, cleanup := startStateExecution(
+		ctx,
+		"revote",
+		v.GameStateID,
+		v.Dependencies.Logger,
+		v.Dependencies.Timings.ShowRevoteScreenFor.Milliseconds(),
+		attribute.Int("revote.candidates", len(v.Candidates)),
+	)
+	defer cleanup()
+
+	deadline := time.Now().UTC().Add(v.Dependencies.Timings.ShowRevoteScreenFor)
+
+	votingState, err := v.Dependencies.RoundService.UpdateStateToRevote(stateCtx.ctx, v.GameStateID, v.Candidates, deadline)
+	if err != nil {
+		stateCtx.recordStateUpdateError(err, "update_to_revote")
+		return err
+	}
+
+	if err := v.Dependencies.ClientUpdater.UpdateClientsAboutVoting(stateCtx.ctx, votingState); err != nil {
+		stateCtx.recordClientUpdateError(err)
+	}
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		stateCtx.addTransition("reveal", "timeout_or_all_ready")
+		r, err := NewRevealState(v.GameStateID, v.Dependencies)
+		if err != nil {
+			stateCtx.logger.ErrorContext(stateCtx.ctx, "failed to create reveal state",
+				slog.Any("error", err),
+				slog.String("game_state_id", v.GameStateID.String()))
+			return nil
+		}
+		v.Dependencies.Transitioner.StartStateMachine(stateCtx.ctx, v.GameStateID, r)
+	case <-stateCtx.ctx.Done():
+		stateCtx.logger.InfoContext(stateCtx.ctx, "revote state cancelled",
+			slog.String("game_state_id", v.GameStateID.String()))
+	}
+
+	return nil
+}
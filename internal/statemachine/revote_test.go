@@ -0,0 +1,69 @@
+package statemachine_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	"gitlab.com/hmajid2301/banterbus/internal/statemachine"
+	mockStatemachine "gitlab.com/hmajid2301/banterbus/internal/statemachine/mocks"
+)
+
+func TestRevoteStateEndsEarlyWhenEveryoneIsReady(t *testing.T) {
+	t.Parallel()
+
+	gameStateID := uuid.Must(uuid.FromString("0193a629-1fcf-79dd-ac70-760bedbdffa9"))
+	candidates := []uuid.UUID{
+		uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d1")),
+		uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8d2")),
+	}
+
+	mockRoundService := mockStatemachine.NewMockRoundService(t)
+	mockClientUpdater := mockStatemachine.NewMockClientUpdater(t)
+	// The revote timer never fires, so the revote must not transition to anything itself.
+	mockTransitioner := mockStatemachine.NewMockStateTransitioner(t)
+	manager := statemachine.NewManager(t.Context(), slog.Default())
+
+	deps := &statemachine.StateDependencies{
+		RoundService:  mockRoundService,
+		ClientUpdater: mockClientUpdater,
+		Transitioner:  mockTransitioner,
+		Logger:        slog.Default(),
+		Timings: statemachine.Timings{
+			ShowRevoteScreenFor: time.Hour,
+			ShowRevealScreenFor: time.Hour,
+		},
+	}
+
+	revoteStarted := make(chan struct{})
+	mockRoundService.EXPECT().UpdateStateToRevote(mock.Anything, gameStateID, candidates, mock.Anything).
+		Run(func(context.Context, uuid.UUID, []uuid.UUID, time.Time) {
+			close(revoteStarted)
+		}).
+		Return(service.VotingState{GameStateID: gameStateID, IsRevote: true}, nil)
+	mockClientUpdater.EXPECT().UpdateClientsAboutVoting(mock.Anything, mock.Anything).Return(nil)
+
+	revote, err := statemachine.NewRevoteState(gameStateID, candidates, deps)
+	require.NoError(t, err)
+	manager.Start(t.Context(), gameStateID, revote)
+	<-revoteStarted
+
+	// Everyone readied up. Reveal fails straight away here, so Wait only depends on the revote stopping.
+	mockRoundService.EXPECT().GetTiedPlayers(mock.Anything, gameStateID).Return(nil, nil)
+	mockRoundService.EXPECT().UpdateStateToReveal(mock.Anything, gameStateID, mock.Anything).
+		Return(service.RevealRoleState{}, errors.New("game already revealed"))
+
+	reveal, err := statemachine.NewRevealState(gameStateID, deps)
+	require.NoError(t, err)
+	manager.Start(t.Context(), gameStateID, reveal)
+
+	assert.True(t, manager.Wait(t.Context(), time.Second), "revote should stop once reveal starts")
+}
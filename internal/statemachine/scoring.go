@@ -65,14 +65,15 @@ func (r *ScoringState) Start(ctx context.Context) error {
 }
 
 func (r *ScoringState) transitionToNextState(stateCtx *stateExecutionContext, scoringState service.ScoreState) {
-	shouldEndGame := scoringState.TotalRounds >= 3 ||
-		scoringState.RoundType == service.RoundTypeMostLikely
+	shouldEndGame := scoringState.IsLastRoundType
 
 	stateCtx.logger.InfoContext(stateCtx.ctx, "scoring state transition decision",
 		slog.Int("round_number", scoringState.RoundNumber),
 		slog.Int("total_rounds", scoringState.TotalRounds),
+		slog.Int("max_rounds", scoringState.MaxRounds),
 		slog.Bool("fibber_caught", scoringState.FibberCaught),
 		slog.String("round_type", scoringState.RoundType),
+		slog.Bool("last_round_type", scoringState.IsLastRoundType),
 		slog.Bool("should_end_game", shouldEndGame),
 		slog.String("game_state_id", r.GameStateID.String()))
 
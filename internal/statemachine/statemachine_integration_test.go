@@ -72,7 +72,16 @@ func setupIntegrationTest(t *testing.T) (*testServices, *statemachine.StateDepen
 	rand := randomizer.NewUserRandomizer()
 
 	roundService := service.NewRoundService(storer, rand, "en-GB")
-	lobbyService := service.NewLobbyService(storer, rand, "en-GB")
+	lobbyService := service.NewLobbyService(storer, rand, "en-GB", service.RoomSettings{
+		MaxRounds:  3,
+		RoundTypes: service.RoundTypes(),
+		Timings: service.Timings{
+			ShowQuestionScreenFor: 15 * time.Second,
+			ShowVotingScreenFor:   60 * time.Second,
+			ShowRevealScreenFor:   15 * time.Second,
+			ShowScoreScreenFor:    15 * time.Second,
+		},
+	})
 	playerService := service.NewPlayerService(storer, rand)
 
 	services := &testServices{
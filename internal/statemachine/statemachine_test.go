@@ -30,6 +30,30 @@ func TestNewRevealState_ReturnsErrorOnNilDeps(t *testing.T) {
 	assert.Contains(t, err.Error(), "dependencies cannot be nil")
 }
 
+func TestNewRevoteState_ReturnsErrorOnNilDeps(t *testing.T) {
+	t.Parallel()
+
+	gameStateID := uuid.Must(uuid.NewV4())
+	candidates := []uuid.UUID{uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())}
+
+	state, err := NewRevoteState(gameStateID, candidates, nil)
+	require.Error(t, err)
+	assert.Nil(t, state)
+	assert.Contains(t, err.Error(), "dependencies cannot be nil")
+}
+
+func TestNewRevoteState_ReturnsErrorWithoutTie(t *testing.T) {
+	t.Parallel()
+
+	gameStateID := uuid.Must(uuid.NewV4())
+	candidates := []uuid.UUID{uuid.Must(uuid.NewV4())}
+
+	state, err := NewRevoteState(gameStateID, candidates, &StateDependencies{})
+	require.Error(t, err)
+	assert.Nil(t, state)
+	assert.Contains(t, err.Error(), "at least two candidates")
+}
+
 func TestNewScoringState_ReturnsErrorOnNilDeps(t *testing.T) {
 	t.Parallel()
 
@@ -47,6 +47,12 @@ func (v *VotingState) Start(ctx context.Context) error {
 		stateCtx.recordClientUpdateError(err)
 	}
 
+	// INFO: A revote only starts as a VotingState when it is resumed or recovered, so keep its shorter deadline
+	// that is already stored rather than giving players the full voting time again.
+	if votingState.IsRevote {
+		deadline = time.Now().UTC().Add(min(votingState.Deadline, time.Until(deadline)))
+	}
+
 	timer := time.NewTimer(time.Until(deadline))
 	defer timer.Stop()
 
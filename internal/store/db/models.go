@@ -9,6 +9,26 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Account struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+	Subject   string
+	Nickname  string
+	Avatar    string
+	Locale    pgtype.Text
+}
+
+type AudienceMember struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+	RoomID    uuid.UUID
+	Nickname  string
+	Avatar    string
+	Locale    pgtype.Text
+}
+
 type FibbingItAnswer struct {
 	ID        uuid.UUID
 	CreatedAt pgtype.Timestamp
@@ -17,6 +37,16 @@ type FibbingItAnswer struct {
 	PlayerID  uuid.UUID
 	RoundID   uuid.UUID
 	IsReady   pgtype.Bool
+	Ranking   []string
+}
+
+type FibbingItAudienceVote struct {
+	ID               uuid.UUID
+	CreatedAt        pgtype.Timestamp
+	UpdatedAt        pgtype.Timestamp
+	AudienceMemberID uuid.UUID
+	VotedForPlayerID uuid.UUID
+	RoundID          uuid.UUID
 }
 
 type FibbingItPlayerRole struct {
@@ -37,15 +67,21 @@ type FibbingItRound struct {
 	FibberQuestionID uuid.UUID
 	NormalQuestionID uuid.UUID
 	GameStateID      uuid.UUID
+	RoundTypeIndex   int32
+	RevoteCandidates []string
+	RevoteWinners    []string
+	RevealedPlayers  []string
 }
 
 type FibbingItScore struct {
-	ID        uuid.UUID
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	PlayerID  uuid.UUID
-	Score     int32
-	RoundID   uuid.UUID
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	PlayerID      uuid.UUID
+	Score         int32
+	RoundID       uuid.UUID
+	ReasonScorers []string
+	ReasonPoints  []int32
 }
 
 type FibbingItVote struct {
@@ -68,43 +104,75 @@ type GameState struct {
 	PauseTimeRemainingMs pgtype.Int4
 	PausedAt             pgtype.Timestamp
 	PauseDeadline        pgtype.Timestamp
+	MaxRounds            int32
+	RoundTypes           []string
+	QuestionSeconds      int32
+	VotingSeconds        int32
+	RevealSeconds        int32
+	ScoreSeconds         int32
+	Scorers              []string
+	RevealRule           string
+	TieBreak             string
+	Fibbers              int32
+	FibberSelection      string
+	FibberRotation       string
+	SeriesID             uuid.NullUUID
+	SeriesGame           int32
+	SeriesGames          int32
+	RecentGames          int32
+	RevoteSeconds        int32
+	WinnerSeconds        int32
 }
 
 type Player struct {
-	ID        uuid.UUID
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	Avatar    string
-	Nickname  string
-	IsReady   pgtype.Bool
-	Locale    pgtype.Text
+	ID             uuid.UUID
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	Avatar         string
+	Nickname       string
+	IsReady        pgtype.Bool
+	Locale         pgtype.Text
+	DisconnectedAt pgtype.Timestamp
+	InactiveAt     pgtype.Timestamp
+	AccountID      uuid.NullUUID
 }
 
 type Question struct {
-	ID        uuid.UUID
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	GameName  string
-	RoundType string
-	Enabled   pgtype.Bool
-	GroupID   uuid.UUID
+	ID         uuid.UUID
+	CreatedAt  pgtype.Timestamp
+	UpdatedAt  pgtype.Timestamp
+	GameName   string
+	RoundType  string
+	Enabled    pgtype.Bool
+	GroupID    uuid.UUID
+	ArchivedAt pgtype.Timestamp
 }
 
-type QuestionsGroup struct {
-	ID        uuid.UUID
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	GroupName string
-	GroupType string
+type QuestionPair struct {
+	ID               uuid.UUID
+	CreatedAt        pgtype.Timestamp
+	UpdatedAt        pgtype.Timestamp
+	NormalQuestionID uuid.UUID
+	FibberQuestionID uuid.UUID
 }
 
-type QuestionsI18n struct {
+type QuestionsGroup struct {
 	ID         uuid.UUID
 	CreatedAt  pgtype.Timestamp
 	UpdatedAt  pgtype.Timestamp
-	Question   string
-	Locale     string
-	QuestionID uuid.UUID
+	GroupName  string
+	GroupType  string
+	ArchivedAt pgtype.Timestamp
+}
+
+type QuestionsI18n struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	Question      string
+	Locale        string
+	QuestionID    uuid.UUID
+	AnswerOptions []string
 }
 
 type Room struct {
@@ -115,6 +183,37 @@ type Room struct {
 	HostPlayer uuid.UUID
 	RoomState  string
 	RoomCode   string
+	SeriesID   uuid.NullUUID
+}
+
+type RoomDisplay struct {
+	ID        uuid.UUID
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+	RoomID    uuid.UUID
+	Locale    pgtype.Text
+}
+
+type RoomSetting struct {
+	RoomID          uuid.UUID
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	MaxRounds       int32
+	RoundTypes      []string
+	QuestionSeconds int32
+	VotingSeconds   int32
+	RevealSeconds   int32
+	ScoreSeconds    int32
+	Scorers         []string
+	RevealRule      string
+	TieBreak        string
+	Fibbers         int32
+	FibberSelection string
+	FibberRotation  string
+	SeriesGames     int32
+	RecentGames     int32
+	RevoteSeconds   int32
+	WinnerSeconds   int32
 }
 
 type RoomsPlayer struct {
@@ -46,8 +46,8 @@ func (q *Queries) AddFibbingItRole(ctx context.Context, arg AddFibbingItRolePara
 
 const addFibbingItRound = `-- name: AddFibbingItRound :one
 INSERT INTO fibbing_it_rounds (
-    id, round_type, round, fibber_question_id, normal_question_id, game_state_id
-) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at, updated_at, round_type, round, fibber_question_id, normal_question_id, game_state_id
+    id, round_type, round, fibber_question_id, normal_question_id, game_state_id, round_type_index
+) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, updated_at, round_type, round, fibber_question_id, normal_question_id, game_state_id, round_type_index, revote_candidates, revote_winners, revealed_players
 `
 
 type AddFibbingItRoundParams struct {
@@ -57,6 +57,7 @@ type AddFibbingItRoundParams struct {
 	FibberQuestionID uuid.UUID
 	NormalQuestionID uuid.UUID
 	GameStateID      uuid.UUID
+	RoundTypeIndex   int32
 }
 
 func (q *Queries) AddFibbingItRound(ctx context.Context, arg AddFibbingItRoundParams) (FibbingItRound, error) {
@@ -67,6 +68,7 @@ func (q *Queries) AddFibbingItRound(ctx context.Context, arg AddFibbingItRoundPa
 		arg.FibberQuestionID,
 		arg.NormalQuestionID,
 		arg.GameStateID,
+		arg.RoundTypeIndex,
 	)
 	var i FibbingItRound
 	err := row.Scan(
@@ -78,21 +80,27 @@ func (q *Queries) AddFibbingItRound(ctx context.Context, arg AddFibbingItRoundPa
 		&i.FibberQuestionID,
 		&i.NormalQuestionID,
 		&i.GameStateID,
+		&i.RoundTypeIndex,
+		&i.RevoteCandidates,
+		&i.RevoteWinners,
+		&i.RevealedPlayers,
 	)
 	return i, err
 }
 
 const addFibbingItScore = `-- name: AddFibbingItScore :one
-INSERT INTO fibbing_it_scores (id, player_id, score, round_id) VALUES (
-    $1, $2, $3, $4
-) RETURNING id, created_at, updated_at, player_id, score, round_id
+INSERT INTO fibbing_it_scores (id, player_id, score, round_id, reason_scorers, reason_points) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, created_at, updated_at, player_id, score, round_id, reason_scorers, reason_points
 `
 
 type AddFibbingItScoreParams struct {
-	ID       uuid.UUID
-	PlayerID uuid.UUID
-	Score    int32
-	RoundID  uuid.UUID
+	ID            uuid.UUID
+	PlayerID      uuid.UUID
+	Score         int32
+	RoundID       uuid.UUID
+	ReasonScorers []string
+	ReasonPoints  []int32
 }
 
 func (q *Queries) AddFibbingItScore(ctx context.Context, arg AddFibbingItScoreParams) (FibbingItScore, error) {
@@ -101,6 +109,8 @@ func (q *Queries) AddFibbingItScore(ctx context.Context, arg AddFibbingItScorePa
 		arg.PlayerID,
 		arg.Score,
 		arg.RoundID,
+		arg.ReasonScorers,
+		arg.ReasonPoints,
 	)
 	var i FibbingItScore
 	err := row.Scan(
@@ -110,21 +120,64 @@ func (q *Queries) AddFibbingItScore(ctx context.Context, arg AddFibbingItScorePa
 		&i.PlayerID,
 		&i.Score,
 		&i.RoundID,
+		&i.ReasonScorers,
+		&i.ReasonPoints,
 	)
 	return i, err
 }
 
 const addGameState = `-- name: AddGameState :one
-INSERT INTO game_state (id, room_id, submit_deadline, state) VALUES (
-    $1, $2, $3, $4
-) RETURNING id, created_at, updated_at, room_id, submit_deadline, state, pause_time_remaining_ms, paused_at, pause_deadline
+INSERT INTO game_state (
+    id,
+    room_id,
+    submit_deadline,
+    state,
+    max_rounds,
+    round_types,
+    question_seconds,
+    voting_seconds,
+    reveal_seconds,
+    score_seconds,
+    scorers,
+    reveal_rule,
+    tie_break,
+    fibbers,
+    fibber_selection,
+    fibber_rotation,
+    series_id,
+    series_game,
+    series_games,
+    recent_games,
+    revote_seconds,
+    winner_seconds
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
+) RETURNING id, created_at, updated_at, room_id, submit_deadline, state, pause_time_remaining_ms, paused_at, pause_deadline, max_rounds, round_types, question_seconds, voting_seconds, reveal_seconds, score_seconds, scorers, reveal_rule, tie_break, fibbers, fibber_selection, fibber_rotation, series_id, series_game, series_games, recent_games, revote_seconds, winner_seconds
 `
 
 type AddGameStateParams struct {
-	ID             uuid.UUID
-	RoomID         uuid.UUID
-	SubmitDeadline pgtype.Timestamp
-	State          string
+	ID              uuid.UUID
+	RoomID          uuid.UUID
+	SubmitDeadline  pgtype.Timestamp
+	State           string
+	MaxRounds       int32
+	RoundTypes      []string
+	QuestionSeconds int32
+	VotingSeconds   int32
+	RevealSeconds   int32
+	ScoreSeconds    int32
+	Scorers         []string
+	RevealRule      string
+	TieBreak        string
+	Fibbers         int32
+	FibberSelection string
+	FibberRotation  string
+	SeriesID        uuid.NullUUID
+	SeriesGame      int32
+	SeriesGames     int32
+	RecentGames     int32
+	RevoteSeconds   int32
+	WinnerSeconds   int32
 }
 
 func (q *Queries) AddGameState(ctx context.Context, arg AddGameStateParams) (GameState, error) {
@@ -133,6 +186,24 @@ func (q *Queries) AddGameState(ctx context.Context, arg AddGameStateParams) (Gam
 		arg.RoomID,
 		arg.SubmitDeadline,
 		arg.State,
+		arg.MaxRounds,
+		arg.RoundTypes,
+		arg.QuestionSeconds,
+		arg.VotingSeconds,
+		arg.RevealSeconds,
+		arg.ScoreSeconds,
+		arg.Scorers,
+		arg.RevealRule,
+		arg.TieBreak,
+		arg.Fibbers,
+		arg.FibberSelection,
+		arg.FibberRotation,
+		arg.SeriesID,
+		arg.SeriesGame,
+		arg.SeriesGames,
+		arg.RecentGames,
+		arg.RevoteSeconds,
+		arg.WinnerSeconds,
 	)
 	var i GameState
 	err := row.Scan(
@@ -145,6 +216,24 @@ func (q *Queries) AddGameState(ctx context.Context, arg AddGameStateParams) (Gam
 		&i.PauseTimeRemainingMs,
 		&i.PausedAt,
 		&i.PauseDeadline,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.QuestionSeconds,
+		&i.VotingSeconds,
+		&i.RevealSeconds,
+		&i.ScoreSeconds,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
+		&i.SeriesID,
+		&i.SeriesGame,
+		&i.SeriesGames,
+		&i.RecentGames,
+		&i.RevoteSeconds,
+		&i.WinnerSeconds,
 	)
 	return i, err
 }
@@ -152,7 +241,7 @@ func (q *Queries) AddGameState(ctx context.Context, arg AddGameStateParams) (Gam
 const addGroup = `-- name: AddGroup :one
 INSERT INTO questions_groups (id, group_name, group_type)
 VALUES ($1, $2, $3)
-RETURNING id, created_at, updated_at, group_name, group_type
+RETURNING id, created_at, updated_at, group_name, group_type, archived_at
 `
 
 type AddGroupParams struct {
@@ -170,6 +259,7 @@ func (q *Queries) AddGroup(ctx context.Context, arg AddGroupParams) (QuestionsGr
 		&i.UpdatedAt,
 		&i.GroupName,
 		&i.GroupType,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -177,7 +267,7 @@ func (q *Queries) AddGroup(ctx context.Context, arg AddGroupParams) (QuestionsGr
 const addPlayer = `-- name: AddPlayer :one
 INSERT INTO players (id, avatar, nickname, locale) VALUES (
     $1, $2, $3, $4
-) RETURNING id, created_at, updated_at, avatar, nickname, is_ready, locale
+) RETURNING id, created_at, updated_at, avatar, nickname, is_ready, locale, disconnected_at, inactive_at, account_id
 `
 
 type AddPlayerParams struct {
@@ -203,6 +293,9 @@ func (q *Queries) AddPlayer(ctx context.Context, arg AddPlayerParams) (Player, e
 		&i.Nickname,
 		&i.IsReady,
 		&i.Locale,
+		&i.DisconnectedAt,
+		&i.InactiveAt,
+		&i.AccountID,
 	)
 	return i, err
 }
@@ -210,7 +303,7 @@ func (q *Queries) AddPlayer(ctx context.Context, arg AddPlayerParams) (Player, e
 const addQuestion = `-- name: AddQuestion :one
 INSERT INTO questions (id, game_name, group_id, round_type) VALUES (
     $1, $2, $3, $4
-) RETURNING id, created_at, updated_at, game_name, round_type, enabled, group_id
+) RETURNING id, created_at, updated_at, game_name, round_type, enabled, group_id, archived_at
 `
 
 type AddQuestionParams struct {
@@ -236,21 +329,48 @@ func (q *Queries) AddQuestion(ctx context.Context, arg AddQuestionParams) (Quest
 		&i.RoundType,
 		&i.Enabled,
 		&i.GroupID,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
+const addQuestionPair = `-- name: AddQuestionPair :one
+INSERT INTO question_pairs (id, normal_question_id, fibber_question_id)
+VALUES ($1, $2, $3)
+RETURNING id, created_at, updated_at, normal_question_id, fibber_question_id
+`
+
+type AddQuestionPairParams struct {
+	ID               uuid.UUID
+	NormalQuestionID uuid.UUID
+	FibberQuestionID uuid.UUID
+}
+
+func (q *Queries) AddQuestionPair(ctx context.Context, arg AddQuestionPairParams) (QuestionPair, error) {
+	row := q.db.QueryRow(ctx, addQuestionPair, arg.ID, arg.NormalQuestionID, arg.FibberQuestionID)
+	var i QuestionPair
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.NormalQuestionID,
+		&i.FibberQuestionID,
 	)
 	return i, err
 }
 
 const addQuestionTranslation = `-- name: AddQuestionTranslation :one
-INSERT INTO questions_i18n (id, question, locale, question_id) VALUES (
-    $1, $2, $3, $4
-) RETURNING id, created_at, updated_at, question, locale, question_id
+INSERT INTO questions_i18n (id, question, locale, question_id, answer_options) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, created_at, updated_at, question, locale, question_id, answer_options
 `
 
 type AddQuestionTranslationParams struct {
-	ID         uuid.UUID
-	Question   string
-	Locale     string
-	QuestionID uuid.UUID
+	ID            uuid.UUID
+	Question      string
+	Locale        string
+	QuestionID    uuid.UUID
+	AnswerOptions []string
 }
 
 func (q *Queries) AddQuestionTranslation(ctx context.Context, arg AddQuestionTranslationParams) (QuestionsI18n, error) {
@@ -259,6 +379,7 @@ func (q *Queries) AddQuestionTranslation(ctx context.Context, arg AddQuestionTra
 		arg.Question,
 		arg.Locale,
 		arg.QuestionID,
+		arg.AnswerOptions,
 	)
 	var i QuestionsI18n
 	err := row.Scan(
@@ -268,6 +389,7 @@ func (q *Queries) AddQuestionTranslation(ctx context.Context, arg AddQuestionTra
 		&i.Question,
 		&i.Locale,
 		&i.QuestionID,
+		&i.AnswerOptions,
 	)
 	return i, err
 }
@@ -275,7 +397,7 @@ func (q *Queries) AddQuestionTranslation(ctx context.Context, arg AddQuestionTra
 const addRoom = `-- name: AddRoom :one
 INSERT INTO rooms (id, game_name, host_player, room_code, room_state) VALUES (
     $1, $2, $3, $4, $5
-) RETURNING id, created_at, updated_at, game_name, host_player, room_state, room_code
+) RETURNING id, created_at, updated_at, game_name, host_player, room_state, room_code, series_id
 `
 
 type AddRoomParams struct {
@@ -303,6 +425,7 @@ func (q *Queries) AddRoom(ctx context.Context, arg AddRoomParams) (Room, error)
 		&i.HostPlayer,
 		&i.RoomState,
 		&i.RoomCode,
+		&i.SeriesID,
 	)
 	return i, err
 }
@@ -328,6 +451,51 @@ func (q *Queries) AddRoomPlayer(ctx context.Context, arg AddRoomPlayerParams) (R
 	return i, err
 }
 
+const archiveGroup = `-- name: ArchiveGroup :exec
+UPDATE questions_groups
+SET archived_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) ArchiveGroup(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, archiveGroup, id)
+	return err
+}
+
+const archiveQuestion = `-- name: ArchiveQuestion :exec
+UPDATE questions
+SET
+    enabled = FALSE,
+    archived_at = COALESCE(archived_at, CURRENT_TIMESTAMP),
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) ArchiveQuestion(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, archiveQuestion, id)
+	return err
+}
+
+const continueSeries = `-- name: ContinueSeries :exec
+UPDATE rooms
+SET series_id = (
+    SELECT gs.series_id
+    FROM game_state AS gs
+    WHERE gs.room_id = $2 AND gs.series_game < gs.series_games
+)
+WHERE id = $1
+`
+
+type ContinueSeriesParams struct {
+	ID     uuid.UUID
+	RoomID uuid.UUID
+}
+
+func (q *Queries) ContinueSeries(ctx context.Context, arg ContinueSeriesParams) error {
+	_, err := q.db.Exec(ctx, continueSeries, arg.ID, arg.RoomID)
+	return err
+}
+
 const countTotalRoundsByGameStateID = `-- name: CountTotalRoundsByGameStateID :one
 SELECT COUNT(*) AS total_rounds
 FROM fibbing_it_rounds
@@ -341,9 +509,147 @@ func (q *Queries) CountTotalRoundsByGameStateID(ctx context.Context, gameStateID
 	return total_rounds, err
 }
 
+const countVotesByPlayerIDAndRoundID = `-- name: CountVotesByPlayerIDAndRoundID :one
+SELECT COUNT(*)
+FROM fibbing_it_votes
+WHERE player_id = $1 AND round_id = $2
+`
+
+type CountVotesByPlayerIDAndRoundIDParams struct {
+	PlayerID uuid.UUID
+	RoundID  uuid.UUID
+}
+
+func (q *Queries) CountVotesByPlayerIDAndRoundID(ctx context.Context, arg CountVotesByPlayerIDAndRoundIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countVotesByPlayerIDAndRoundID, arg.PlayerID, arg.RoundID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteFibbingItVotesByRoundID = `-- name: DeleteFibbingItVotesByRoundID :exec
+DELETE FROM fibbing_it_votes
+WHERE round_id = $1
+`
+
+func (q *Queries) DeleteFibbingItVotesByRoundID(ctx context.Context, roundID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteFibbingItVotesByRoundID, roundID)
+	return err
+}
+
+const deleteGroup = `-- name: DeleteGroup :exec
+DELETE FROM questions_groups
+WHERE id = $1
+`
+
+func (q *Queries) DeleteGroup(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteGroup, id)
+	return err
+}
+
+const deleteOldestFibbingItAudienceVotes = `-- name: DeleteOldestFibbingItAudienceVotes :exec
+DELETE FROM fibbing_it_audience_votes
+WHERE id IN (
+    SELECT av.id
+    FROM fibbing_it_audience_votes av
+    WHERE av.audience_member_id = $1 AND av.round_id = $2
+    ORDER BY av.updated_at DESC
+    OFFSET $3
+)
+`
+
+type DeleteOldestFibbingItAudienceVotesParams struct {
+	AudienceMemberID uuid.UUID
+	RoundID          uuid.UUID
+	Offset           int32
+}
+
+func (q *Queries) DeleteOldestFibbingItAudienceVotes(ctx context.Context, arg DeleteOldestFibbingItAudienceVotesParams) error {
+	_, err := q.db.Exec(ctx, deleteOldestFibbingItAudienceVotes, arg.AudienceMemberID, arg.RoundID, arg.Offset)
+	return err
+}
+
+const deleteOldestFibbingItVotes = `-- name: DeleteOldestFibbingItVotes :exec
+DELETE FROM fibbing_it_votes
+WHERE id IN (
+    SELECT fv.id
+    FROM fibbing_it_votes fv
+    WHERE fv.player_id = $1 AND fv.round_id = $2
+    ORDER BY fv.updated_at DESC
+    OFFSET $3
+)
+`
+
+type DeleteOldestFibbingItVotesParams struct {
+	PlayerID uuid.UUID
+	RoundID  uuid.UUID
+	Offset   int32
+}
+
+func (q *Queries) DeleteOldestFibbingItVotes(ctx context.Context, arg DeleteOldestFibbingItVotesParams) error {
+	_, err := q.db.Exec(ctx, deleteOldestFibbingItVotes, arg.PlayerID, arg.RoundID, arg.Offset)
+	return err
+}
+
+const deleteQuestion = `-- name: DeleteQuestion :exec
+DELETE FROM questions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteQuestion(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteQuestion, id)
+	return err
+}
+
+const deleteQuestionPair = `-- name: DeleteQuestionPair :execrows
+DELETE FROM question_pairs
+WHERE normal_question_id = $1 AND fibber_question_id = $2
+`
+
+type DeleteQuestionPairParams struct {
+	NormalQuestionID uuid.UUID
+	FibberQuestionID uuid.UUID
+}
+
+func (q *Queries) DeleteQuestionPair(ctx context.Context, arg DeleteQuestionPairParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteQuestionPair, arg.NormalQuestionID, arg.FibberQuestionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteQuestionTranslation = `-- name: DeleteQuestionTranslation :execrows
+DELETE FROM questions_i18n
+WHERE question_id = $1 AND locale = $2
+`
+
+type DeleteQuestionTranslationParams struct {
+	QuestionID uuid.UUID
+	Locale     string
+}
+
+func (q *Queries) DeleteQuestionTranslation(ctx context.Context, arg DeleteQuestionTranslationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteQuestionTranslation, arg.QuestionID, arg.Locale)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteQuestionTranslations = `-- name: DeleteQuestionTranslations :exec
+DELETE FROM questions_i18n
+WHERE question_id = $1
+`
+
+func (q *Queries) DeleteQuestionTranslations(ctx context.Context, questionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteQuestionTranslations, questionID)
+	return err
+}
+
 const disableQuestion = `-- name: DisableQuestion :one
 UPDATE questions SET enabled = FALSE
-WHERE id = $1 RETURNING id, created_at, updated_at, game_name, round_type, enabled, group_id
+WHERE id = $1 RETURNING id, created_at, updated_at, game_name, round_type, enabled, group_id, archived_at
 `
 
 func (q *Queries) DisableQuestion(ctx context.Context, id uuid.UUID) (Question, error) {
@@ -357,13 +663,14 @@ func (q *Queries) DisableQuestion(ctx context.Context, id uuid.UUID) (Question,
 		&i.RoundType,
 		&i.Enabled,
 		&i.GroupID,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
 
 const enableQuestion = `-- name: EnableQuestion :one
 UPDATE questions SET enabled = TRUE
-WHERE id = $1 RETURNING id, created_at, updated_at, game_name, round_type, enabled, group_id
+WHERE id = $1 AND archived_at IS NULL RETURNING id, created_at, updated_at, game_name, round_type, enabled, group_id, archived_at
 `
 
 func (q *Queries) EnableQuestion(ctx context.Context, id uuid.UUID) (Question, error) {
@@ -377,6 +684,7 @@ func (q *Queries) EnableQuestion(ctx context.Context, id uuid.UUID) (Question, e
 		&i.RoundType,
 		&i.Enabled,
 		&i.GroupID,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -413,6 +721,94 @@ func (q *Queries) ForceResumeExpiredPauses(ctx context.Context) ([]uuid.UUID, er
 	return items, nil
 }
 
+const getAccountBySubject = `-- name: GetAccountBySubject :one
+SELECT id, created_at, updated_at, subject, nickname, avatar, locale FROM accounts WHERE subject = $1
+`
+
+func (q *Queries) GetAccountBySubject(ctx context.Context, subject string) (Account, error) {
+	row := q.db.QueryRow(ctx, getAccountBySubject, subject)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Subject,
+		&i.Nickname,
+		&i.Avatar,
+		&i.Locale,
+	)
+	return i, err
+}
+
+const getAccountStats = `-- name: GetAccountStats :one
+WITH account_games AS (
+    SELECT DISTINCT
+        fr.game_state_id,
+        fpr.player_id
+    FROM fibbing_it_player_roles AS fpr
+    JOIN fibbing_it_rounds AS fr ON fpr.round_id = fr.id
+    JOIN players AS p ON fpr.player_id = p.id
+    WHERE p.account_id = $1
+),
+
+game_scores AS (
+    SELECT
+        fr.game_state_id,
+        s.player_id,
+        SUM(s.score) AS total_score
+    FROM fibbing_it_scores AS s
+    JOIN fibbing_it_rounds AS fr ON s.round_id = fr.id
+    WHERE fr.game_state_id IN (SELECT game_state_id FROM account_games)
+    GROUP BY fr.game_state_id, s.player_id
+),
+
+fibber_rounds AS (
+    SELECT fpr.player_id::TEXT = ANY(fr.revealed_players) AS revealed
+    FROM fibbing_it_player_roles AS fpr
+    JOIN fibbing_it_rounds AS fr ON fpr.round_id = fr.id
+    JOIN players AS p ON fpr.player_id = p.id
+    WHERE p.account_id = $1 AND fpr.player_role = 'fibber'
+)
+
+SELECT
+    (SELECT COUNT(DISTINCT game_state_id) FROM account_games) AS games_played,
+    (
+        SELECT COUNT(*)
+        FROM account_games AS ag
+        JOIN game_state AS gs ON ag.game_state_id = gs.id
+        JOIN game_scores AS gsc
+            ON ag.game_state_id = gsc.game_state_id AND ag.player_id = gsc.player_id
+        WHERE
+            gs.state = 'FibbingItWinner'
+            AND gsc.total_score = (
+                SELECT MAX(best.total_score) FROM game_scores AS best
+                WHERE best.game_state_id = ag.game_state_id
+            )
+    ) AS wins,
+    (SELECT COUNT(*) FROM fibber_rounds) AS fibber_rounds,
+    -- INFO: A fibber got away with it if the group didn't reveal them at the end of the round.
+    (SELECT COUNT(*) FROM fibber_rounds WHERE NOT revealed) AS fibber_successes
+`
+
+type GetAccountStatsRow struct {
+	GamesPlayed     int64
+	Wins            int64
+	FibberRounds    int64
+	FibberSuccesses int64
+}
+
+func (q *Queries) GetAccountStats(ctx context.Context, accountID uuid.NullUUID) (GetAccountStatsRow, error) {
+	row := q.db.QueryRow(ctx, getAccountStats, accountID)
+	var i GetAccountStatsRow
+	err := row.Scan(
+		&i.GamesPlayed,
+		&i.Wins,
+		&i.FibberRounds,
+		&i.FibberSuccesses,
+	)
+	return i, err
+}
+
 const getActiveGames = `-- name: GetActiveGames :many
 SELECT DISTINCT
     gs.id AS game_state_id,
@@ -478,11 +874,12 @@ WITH latest_round AS (
     LIMIT 1
 )
 
-SELECT COUNT(rp.*) = SUM(CASE WHEN COALESCE(fa.is_ready, FALSE) THEN 1 ELSE 0 END) AS all_players_ready
+SELECT COUNT(rp.*) = COALESCE(SUM(CASE WHEN COALESCE(fa.is_ready, FALSE) THEN 1 ELSE 0 END), 0) AS all_players_ready
 FROM rooms_players rp
 CROSS JOIN latest_round lr
+JOIN players p ON p.id = rp.player_id
 LEFT JOIN fibbing_it_answers fa ON fa.player_id = rp.player_id AND fa.round_id = lr.round_id
-WHERE rp.room_id = lr.room_id
+WHERE rp.room_id = lr.room_id AND p.inactive_at IS NULL
 `
 
 func (q *Queries) GetAllPlayerAnswerIsReady(ctx context.Context, id uuid.UUID) (bool, error) {
@@ -505,11 +902,12 @@ WITH latest_round AS (
     LIMIT 1
 )
 
-SELECT COUNT(rp.*) = SUM(CASE WHEN COALESCE(fa.is_ready, FALSE) THEN 1 ELSE 0 END) AS all_players_ready
+SELECT COUNT(rp.*) = COALESCE(SUM(CASE WHEN COALESCE(fa.is_ready, FALSE) THEN 1 ELSE 0 END), 0) AS all_players_ready
 FROM rooms_players rp
 CROSS JOIN latest_round lr
+JOIN players p ON p.id = rp.player_id
 LEFT JOIN fibbing_it_answers fa ON fa.player_id = rp.player_id AND fa.round_id = lr.round_id
-WHERE rp.room_id = lr.room_id
+WHERE rp.room_id = lr.room_id AND p.inactive_at IS NULL
 `
 
 func (q *Queries) GetAllPlayerAnswerIsReadyByPlayerID(ctx context.Context, playerID uuid.UUID) (bool, error) {
@@ -590,7 +988,8 @@ SELECT
     p.id,
     p.nickname,
     p.avatar,
-    p.locale
+    p.locale,
+    p.inactive_at
 FROM players AS p
 JOIN rooms_players AS rp ON p.id = rp.player_id
 JOIN game_state AS gs ON rp.room_id = gs.room_id
@@ -599,10 +998,11 @@ ORDER BY p.created_at
 `
 
 type GetAllPlayersByGameStateIDRow struct {
-	ID       uuid.UUID
-	Nickname string
-	Avatar   string
-	Locale   pgtype.Text
+	ID         uuid.UUID
+	Nickname   string
+	Avatar     string
+	Locale     pgtype.Text
+	InactiveAt pgtype.Timestamp
 }
 
 func (q *Queries) GetAllPlayersByGameStateID(ctx context.Context, id uuid.UUID) ([]GetAllPlayersByGameStateIDRow, error) {
@@ -619,6 +1019,7 @@ func (q *Queries) GetAllPlayersByGameStateID(ctx context.Context, id uuid.UUID)
 			&i.Nickname,
 			&i.Avatar,
 			&i.Locale,
+			&i.InactiveAt,
 		); err != nil {
 			return nil, err
 		}
@@ -637,6 +1038,8 @@ SELECT
     p.avatar,
     p.locale,
     p.is_ready,
+    p.disconnected_at,
+    p.inactive_at,
     r.host_player,
     r.room_code
 FROM players AS p
@@ -648,13 +1051,15 @@ ORDER BY p.created_at ASC
 `
 
 type GetAllPlayersInRoomRow struct {
-	ID         uuid.UUID
-	Nickname   string
-	Avatar     string
-	Locale     pgtype.Text
-	IsReady    pgtype.Bool
-	HostPlayer uuid.UUID
-	RoomCode   string
+	ID             uuid.UUID
+	Nickname       string
+	Avatar         string
+	Locale         pgtype.Text
+	IsReady        pgtype.Bool
+	DisconnectedAt pgtype.Timestamp
+	InactiveAt     pgtype.Timestamp
+	HostPlayer     uuid.UUID
+	RoomCode       string
 }
 
 func (q *Queries) GetAllPlayersInRoom(ctx context.Context, playerID uuid.UUID) ([]GetAllPlayersInRoomRow, error) {
@@ -672,6 +1077,8 @@ func (q *Queries) GetAllPlayersInRoom(ctx context.Context, playerID uuid.UUID) (
 			&i.Avatar,
 			&i.Locale,
 			&i.IsReady,
+			&i.DisconnectedAt,
+			&i.InactiveAt,
 			&i.HostPlayer,
 			&i.RoomCode,
 		); err != nil {
@@ -700,7 +1107,8 @@ SELECT
     COALESCE(fia.answer, '') AS current_answer,
     COALESCE(fia.is_ready, FALSE) AS is_answer_ready,
     fr.normal_question_id,
-    fr.fibber_question_id
+    fr.fibber_question_id,
+    p.inactive_at
 FROM game_state AS gs
 JOIN fibbing_it_rounds AS fr
     ON
@@ -738,6 +1146,7 @@ type GetAllPlayersQuestionStateByGameStateIDRow struct {
 	IsAnswerReady    bool
 	NormalQuestionID uuid.UUID
 	FibberQuestionID uuid.UUID
+	InactiveAt       pgtype.Timestamp
 }
 
 func (q *Queries) GetAllPlayersQuestionStateByGameStateID(ctx context.Context, id uuid.UUID) ([]GetAllPlayersQuestionStateByGameStateIDRow, error) {
@@ -764,6 +1173,7 @@ func (q *Queries) GetAllPlayersQuestionStateByGameStateID(ctx context.Context, i
 			&i.IsAnswerReady,
 			&i.NormalQuestionID,
 			&i.FibberQuestionID,
+			&i.InactiveAt,
 		); err != nil {
 			return nil, err
 		}
@@ -787,11 +1197,15 @@ WITH latest_round AS (
     LIMIT 1
 )
 
-SELECT COUNT(rp.*) = SUM(CASE WHEN COALESCE(fv.is_ready, FALSE) THEN 1 ELSE 0 END) AS all_players_ready
+SELECT
+    COUNT(DISTINCT rp.player_id)
+    = COUNT(DISTINCT fv.player_id) FILTER (WHERE fv.is_ready)
+        AS all_players_ready
 FROM rooms_players rp
 CROSS JOIN latest_round lr
+JOIN players p ON p.id = rp.player_id
 LEFT JOIN fibbing_it_votes fv ON fv.player_id = rp.player_id AND fv.round_id = lr.round_id
-WHERE rp.room_id = lr.room_id
+WHERE rp.room_id = lr.room_id AND p.inactive_at IS NULL
 `
 
 func (q *Queries) GetAllPlayersVotingIsReady(ctx context.Context, id uuid.UUID) (bool, error) {
@@ -803,10 +1217,11 @@ func (q *Queries) GetAllPlayersVotingIsReady(ctx context.Context, id uuid.UUID)
 
 const getAllPlayersVotingIsReadyByPlayerID = `-- name: GetAllPlayersVotingIsReadyByPlayerID :one
 SELECT
-    COUNT(*)
-    = SUM(CASE WHEN COALESCE(fa.is_ready, FALSE) THEN 1 ELSE 0 END)
+    COUNT(DISTINCT rp.player_id)
+    = COUNT(DISTINCT fa.player_id) FILTER (WHERE fa.is_ready)
         AS all_players_ready
 FROM rooms_players rp
+JOIN players p ON p.id = rp.player_id
 LEFT JOIN fibbing_it_votes fa
     ON fa.player_id = rp.player_id AND fa.round_id = (
         SELECT fir.id
@@ -823,6 +1238,7 @@ WHERE rp.room_id = (
     WHERE rp.player_id = $1
     LIMIT 1
 )
+AND p.inactive_at IS NULL
 `
 
 func (q *Queries) GetAllPlayersVotingIsReadyByPlayerID(ctx context.Context, playerID uuid.UUID) (bool, error) {
@@ -847,15 +1263,18 @@ SELECT
     p1.avatar AS voter_avatar,
     v.voted_for_player_id AS voted_for_id,
     p2.nickname AS voted_for_nickname,
-    r.player_id AS fibber_id,
-    p3.nickname AS fibber_nickname,
-    v.round_id
+    COALESCE(voter_role.player_role = 'fibber', FALSE) AS voter_is_fibber,
+    COALESCE(voted_for_role.player_role = 'fibber', FALSE) AS voted_for_fibber,
+    v.round_id,
+    v.updated_at AS voted_at
 FROM fibbing_it_votes v
 JOIN players p1 ON v.player_id = p1.id
 JOIN players p2 ON v.voted_for_player_id = p2.id
 JOIN fibbing_it_rounds fr ON v.round_id = fr.id
-JOIN fibbing_it_player_roles r ON fr.id = r.round_id AND r.player_role = 'fibber'
-JOIN players p3 ON r.player_id = p3.id
+LEFT JOIN fibbing_it_player_roles voter_role
+    ON fr.id = voter_role.round_id AND v.player_id = voter_role.player_id
+LEFT JOIN fibbing_it_player_roles voted_for_role
+    ON fr.id = voted_for_role.round_id AND v.voted_for_player_id = voted_for_role.player_id
 CROSS JOIN latest_round_type lrt
 WHERE fr.game_state_id = $1 AND fr.round_type = lrt.round_type
 ORDER BY v.round_id DESC
@@ -867,9 +1286,10 @@ type GetAllVotesForRoundByGameStateIDRow struct {
 	VoterAvatar      string
 	VotedForID       uuid.UUID
 	VotedForNickname string
-	FibberID         uuid.UUID
-	FibberNickname   string
+	VoterIsFibber    bool
+	VotedForFibber   bool
 	RoundID          uuid.UUID
+	VotedAt          pgtype.Timestamp
 }
 
 func (q *Queries) GetAllVotesForRoundByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]GetAllVotesForRoundByGameStateIDRow, error) {
@@ -887,9 +1307,10 @@ func (q *Queries) GetAllVotesForRoundByGameStateID(ctx context.Context, gameStat
 			&i.VoterAvatar,
 			&i.VotedForID,
 			&i.VotedForNickname,
-			&i.FibberID,
-			&i.FibberNickname,
+			&i.VoterIsFibber,
+			&i.VotedForFibber,
 			&i.RoundID,
+			&i.VotedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -901,93 +1322,349 @@ func (q *Queries) GetAllVotesForRoundByGameStateID(ctx context.Context, gameStat
 	return items, nil
 }
 
-const getCurrentQuestionByPlayerID = `-- name: GetCurrentQuestionByPlayerID :one
+const getAnswerOptionsByPlayerID = `-- name: GetAnswerOptionsByPlayerID :many
+WITH latest_round AS (
+    SELECT
+        fr.id,
+        fr.normal_question_id
+    FROM fibbing_it_rounds AS fr
+    JOIN game_state AS gs ON fr.game_state_id = gs.id
+    JOIN rooms_players AS rp ON gs.room_id = rp.room_id
+    WHERE rp.player_id = $1
+    ORDER BY fr.created_at DESC
+    LIMIT 1
+)
 SELECT
-    gs.id AS game_state_id,
-    fr.round,
-    fr.round_type,
-    r.room_code,
-    gs.submit_deadline,
-    p.id AS player_id,
-    p.nickname,
-    fpr.player_role AS role,
-    qi.question AS question,
-    p.avatar,
-    COALESCE(fia.answer, '') AS current_answer,
-    COALESCE(fia.is_ready, FALSE) AS is_answer_ready
-FROM players AS p
-JOIN rooms_players AS rp ON p.id = rp.player_id
-JOIN rooms AS r ON rp.room_id = r.id
-JOIN game_state AS gs ON gs.room_id = r.id
-JOIN fibbing_it_rounds AS fr ON fr.game_state_id = gs.id
-LEFT JOIN questions AS q ON fr.normal_question_id = q.id
-LEFT JOIN questions_i18n AS qi ON q.id = qi.question_id AND qi.locale = 'en-GB'
-LEFT JOIN
-    fibbing_it_player_roles AS fpr
-    ON p.id = fpr.player_id AND fr.id = fpr.round_id
-LEFT JOIN
-    fibbing_it_answers AS fia
-    ON p.id = fia.player_id AND fr.id = fia.round_id
-WHERE p.id = $1
-ORDER BY fr.round DESC
-LIMIT 1
+    qi.locale,
+    qi.answer_options,
+    p.locale AS player_locale
+FROM latest_round AS lr
+JOIN players AS p ON p.id = $1
+JOIN questions_i18n AS qi ON lr.normal_question_id = qi.question_id
 `
 
-type GetCurrentQuestionByPlayerIDRow struct {
-	GameStateID    uuid.UUID
-	Round          int32
-	RoundType      string
-	RoomCode       string
-	SubmitDeadline pgtype.Timestamp
-	PlayerID       uuid.UUID
-	Nickname       string
-	Role           pgtype.Text
-	Question       pgtype.Text
-	Avatar         string
-	CurrentAnswer  string
-	IsAnswerReady  bool
-}
-
-func (q *Queries) GetCurrentQuestionByPlayerID(ctx context.Context, id uuid.UUID) (GetCurrentQuestionByPlayerIDRow, error) {
-	row := q.db.QueryRow(ctx, getCurrentQuestionByPlayerID, id)
-	var i GetCurrentQuestionByPlayerIDRow
-	err := row.Scan(
-		&i.GameStateID,
-		&i.Round,
-		&i.RoundType,
-		&i.RoomCode,
-		&i.SubmitDeadline,
-		&i.PlayerID,
-		&i.Nickname,
-		&i.Role,
-		&i.Question,
-		&i.Avatar,
-		&i.CurrentAnswer,
-		&i.IsAnswerReady,
-	)
-	return i, err
+type GetAnswerOptionsByPlayerIDRow struct {
+	Locale        string
+	AnswerOptions []string
+	PlayerLocale  pgtype.Text
 }
 
-const getFibberByRoundID = `-- name: GetFibberByRoundID :one
-SELECT id, created_at, updated_at, player_role, round_id, player_id
-FROM fibbing_it_player_roles
-WHERE round_id = $1 AND player_role = 'fibber'
-`
-
-func (q *Queries) GetFibberByRoundID(ctx context.Context, roundID uuid.UUID) (FibbingItPlayerRole, error) {
-	row := q.db.QueryRow(ctx, getFibberByRoundID, roundID)
-	var i FibbingItPlayerRole
-	err := row.Scan(
-		&i.ID,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.PlayerRole,
-		&i.RoundID,
+func (q *Queries) GetAnswerOptionsByPlayerID(ctx context.Context, playerID uuid.UUID) ([]GetAnswerOptionsByPlayerIDRow, error) {
+	rows, err := q.db.Query(ctx, getAnswerOptionsByPlayerID, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAnswerOptionsByPlayerIDRow
+	for rows.Next() {
+		var i GetAnswerOptionsByPlayerIDRow
+		if err := rows.Scan(
+			&i.Locale,
+			&i.AnswerOptions,
+			&i.PlayerLocale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAudienceByGameStateID = `-- name: GetAudienceByGameStateID :many
+SELECT am.id, am.created_at, am.updated_at, am.room_id, am.nickname, am.avatar, am.locale
+FROM audience_members AS am
+JOIN game_state AS gs ON am.room_id = gs.room_id
+WHERE gs.id = $1
+ORDER BY am.created_at
+`
+
+func (q *Queries) GetAudienceByGameStateID(ctx context.Context, id uuid.UUID) ([]AudienceMember, error) {
+	rows, err := q.db.Query(ctx, getAudienceByGameStateID, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AudienceMember
+	for rows.Next() {
+		var i AudienceMember
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RoomID,
+			&i.Nickname,
+			&i.Avatar,
+			&i.Locale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAudienceMemberByID = `-- name: GetAudienceMemberByID :one
+SELECT
+    am.id,
+    am.nickname,
+    am.avatar,
+    am.locale,
+    r.room_code,
+    r.room_state,
+    r.host_player
+FROM audience_members AS am
+JOIN rooms AS r ON am.room_id = r.id
+WHERE am.id = $1
+`
+
+type GetAudienceMemberByIDRow struct {
+	ID         uuid.UUID
+	Nickname   string
+	Avatar     string
+	Locale     pgtype.Text
+	RoomCode   string
+	RoomState  string
+	HostPlayer uuid.UUID
+}
+
+func (q *Queries) GetAudienceMemberByID(ctx context.Context, id uuid.UUID) (GetAudienceMemberByIDRow, error) {
+	row := q.db.QueryRow(ctx, getAudienceMemberByID, id)
+	var i GetAudienceMemberByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Nickname,
+		&i.Avatar,
+		&i.Locale,
+		&i.RoomCode,
+		&i.RoomState,
+		&i.HostPlayer,
+	)
+	return i, err
+}
+
+const getAudienceVotesByRoundID = `-- name: GetAudienceVotesByRoundID :many
+SELECT
+    p.nickname,
+    COUNT(av.id) AS votes
+FROM fibbing_it_audience_votes AS av
+JOIN players AS p ON av.voted_for_player_id = p.id
+WHERE av.round_id = $1
+GROUP BY p.nickname
+ORDER BY votes DESC, p.nickname
+`
+
+type GetAudienceVotesByRoundIDRow struct {
+	Nickname string
+	Votes    int64
+}
+
+func (q *Queries) GetAudienceVotesByRoundID(ctx context.Context, roundID uuid.UUID) ([]GetAudienceVotesByRoundIDRow, error) {
+	rows, err := q.db.Query(ctx, getAudienceVotesByRoundID, roundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAudienceVotesByRoundIDRow
+	for rows.Next() {
+		var i GetAudienceVotesByRoundIDRow
+		if err := rows.Scan(
+			&i.Nickname,
+			&i.Votes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCurrentQuestionByPlayerID = `-- name: GetCurrentQuestionByPlayerID :one
+SELECT
+    gs.id AS game_state_id,
+    fr.round,
+    fr.round_type,
+    r.room_code,
+    gs.submit_deadline,
+    p.id AS player_id,
+    p.nickname,
+    fpr.player_role AS role,
+    qi.question AS question,
+    p.avatar,
+    COALESCE(fia.answer, '') AS current_answer,
+    COALESCE(fia.is_ready, FALSE) AS is_answer_ready
+FROM players AS p
+JOIN rooms_players AS rp ON p.id = rp.player_id
+JOIN rooms AS r ON rp.room_id = r.id
+JOIN game_state AS gs ON gs.room_id = r.id
+JOIN fibbing_it_rounds AS fr ON fr.game_state_id = gs.id
+LEFT JOIN questions AS q ON fr.normal_question_id = q.id
+LEFT JOIN questions_i18n AS qi ON q.id = qi.question_id AND qi.locale = 'en-GB'
+LEFT JOIN
+    fibbing_it_player_roles AS fpr
+    ON p.id = fpr.player_id AND fr.id = fpr.round_id
+LEFT JOIN
+    fibbing_it_answers AS fia
+    ON p.id = fia.player_id AND fr.id = fia.round_id
+WHERE p.id = $1
+ORDER BY fr.round DESC
+LIMIT 1
+`
+
+type GetCurrentQuestionByPlayerIDRow struct {
+	GameStateID    uuid.UUID
+	Round          int32
+	RoundType      string
+	RoomCode       string
+	SubmitDeadline pgtype.Timestamp
+	PlayerID       uuid.UUID
+	Nickname       string
+	Role           pgtype.Text
+	Question       pgtype.Text
+	Avatar         string
+	CurrentAnswer  string
+	IsAnswerReady  bool
+}
+
+func (q *Queries) GetCurrentQuestionByPlayerID(ctx context.Context, id uuid.UUID) (GetCurrentQuestionByPlayerIDRow, error) {
+	row := q.db.QueryRow(ctx, getCurrentQuestionByPlayerID, id)
+	var i GetCurrentQuestionByPlayerIDRow
+	err := row.Scan(
+		&i.GameStateID,
+		&i.Round,
+		&i.RoundType,
+		&i.RoomCode,
+		&i.SubmitDeadline,
 		&i.PlayerID,
+		&i.Nickname,
+		&i.Role,
+		&i.Question,
+		&i.Avatar,
+		&i.CurrentAnswer,
+		&i.IsAnswerReady,
 	)
 	return i, err
 }
 
+const getDuplicateQuestionTranslations = `-- name: GetDuplicateQuestionTranslations :many
+SELECT
+    qi.question,
+    qi.locale
+FROM questions_i18n AS qi
+JOIN UNNEST($1::TEXT [], $2::TEXT []) AS t (question, locale)
+    ON qi.question = t.question AND qi.locale = t.locale
+`
+
+type GetDuplicateQuestionTranslationsParams struct {
+	Questions []string
+	Locales   []string
+}
+
+type GetDuplicateQuestionTranslationsRow struct {
+	Question string
+	Locale   string
+}
+
+func (q *Queries) GetDuplicateQuestionTranslations(ctx context.Context, arg GetDuplicateQuestionTranslationsParams) ([]GetDuplicateQuestionTranslationsRow, error) {
+	rows, err := q.db.Query(ctx, getDuplicateQuestionTranslations, arg.Questions, arg.Locales)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDuplicateQuestionTranslationsRow
+	for rows.Next() {
+		var i GetDuplicateQuestionTranslationsRow
+		if err := rows.Scan(&i.Question, &i.Locale); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFibberCountsByGameStateID = `-- name: GetFibberCountsByGameStateID :many
+SELECT
+    fpr.player_id,
+    COUNT(*) AS fibber_count
+FROM fibbing_it_player_roles AS fpr
+JOIN fibbing_it_rounds AS fr ON fpr.round_id = fr.id
+WHERE fr.game_state_id = $1 AND fpr.player_role = 'fibber'
+GROUP BY fpr.player_id
+`
+
+type GetFibberCountsByGameStateIDRow struct {
+	PlayerID    uuid.UUID
+	FibberCount int64
+}
+
+func (q *Queries) GetFibberCountsByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]GetFibberCountsByGameStateIDRow, error) {
+	rows, err := q.db.Query(ctx, getFibberCountsByGameStateID, gameStateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFibberCountsByGameStateIDRow
+	for rows.Next() {
+		var i GetFibberCountsByGameStateIDRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.FibberCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFibbersByRoundID = `-- name: GetFibbersByRoundID :many
+SELECT id, created_at, updated_at, player_role, round_id, player_id
+FROM fibbing_it_player_roles
+WHERE round_id = $1 AND player_role = 'fibber'
+ORDER BY created_at ASC
+`
+
+func (q *Queries) GetFibbersByRoundID(ctx context.Context, roundID uuid.UUID) ([]FibbingItPlayerRole, error) {
+	rows, err := q.db.Query(ctx, getFibbersByRoundID, roundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FibbingItPlayerRole
+	for rows.Next() {
+		var i FibbingItPlayerRole
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PlayerRole,
+			&i.RoundID,
+			&i.PlayerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getGameState = `-- name: GetGameState :one
 SELECT
     gs.id,
@@ -998,7 +1675,25 @@ SELECT
     gs.state,
     gs.pause_time_remaining_ms,
     gs.paused_at,
-    gs.pause_deadline
+    gs.pause_deadline,
+    gs.max_rounds,
+    gs.round_types,
+    gs.question_seconds,
+    gs.voting_seconds,
+    gs.reveal_seconds,
+    gs.score_seconds,
+    gs.scorers,
+    gs.reveal_rule,
+    gs.tie_break,
+    gs.fibbers,
+    gs.fibber_selection,
+    gs.fibber_rotation,
+    gs.series_id,
+    gs.series_game,
+    gs.series_games,
+    gs.recent_games,
+    gs.revote_seconds,
+    gs.winner_seconds
 FROM game_state gs
 WHERE gs.id = $1
 `
@@ -1016,6 +1711,24 @@ func (q *Queries) GetGameState(ctx context.Context, id uuid.UUID) (GameState, er
 		&i.PauseTimeRemainingMs,
 		&i.PausedAt,
 		&i.PauseDeadline,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.QuestionSeconds,
+		&i.VotingSeconds,
+		&i.RevealSeconds,
+		&i.ScoreSeconds,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
+		&i.SeriesID,
+		&i.SeriesGame,
+		&i.SeriesGames,
+		&i.RecentGames,
+		&i.RevoteSeconds,
+		&i.WinnerSeconds,
 	)
 	return i, err
 }
@@ -1030,7 +1743,25 @@ SELECT
     gs.state,
     gs.pause_time_remaining_ms,
     gs.paused_at,
-    gs.pause_deadline
+    gs.pause_deadline,
+    gs.max_rounds,
+    gs.round_types,
+    gs.question_seconds,
+    gs.voting_seconds,
+    gs.reveal_seconds,
+    gs.score_seconds,
+    gs.scorers,
+    gs.reveal_rule,
+    gs.tie_break,
+    gs.fibbers,
+    gs.fibber_selection,
+    gs.fibber_rotation,
+    gs.series_id,
+    gs.series_game,
+    gs.series_games,
+    gs.recent_games,
+    gs.revote_seconds,
+    gs.winner_seconds
 FROM game_state AS gs
 JOIN rooms_players AS rp ON gs.room_id = rp.room_id
 WHERE rp.player_id = $1
@@ -1049,6 +1780,24 @@ func (q *Queries) GetGameStateByPlayerID(ctx context.Context, playerID uuid.UUID
 		&i.PauseTimeRemainingMs,
 		&i.PausedAt,
 		&i.PauseDeadline,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.QuestionSeconds,
+		&i.VotingSeconds,
+		&i.RevealSeconds,
+		&i.ScoreSeconds,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
+		&i.SeriesID,
+		&i.SeriesGame,
+		&i.SeriesGames,
+		&i.RecentGames,
+		&i.RevoteSeconds,
+		&i.WinnerSeconds,
 	)
 	return i, err
 }
@@ -1089,12 +1838,33 @@ func (q *Queries) GetGameStateForUpdate(ctx context.Context, id uuid.UUID) (GetG
 	return i, err
 }
 
+const getGroupByID = `-- name: GetGroupByID :one
+SELECT id, created_at, updated_at, group_name, group_type, archived_at
+FROM questions_groups
+WHERE id = $1 AND archived_at IS NULL
+`
+
+func (q *Queries) GetGroupByID(ctx context.Context, id uuid.UUID) (QuestionsGroup, error) {
+	row := q.db.QueryRow(ctx, getGroupByID, id)
+	var i QuestionsGroup
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GroupName,
+		&i.GroupType,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
 const getGroupByName = `-- name: GetGroupByName :one
-SELECT id, created_at, updated_at, group_name, group_type
+SELECT id, created_at, updated_at, group_name, group_type, archived_at
 FROM
     questions_groups
 WHERE
     group_name = $1
+    AND archived_at IS NULL
 `
 
 func (q *Queries) GetGroupByName(ctx context.Context, groupName string) (QuestionsGroup, error) {
@@ -1106,14 +1876,17 @@ func (q *Queries) GetGroupByName(ctx context.Context, groupName string) (Questio
 		&i.UpdatedAt,
 		&i.GroupName,
 		&i.GroupType,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
 
 const getGroups = `-- name: GetGroups :many
-SELECT id, created_at, updated_at, group_name, group_type
+SELECT id, created_at, updated_at, group_name, group_type, archived_at
 FROM
     questions_groups
+WHERE
+    archived_at IS NULL
 ORDER BY group_name DESC
 `
 
@@ -1132,6 +1905,7 @@ func (q *Queries) GetGroups(ctx context.Context) ([]QuestionsGroup, error) {
 			&i.UpdatedAt,
 			&i.GroupName,
 			&i.GroupType,
+			&i.ArchivedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1143,12 +1917,54 @@ func (q *Queries) GetGroups(ctx context.Context) ([]QuestionsGroup, error) {
 	return items, nil
 }
 
+const getLatestGameInSeries = `-- name: GetLatestGameInSeries :one
+SELECT
+    gs.series_id,
+    gs.series_game,
+    gs.series_games
+FROM game_state AS gs
+WHERE gs.series_id = $1
+ORDER BY gs.series_game DESC
+LIMIT 1
+`
+
+type GetLatestGameInSeriesRow struct {
+	SeriesID    uuid.NullUUID
+	SeriesGame  int32
+	SeriesGames int32
+}
+
+func (q *Queries) GetLatestGameInSeries(ctx context.Context, seriesID uuid.NullUUID) (GetLatestGameInSeriesRow, error) {
+	row := q.db.QueryRow(ctx, getLatestGameInSeries, seriesID)
+	var i GetLatestGameInSeriesRow
+	err := row.Scan(
+		&i.SeriesID,
+		&i.SeriesGame,
+		&i.SeriesGames,
+	)
+	return i, err
+}
+
 const getLatestRoundByGameStateID = `-- name: GetLatestRoundByGameStateID :one
 SELECT
-    fir.id, fir.created_at, fir.updated_at, fir.round_type, fir.round, fir.fibber_question_id, fir.normal_question_id, fir.game_state_id,
-    gs.submit_deadline
+    fir.id, fir.created_at, fir.updated_at, fir.round_type, fir.round, fir.fibber_question_id, fir.normal_question_id, fir.game_state_id, fir.round_type_index, fir.revote_candidates, fir.revote_winners, fir.revealed_players,
+    gs.submit_deadline,
+    gs.max_rounds,
+    gs.round_types,
+    gs.scorers,
+    gs.reveal_rule,
+    gs.tie_break,
+    gs.fibbers,
+    gs.fibber_selection,
+    gs.fibber_rotation,
+    gs.series_id,
+    gs.series_game,
+    gs.series_games,
+    gs.recent_games,
+    r.game_name
 FROM fibbing_it_rounds AS fir
 JOIN game_state AS gs ON fir.game_state_id = gs.id
+JOIN rooms AS r ON gs.room_id = r.id
 WHERE gs.id = $1
 ORDER BY fir.created_at DESC
 LIMIT 1
@@ -1163,7 +1979,24 @@ type GetLatestRoundByGameStateIDRow struct {
 	FibberQuestionID uuid.UUID
 	NormalQuestionID uuid.UUID
 	GameStateID      uuid.UUID
+	RoundTypeIndex   int32
+	RevoteCandidates []string
+	RevoteWinners    []string
+	RevealedPlayers  []string
 	SubmitDeadline   pgtype.Timestamp
+	MaxRounds        int32
+	RoundTypes       []string
+	Scorers          []string
+	RevealRule       string
+	TieBreak         string
+	Fibbers          int32
+	FibberSelection  string
+	FibberRotation   string
+	SeriesID         uuid.NullUUID
+	SeriesGame       int32
+	SeriesGames      int32
+	RecentGames      int32
+	GameName         string
 }
 
 func (q *Queries) GetLatestRoundByGameStateID(ctx context.Context, id uuid.UUID) (GetLatestRoundByGameStateIDRow, error) {
@@ -1178,15 +2011,40 @@ func (q *Queries) GetLatestRoundByGameStateID(ctx context.Context, id uuid.UUID)
 		&i.FibberQuestionID,
 		&i.NormalQuestionID,
 		&i.GameStateID,
+		&i.RoundTypeIndex,
+		&i.RevoteCandidates,
+		&i.RevoteWinners,
+		&i.RevealedPlayers,
 		&i.SubmitDeadline,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
+		&i.SeriesID,
+		&i.SeriesGame,
+		&i.SeriesGames,
+		&i.RecentGames,
+		&i.GameName,
 	)
 	return i, err
 }
 
 const getLatestRoundByPlayerID = `-- name: GetLatestRoundByPlayerID :one
 SELECT
-    fir.id, fir.created_at, fir.updated_at, fir.round_type, fir.round, fir.fibber_question_id, fir.normal_question_id, fir.game_state_id,
-    gs.submit_deadline
+    fir.id, fir.created_at, fir.updated_at, fir.round_type, fir.round, fir.fibber_question_id, fir.normal_question_id, fir.game_state_id, fir.round_type_index, fir.revote_candidates, fir.revote_winners, fir.revealed_players,
+    gs.submit_deadline,
+    gs.max_rounds,
+    gs.round_types,
+    gs.scorers,
+    gs.reveal_rule,
+    gs.tie_break,
+    gs.fibbers,
+    gs.fibber_selection,
+    gs.fibber_rotation
 FROM fibbing_it_rounds AS fir
 JOIN game_state AS gs ON fir.game_state_id = gs.id
 JOIN rooms_players AS rp ON gs.room_id = rp.room_id
@@ -1204,7 +2062,19 @@ type GetLatestRoundByPlayerIDRow struct {
 	FibberQuestionID uuid.UUID
 	NormalQuestionID uuid.UUID
 	GameStateID      uuid.UUID
+	RoundTypeIndex   int32
+	RevoteCandidates []string
+	RevoteWinners    []string
+	RevealedPlayers  []string
 	SubmitDeadline   pgtype.Timestamp
+	MaxRounds        int32
+	RoundTypes       []string
+	Scorers          []string
+	RevealRule       string
+	TieBreak         string
+	Fibbers          int32
+	FibberSelection  string
+	FibberRotation   string
 }
 
 func (q *Queries) GetLatestRoundByPlayerID(ctx context.Context, playerID uuid.UUID) (GetLatestRoundByPlayerIDRow, error) {
@@ -1219,11 +2089,85 @@ func (q *Queries) GetLatestRoundByPlayerID(ctx context.Context, playerID uuid.UU
 		&i.FibberQuestionID,
 		&i.NormalQuestionID,
 		&i.GameStateID,
+		&i.RoundTypeIndex,
+		&i.RevoteCandidates,
+		&i.RevoteWinners,
+		&i.RevealedPlayers,
 		&i.SubmitDeadline,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
 	)
 	return i, err
 }
 
+const getLeaderboard = `-- name: GetLeaderboard :many
+SELECT
+    p.account_id,
+    COALESCE(NULLIF(MAX(a.nickname), ''), MAX(p.nickname))::TEXT AS nickname,
+    COALESCE(NULLIF(MAX(a.avatar), ''), MAX(p.avatar))::TEXT AS avatar,
+    SUM(s.score)::BIGINT AS total_score,
+    COUNT(DISTINCT fr.game_state_id) AS games_played
+FROM fibbing_it_scores AS s
+JOIN fibbing_it_rounds AS fr ON s.round_id = fr.id
+JOIN game_state AS gs ON fr.game_state_id = gs.id
+JOIN players AS p ON s.player_id = p.id
+LEFT JOIN accounts AS a ON p.account_id = a.id
+WHERE
+    gs.state = 'FibbingItWinner'
+    AND s.created_at >= $1
+    AND ($2::TEXT = '' OR fr.round_type = $2::TEXT)
+GROUP BY p.account_id, CASE WHEN p.account_id IS NULL THEN p.nickname ELSE '' END
+ORDER BY total_score DESC, nickname ASC
+LIMIT $3
+`
+
+type GetLeaderboardParams struct {
+	Since      pgtype.Timestamp
+	RoundType  string
+	MaxEntries int32
+}
+
+type GetLeaderboardRow struct {
+	AccountID   uuid.NullUUID
+	Nickname    string
+	Avatar      string
+	TotalScore  int64
+	GamesPlayed int64
+}
+
+// INFO: Players who are signed in are ranked by their account, anonymous players are ranked by their nickname.
+func (q *Queries) GetLeaderboard(ctx context.Context, arg GetLeaderboardParams) ([]GetLeaderboardRow, error) {
+	rows, err := q.db.Query(ctx, getLeaderboard, arg.Since, arg.RoundType, arg.MaxEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLeaderboardRow
+	for rows.Next() {
+		var i GetLeaderboardRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.Nickname,
+			&i.Avatar,
+			&i.TotalScore,
+			&i.GamesPlayed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPauseStatus = `-- name: GetPauseStatus :one
 SELECT
     id,
@@ -1260,7 +2204,7 @@ func (q *Queries) GetPauseStatus(ctx context.Context, id uuid.UUID) (GetPauseSta
 }
 
 const getPlayerByID = `-- name: GetPlayerByID :one
-SELECT id, created_at, updated_at, avatar, nickname, is_ready, locale FROM players
+SELECT id, created_at, updated_at, avatar, nickname, is_ready, locale, disconnected_at, inactive_at, account_id FROM players
 WHERE id = $1
 `
 
@@ -1275,13 +2219,153 @@ func (q *Queries) GetPlayerByID(ctx context.Context, id uuid.UUID) (Player, erro
 		&i.Nickname,
 		&i.IsReady,
 		&i.Locale,
+		&i.DisconnectedAt,
+		&i.InactiveAt,
+		&i.AccountID,
+	)
+	return i, err
+}
+
+const getQuestionByID = `-- name: GetQuestionByID :one
+SELECT id, created_at, updated_at, game_name, round_type, enabled, group_id, archived_at
+FROM questions
+WHERE id = $1 AND archived_at IS NULL
+`
+
+func (q *Queries) GetQuestionByID(ctx context.Context, id uuid.UUID) (Question, error) {
+	row := q.db.QueryRow(ctx, getQuestionByID, id)
+	var i Question
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GameName,
+		&i.RoundType,
+		&i.Enabled,
+		&i.GroupID,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
 
+const getQuestionIDsInGroup = `-- name: GetQuestionIDsInGroup :many
+SELECT id
+FROM questions
+WHERE group_id = $1
+`
+
+func (q *Queries) GetQuestionIDsInGroup(ctx context.Context, groupID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getQuestionIDsInGroup, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQuestionPack = `-- name: GetQuestionPack :many
+SELECT
+    q.id,
+    q.round_type,
+    q.enabled,
+    qg.group_name,
+    qi.locale,
+    qi.question,
+    qi.answer_options
+FROM questions AS q
+JOIN questions_groups AS qg ON q.group_id = qg.id
+JOIN questions_i18n AS qi ON q.id = qi.question_id
+WHERE
+    ($1::TEXT = '' OR qg.group_name = $1::TEXT)
+    AND q.archived_at IS NULL
+ORDER BY qg.group_name ASC, q.created_at ASC, q.id ASC, qi.locale ASC
+`
+
+type GetQuestionPackRow struct {
+	ID            uuid.UUID
+	RoundType     string
+	Enabled       pgtype.Bool
+	GroupName     string
+	Locale        string
+	Question      string
+	AnswerOptions []string
+}
+
+func (q *Queries) GetQuestionPack(ctx context.Context, groupName string) ([]GetQuestionPackRow, error) {
+	rows, err := q.db.Query(ctx, getQuestionPack, groupName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetQuestionPackRow
+	for rows.Next() {
+		var i GetQuestionPackRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RoundType,
+			&i.Enabled,
+			&i.GroupName,
+			&i.Locale,
+			&i.Question,
+			&i.AnswerOptions,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQuestionPairs = `-- name: GetQuestionPairs :many
+SELECT id, created_at, updated_at, normal_question_id, fibber_question_id
+FROM question_pairs
+WHERE normal_question_id = $1 OR fibber_question_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) GetQuestionPairs(ctx context.Context, questionID uuid.UUID) ([]QuestionPair, error) {
+	rows, err := q.db.Query(ctx, getQuestionPairs, questionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QuestionPair
+	for rows.Next() {
+		var i QuestionPair
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.NormalQuestionID,
+			&i.FibberQuestionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getQuestionWithLocalesById = `-- name: GetQuestionWithLocalesById :many
 SELECT
-    qi.id, qi.created_at, qi.updated_at, qi.question, qi.locale, qi.question_id,
+    qi.id, qi.created_at, qi.updated_at, qi.question, qi.locale, qi.question_id, qi.answer_options,
     q.group_id,
     q.id
 FROM questions_i18n qi
@@ -1290,14 +2374,15 @@ WHERE q.id = $1
 `
 
 type GetQuestionWithLocalesByIdRow struct {
-	ID         uuid.UUID
-	CreatedAt  pgtype.Timestamp
-	UpdatedAt  pgtype.Timestamp
-	Question   string
-	Locale     string
-	QuestionID uuid.UUID
-	GroupID    uuid.UUID
-	ID_2       uuid.UUID
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	Question      string
+	Locale        string
+	QuestionID    uuid.UUID
+	AnswerOptions []string
+	GroupID       uuid.UUID
+	ID_2          uuid.UUID
 }
 
 func (q *Queries) GetQuestionWithLocalesById(ctx context.Context, id uuid.UUID) ([]GetQuestionWithLocalesByIdRow, error) {
@@ -1316,6 +2401,7 @@ func (q *Queries) GetQuestionWithLocalesById(ctx context.Context, id uuid.UUID)
 			&i.Question,
 			&i.Locale,
 			&i.QuestionID,
+			&i.AnswerOptions,
 			&i.GroupID,
 			&i.ID_2,
 		); err != nil {
@@ -1331,9 +2417,10 @@ func (q *Queries) GetQuestionWithLocalesById(ctx context.Context, id uuid.UUID)
 
 const getQuestions = `-- name: GetQuestions :many
 SELECT
-    q.id, q.created_at, q.updated_at, q.game_name, q.round_type, q.enabled, q.group_id,
+    q.id, q.created_at, q.updated_at, q.game_name, q.round_type, q.enabled, q.group_id, q.archived_at,
     qi.question,
     qi.locale,
+    qi.answer_options,
     qg.group_name,
     qg.group_type
 FROM questions q
@@ -1344,6 +2431,7 @@ WHERE
     AND ($2::text = '' OR q.round_type = $2)
     AND ($3::text = '' OR qg.group_name = $3)
     AND ($4::boolean IS NULL OR q.enabled = $4)
+    AND q.archived_at IS NULL
 ORDER BY q.created_at DESC
 LIMIT $5 OFFSET $6
 `
@@ -1358,17 +2446,19 @@ type GetQuestionsParams struct {
 }
 
 type GetQuestionsRow struct {
-	ID        uuid.UUID
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	GameName  string
-	RoundType string
-	Enabled   pgtype.Bool
-	GroupID   uuid.UUID
-	Question  string
-	Locale    string
-	GroupName string
-	GroupType string
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	GameName      string
+	RoundType     string
+	Enabled       pgtype.Bool
+	GroupID       uuid.UUID
+	ArchivedAt    pgtype.Timestamp
+	Question      string
+	Locale        string
+	AnswerOptions []string
+	GroupName     string
+	GroupType     string
 }
 
 func (q *Queries) GetQuestions(ctx context.Context, arg GetQuestionsParams) ([]GetQuestionsRow, error) {
@@ -1395,8 +2485,10 @@ func (q *Queries) GetQuestions(ctx context.Context, arg GetQuestionsParams) ([]G
 			&i.RoundType,
 			&i.Enabled,
 			&i.GroupID,
+			&i.ArchivedAt,
 			&i.Question,
 			&i.Locale,
+			&i.AnswerOptions,
 			&i.GroupName,
 			&i.GroupType,
 		); err != nil {
@@ -1412,7 +2504,7 @@ func (q *Queries) GetQuestions(ctx context.Context, arg GetQuestionsParams) ([]G
 
 const getRandomQuestionByRound = `-- name: GetRandomQuestionByRound :many
 SELECT
-    qi.id, qi.created_at, qi.updated_at, qi.question, qi.locale, qi.question_id,
+    qi.id, qi.created_at, qi.updated_at, qi.question, qi.locale, qi.question_id, qi.answer_options,
     random_question.group_id,
     random_question.id
 FROM questions_i18n qi
@@ -1425,29 +2517,33 @@ JOIN (
         q.game_name = $1
         AND q.round_type = $2
         AND q.enabled = TRUE
+        AND q.archived_at IS NULL
+        AND NOT (q.id = ANY($3::uuid[]))
     ORDER BY RANDOM()
     LIMIT 1
 ) random_question ON qi.question_id = random_question.id
 `
 
 type GetRandomQuestionByRoundParams struct {
-	GameName  string
-	RoundType string
+	GameName            string
+	RoundType           string
+	ExcludedQuestionIds []uuid.UUID
 }
 
 type GetRandomQuestionByRoundRow struct {
-	ID         uuid.UUID
-	CreatedAt  pgtype.Timestamp
-	UpdatedAt  pgtype.Timestamp
-	Question   string
-	Locale     string
-	QuestionID uuid.UUID
-	GroupID    uuid.UUID
-	ID_2       uuid.UUID
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	Question      string
+	Locale        string
+	QuestionID    uuid.UUID
+	AnswerOptions []string
+	GroupID       uuid.UUID
+	ID_2          uuid.UUID
 }
 
 func (q *Queries) GetRandomQuestionByRound(ctx context.Context, arg GetRandomQuestionByRoundParams) ([]GetRandomQuestionByRoundRow, error) {
-	rows, err := q.db.Query(ctx, getRandomQuestionByRound, arg.GameName, arg.RoundType)
+	rows, err := q.db.Query(ctx, getRandomQuestionByRound, arg.GameName, arg.RoundType, arg.ExcludedQuestionIds)
 	if err != nil {
 		return nil, err
 	}
@@ -1462,6 +2558,7 @@ func (q *Queries) GetRandomQuestionByRound(ctx context.Context, arg GetRandomQue
 			&i.Question,
 			&i.Locale,
 			&i.QuestionID,
+			&i.AnswerOptions,
 			&i.GroupID,
 			&i.ID_2,
 		); err != nil {
@@ -1477,7 +2574,7 @@ func (q *Queries) GetRandomQuestionByRound(ctx context.Context, arg GetRandomQue
 
 const getRandomQuestionInGroup = `-- name: GetRandomQuestionInGroup :many
 SELECT
-    qi.id, qi.created_at, qi.updated_at, qi.question, qi.locale, qi.question_id,
+    qi.id, qi.created_at, qi.updated_at, qi.question, qi.locale, qi.question_id, qi.answer_options,
     random_question.id
 FROM questions_i18n qi
 JOIN (
@@ -1488,52 +2585,274 @@ JOIN (
         ($1::text = '' OR qg.group_type = $1)
         AND q.group_id = $2
         AND q.enabled = TRUE
+        AND q.archived_at IS NULL
         AND q.id != $3
-        AND q.round_type = $4
+        AND NOT (q.id = ANY($4::uuid[]))
+        AND q.round_type = $5
     ORDER BY RANDOM()
     LIMIT 1
 ) random_question ON qi.question_id = random_question.id
 `
 
 type GetRandomQuestionInGroupParams struct {
-	GroupType          string
-	GroupID            uuid.UUID
-	ExcludedQuestionID uuid.UUID
-	RoundType          string
+	GroupType           string
+	GroupID             uuid.UUID
+	ExcludedQuestionID  uuid.UUID
+	ExcludedQuestionIds []uuid.UUID
+	RoundType           string
+}
+
+type GetRandomQuestionInGroupRow struct {
+	ID            uuid.UUID
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	Question      string
+	Locale        string
+	QuestionID    uuid.UUID
+	AnswerOptions []string
+	ID_2          uuid.UUID
+}
+
+func (q *Queries) GetRandomQuestionInGroup(ctx context.Context, arg GetRandomQuestionInGroupParams) ([]GetRandomQuestionInGroupRow, error) {
+	rows, err := q.db.Query(ctx, getRandomQuestionInGroup,
+		arg.GroupType,
+		arg.GroupID,
+		arg.ExcludedQuestionID,
+		arg.ExcludedQuestionIds,
+		arg.RoundType,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRandomQuestionInGroupRow
+	for rows.Next() {
+		var i GetRandomQuestionInGroupRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Question,
+			&i.Locale,
+			&i.QuestionID,
+			&i.AnswerOptions,
+			&i.ID_2,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRandomQuestionPair = `-- name: GetRandomQuestionPair :one
+SELECT qp.id, qp.created_at, qp.updated_at, qp.normal_question_id, qp.fibber_question_id
+FROM question_pairs qp
+JOIN questions nq ON qp.normal_question_id = nq.id
+JOIN questions fq ON qp.fibber_question_id = fq.id
+WHERE
+    nq.game_name = $1
+    AND nq.round_type = $2
+    AND fq.round_type = $2
+    AND nq.enabled = TRUE
+    AND fq.enabled = TRUE
+    AND nq.archived_at IS NULL
+    AND fq.archived_at IS NULL
+    AND NOT (nq.id = ANY($3::uuid[]))
+    AND NOT (fq.id = ANY($3::uuid[]))
+ORDER BY RANDOM()
+LIMIT 1
+`
+
+type GetRandomQuestionPairParams struct {
+	GameName            string
+	RoundType           string
+	ExcludedQuestionIds []uuid.UUID
+}
+
+func (q *Queries) GetRandomQuestionPair(ctx context.Context, arg GetRandomQuestionPairParams) (QuestionPair, error) {
+	row := q.db.QueryRow(ctx, getRandomQuestionPair, arg.GameName, arg.RoundType, arg.ExcludedQuestionIds)
+	var i QuestionPair
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.NormalQuestionID,
+		&i.FibberQuestionID,
+	)
+	return i, err
+}
+
+const getRecapAnswersByGameStateID = `-- name: GetRecapAnswersByGameStateID :many
+SELECT
+    fr.id AS round_id,
+    p.id AS player_id,
+    p.nickname,
+    p.avatar,
+    fpr.player_role AS role,
+    COALESCE(fia.answer, '') AS answer,
+    fia.ranking,
+    COALESCE((
+        SELECT SUM(fs.score)
+        FROM fibbing_it_scores AS fs
+        WHERE fs.round_id = fr.id AND fs.player_id = p.id
+    ), 0)::BIGINT AS score,
+    fr.created_at AS round_started_at,
+    fia.created_at AS answered_at
+FROM fibbing_it_player_roles AS fpr
+JOIN fibbing_it_rounds AS fr ON fpr.round_id = fr.id
+JOIN players AS p ON fpr.player_id = p.id
+LEFT JOIN fibbing_it_answers AS fia
+    ON fr.id = fia.round_id AND p.id = fia.player_id
+WHERE fr.game_state_id = $1
+ORDER BY fr.created_at ASC, p.nickname ASC
+`
+
+type GetRecapAnswersByGameStateIDRow struct {
+	RoundID        uuid.UUID
+	PlayerID       uuid.UUID
+	Nickname       string
+	Avatar         string
+	Role           string
+	Answer         string
+	Ranking        []string
+	Score          int64
+	RoundStartedAt pgtype.Timestamp
+	AnsweredAt     pgtype.Timestamp
+}
+
+func (q *Queries) GetRecapAnswersByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]GetRecapAnswersByGameStateIDRow, error) {
+	rows, err := q.db.Query(ctx, getRecapAnswersByGameStateID, gameStateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRecapAnswersByGameStateIDRow
+	for rows.Next() {
+		var i GetRecapAnswersByGameStateIDRow
+		if err := rows.Scan(
+			&i.RoundID,
+			&i.PlayerID,
+			&i.Nickname,
+			&i.Avatar,
+			&i.Role,
+			&i.Answer,
+			&i.Ranking,
+			&i.Score,
+			&i.RoundStartedAt,
+			&i.AnsweredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecapRoundsByGameStateID = `-- name: GetRecapRoundsByGameStateID :many
+SELECT
+    fr.id,
+    fr.round,
+    fr.round_type,
+    COALESCE(nq.question, nqd.question, '') AS normal_question,
+    COALESCE(fq.question, fqd.question, '') AS fibber_question
+FROM fibbing_it_rounds AS fr
+LEFT JOIN questions_i18n AS nq
+    ON fr.normal_question_id = nq.question_id AND nq.locale = $1
+LEFT JOIN questions_i18n AS nqd
+    ON fr.normal_question_id = nqd.question_id AND nqd.locale = $2
+LEFT JOIN questions_i18n AS fq
+    ON fr.fibber_question_id = fq.question_id AND fq.locale = $1
+LEFT JOIN questions_i18n AS fqd
+    ON fr.fibber_question_id = fqd.question_id AND fqd.locale = $2
+WHERE fr.game_state_id = $3
+ORDER BY fr.created_at ASC
+`
+
+type GetRecapRoundsByGameStateIDParams struct {
+	Locale        string
+	DefaultLocale string
+	GameStateID   uuid.UUID
+}
+
+type GetRecapRoundsByGameStateIDRow struct {
+	ID             uuid.UUID
+	Round          int32
+	RoundType      string
+	NormalQuestion string
+	FibberQuestion string
+}
+
+func (q *Queries) GetRecapRoundsByGameStateID(ctx context.Context, arg GetRecapRoundsByGameStateIDParams) ([]GetRecapRoundsByGameStateIDRow, error) {
+	rows, err := q.db.Query(ctx, getRecapRoundsByGameStateID, arg.Locale, arg.DefaultLocale, arg.GameStateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRecapRoundsByGameStateIDRow
+	for rows.Next() {
+		var i GetRecapRoundsByGameStateIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Round,
+			&i.RoundType,
+			&i.NormalQuestion,
+			&i.FibberQuestion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-type GetRandomQuestionInGroupRow struct {
-	ID         uuid.UUID
-	CreatedAt  pgtype.Timestamp
-	UpdatedAt  pgtype.Timestamp
-	Question   string
-	Locale     string
-	QuestionID uuid.UUID
-	ID_2       uuid.UUID
+const getRecapVotesByGameStateID = `-- name: GetRecapVotesByGameStateID :many
+SELECT
+    v.round_id,
+    v.player_id AS voter_id,
+    p1.nickname AS voter_nickname,
+    v.voted_for_player_id AS voted_for_id,
+    p2.nickname AS voted_for_nickname
+FROM fibbing_it_votes AS v
+JOIN fibbing_it_rounds AS fr ON v.round_id = fr.id
+JOIN players AS p1 ON v.player_id = p1.id
+JOIN players AS p2 ON v.voted_for_player_id = p2.id
+WHERE fr.game_state_id = $1
+ORDER BY fr.created_at ASC, p1.nickname ASC
+`
+
+type GetRecapVotesByGameStateIDRow struct {
+	RoundID          uuid.UUID
+	VoterID          uuid.UUID
+	VoterNickname    string
+	VotedForID       uuid.UUID
+	VotedForNickname string
 }
 
-func (q *Queries) GetRandomQuestionInGroup(ctx context.Context, arg GetRandomQuestionInGroupParams) ([]GetRandomQuestionInGroupRow, error) {
-	rows, err := q.db.Query(ctx, getRandomQuestionInGroup,
-		arg.GroupType,
-		arg.GroupID,
-		arg.ExcludedQuestionID,
-		arg.RoundType,
-	)
+func (q *Queries) GetRecapVotesByGameStateID(ctx context.Context, gameStateID uuid.UUID) ([]GetRecapVotesByGameStateIDRow, error) {
+	rows, err := q.db.Query(ctx, getRecapVotesByGameStateID, gameStateID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetRandomQuestionInGroupRow
+	var items []GetRecapVotesByGameStateIDRow
 	for rows.Next() {
-		var i GetRandomQuestionInGroupRow
+		var i GetRecapVotesByGameStateIDRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.Question,
-			&i.Locale,
-			&i.QuestionID,
-			&i.ID_2,
+			&i.RoundID,
+			&i.VoterID,
+			&i.VoterNickname,
+			&i.VotedForID,
+			&i.VotedForNickname,
 		); err != nil {
 			return nil, err
 		}
@@ -1546,8 +2865,10 @@ func (q *Queries) GetRandomQuestionInGroup(ctx context.Context, arg GetRandomQue
 }
 
 const getRoomByCode = `-- name: GetRoomByCode :one
-SELECT id, created_at, updated_at, game_name, host_player, room_state, room_code FROM rooms
+SELECT id, created_at, updated_at, game_name, host_player, room_state, room_code, series_id FROM rooms
 WHERE room_code = $1
+ORDER BY created_at DESC
+LIMIT 1
 `
 
 func (q *Queries) GetRoomByCode(ctx context.Context, roomCode string) (Room, error) {
@@ -1561,12 +2882,36 @@ func (q *Queries) GetRoomByCode(ctx context.Context, roomCode string) (Room, err
 		&i.HostPlayer,
 		&i.RoomState,
 		&i.RoomCode,
+		&i.SeriesID,
+	)
+	return i, err
+}
+
+const getRoomByGameStateID = `-- name: GetRoomByGameStateID :one
+SELECT r.id, r.created_at, r.updated_at, r.game_name, r.host_player, r.room_state, r.room_code, r.series_id
+FROM rooms AS r
+JOIN game_state AS gs ON r.id = gs.room_id
+WHERE gs.id = $1
+`
+
+func (q *Queries) GetRoomByGameStateID(ctx context.Context, id uuid.UUID) (Room, error) {
+	row := q.db.QueryRow(ctx, getRoomByGameStateID, id)
+	var i Room
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GameName,
+		&i.HostPlayer,
+		&i.RoomState,
+		&i.RoomCode,
+		&i.SeriesID,
 	)
 	return i, err
 }
 
 const getRoomByPlayerID = `-- name: GetRoomByPlayerID :one
-SELECT r.id, r.created_at, r.updated_at, r.game_name, r.host_player, r.room_state, r.room_code
+SELECT r.id, r.created_at, r.updated_at, r.game_name, r.host_player, r.room_state, r.room_code, r.series_id
 FROM rooms AS r
 JOIN rooms_players AS rp ON r.id = rp.room_id
 WHERE rp.player_id = $1
@@ -1583,12 +2928,13 @@ func (q *Queries) GetRoomByPlayerID(ctx context.Context, playerID uuid.UUID) (Ro
 		&i.HostPlayer,
 		&i.RoomState,
 		&i.RoomCode,
+		&i.SeriesID,
 	)
 	return i, err
 }
 
 const getRoomByPlayerIDForUpdate = `-- name: GetRoomByPlayerIDForUpdate :one
-SELECT r.id, r.created_at, r.updated_at, r.game_name, r.host_player, r.room_state, r.room_code
+SELECT r.id, r.created_at, r.updated_at, r.game_name, r.host_player, r.room_state, r.room_code, r.series_id
 FROM rooms AS r
 JOIN rooms_players AS rp ON r.id = rp.room_id
 WHERE rp.player_id = $1
@@ -1606,10 +2952,245 @@ func (q *Queries) GetRoomByPlayerIDForUpdate(ctx context.Context, playerID uuid.
 		&i.HostPlayer,
 		&i.RoomState,
 		&i.RoomCode,
+		&i.SeriesID,
+	)
+	return i, err
+}
+
+const getRoomDisplayByID = `-- name: GetRoomDisplayByID :one
+SELECT
+    rd.id,
+    rd.locale,
+    r.room_code,
+    r.room_state,
+    r.host_player
+FROM room_displays AS rd
+JOIN rooms AS r ON rd.room_id = r.id
+WHERE rd.id = $1
+`
+
+type GetRoomDisplayByIDRow struct {
+	ID         uuid.UUID
+	Locale     pgtype.Text
+	RoomCode   string
+	RoomState  string
+	HostPlayer uuid.UUID
+}
+
+func (q *Queries) GetRoomDisplayByID(ctx context.Context, id uuid.UUID) (GetRoomDisplayByIDRow, error) {
+	row := q.db.QueryRow(ctx, getRoomDisplayByID, id)
+	var i GetRoomDisplayByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Locale,
+		&i.RoomCode,
+		&i.RoomState,
+		&i.HostPlayer,
+	)
+	return i, err
+}
+
+const getRoomDisplaysByGameStateID = `-- name: GetRoomDisplaysByGameStateID :many
+SELECT rd.id, rd.created_at, rd.updated_at, rd.room_id, rd.locale
+FROM room_displays AS rd
+JOIN game_state AS gs ON rd.room_id = gs.room_id
+WHERE gs.id = $1
+ORDER BY rd.created_at
+`
+
+func (q *Queries) GetRoomDisplaysByGameStateID(ctx context.Context, id uuid.UUID) ([]RoomDisplay, error) {
+	rows, err := q.db.Query(ctx, getRoomDisplaysByGameStateID, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RoomDisplay
+	for rows.Next() {
+		var i RoomDisplay
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RoomID,
+			&i.Locale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRoomDisplaysInLobby = `-- name: GetRoomDisplaysInLobby :many
+SELECT rd.id, rd.created_at, rd.updated_at, rd.room_id, rd.locale
+FROM room_displays AS rd
+JOIN rooms AS r ON rd.room_id = r.id
+WHERE r.room_code = $1 AND r.room_state = 'CREATED'
+ORDER BY rd.created_at
+`
+
+func (q *Queries) GetRoomDisplaysInLobby(ctx context.Context, roomCode string) ([]RoomDisplay, error) {
+	rows, err := q.db.Query(ctx, getRoomDisplaysInLobby, roomCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RoomDisplay
+	for rows.Next() {
+		var i RoomDisplay
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RoomID,
+			&i.Locale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRoomSettings = `-- name: GetRoomSettings :one
+SELECT room_id, created_at, updated_at, max_rounds, round_types, question_seconds, voting_seconds, reveal_seconds, score_seconds, scorers, reveal_rule, tie_break, fibbers, fibber_selection, fibber_rotation, series_games, recent_games, revote_seconds, winner_seconds FROM room_settings
+WHERE room_id = $1
+`
+
+func (q *Queries) GetRoomSettings(ctx context.Context, roomID uuid.UUID) (RoomSetting, error) {
+	row := q.db.QueryRow(ctx, getRoomSettings, roomID)
+	var i RoomSetting
+	err := row.Scan(
+		&i.RoomID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.QuestionSeconds,
+		&i.VotingSeconds,
+		&i.RevealSeconds,
+		&i.ScoreSeconds,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
+		&i.SeriesGames,
+		&i.RecentGames,
+		&i.RevoteSeconds,
+		&i.WinnerSeconds,
 	)
 	return i, err
 }
 
+const getScoreReasonsByRoundID = `-- name: GetScoreReasonsByRoundID :many
+SELECT
+    player_id,
+    reason_scorers,
+    reason_points
+FROM fibbing_it_scores
+WHERE round_id = $1
+`
+
+type GetScoreReasonsByRoundIDRow struct {
+	PlayerID      uuid.UUID
+	ReasonScorers []string
+	ReasonPoints  []int32
+}
+
+func (q *Queries) GetScoreReasonsByRoundID(ctx context.Context, roundID uuid.UUID) ([]GetScoreReasonsByRoundIDRow, error) {
+	rows, err := q.db.Query(ctx, getScoreReasonsByRoundID, roundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetScoreReasonsByRoundIDRow
+	for rows.Next() {
+		var i GetScoreReasonsByRoundIDRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.ReasonScorers,
+			&i.ReasonPoints,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeriesScores = `-- name: GetSeriesScores :many
+SELECT
+    s.player_id,
+    p.avatar,
+    p.nickname,
+    SUM(s.score) AS total_score
+FROM
+    fibbing_it_scores s
+JOIN
+    fibbing_it_rounds r
+    ON s.round_id = r.id
+JOIN
+    game_state gs
+    ON r.game_state_id = gs.id
+JOIN
+    players p
+    ON s.player_id = p.id
+WHERE
+    gs.series_id = $1 AND gs.id != $2
+GROUP BY
+    s.player_id,
+    p.avatar,
+    p.nickname
+`
+
+type GetSeriesScoresParams struct {
+	SeriesID uuid.NullUUID
+	ID       uuid.UUID
+}
+
+type GetSeriesScoresRow struct {
+	PlayerID   uuid.UUID
+	Avatar     string
+	Nickname   string
+	TotalScore int64
+}
+
+func (q *Queries) GetSeriesScores(ctx context.Context, arg GetSeriesScoresParams) ([]GetSeriesScoresRow, error) {
+	rows, err := q.db.Query(ctx, getSeriesScores, arg.SeriesID, arg.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeriesScoresRow
+	for rows.Next() {
+		var i GetSeriesScoresRow
+		if err := rows.Scan(
+			&i.PlayerID,
+			&i.Avatar,
+			&i.Nickname,
+			&i.TotalScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTotalScoresByGameStateID = `-- name: GetTotalScoresByGameStateID :many
 SELECT
     s.player_id,
@@ -1672,9 +3253,67 @@ func (q *Queries) GetTotalScoresByGameStateID(ctx context.Context, arg GetTotalS
 	return items, nil
 }
 
+const getUsedQuestionIDs = `-- name: GetUsedQuestionIDs :many
+SELECT
+    fr.game_state_id,
+    fr.normal_question_id,
+    fr.fibber_question_id
+FROM fibbing_it_rounds AS fr
+WHERE fr.game_state_id = $1
+    OR fr.game_state_id IN (
+        SELECT gs.id
+        FROM game_state AS gs
+        WHERE gs.id != $1 AND EXISTS (
+            SELECT 1
+            FROM fibbing_it_rounds AS pr
+            JOIN fibbing_it_player_roles AS fpr ON pr.id = fpr.round_id
+            WHERE pr.game_state_id = gs.id AND fpr.player_id = ANY($2::uuid[])
+        )
+        ORDER BY gs.created_at DESC
+        LIMIT $3
+    )
+`
+
+type GetUsedQuestionIDsParams struct {
+	GameStateID uuid.UUID
+	PlayerIds   []uuid.UUID
+	RecentGames int32
+}
+
+type GetUsedQuestionIDsRow struct {
+	GameStateID      uuid.UUID
+	NormalQuestionID uuid.UUID
+	FibberQuestionID uuid.UUID
+}
+
+func (q *Queries) GetUsedQuestionIDs(ctx context.Context, arg GetUsedQuestionIDsParams) ([]GetUsedQuestionIDsRow, error) {
+	rows, err := q.db.Query(ctx, getUsedQuestionIDs, arg.GameStateID, arg.PlayerIds, arg.RecentGames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUsedQuestionIDsRow
+	for rows.Next() {
+		var i GetUsedQuestionIDsRow
+		if err := rows.Scan(
+			&i.GameStateID,
+			&i.NormalQuestionID,
+			&i.FibberQuestionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getVotingState = `-- name: GetVotingState :many
 SELECT
     fir.round AS round,
+    fir.round_type,
     gs.id AS game_state_id,
     qi.question,
     gs.submit_deadline,
@@ -1683,8 +3322,10 @@ SELECT
     p.avatar,
     COALESCE(vote_counts.votes, 0) AS votes,
     fia.answer,
+    fia.ranking,
     COALESCE(voter_ready.is_ready, FALSE) AS is_ready,
-    fpr.player_role AS role
+    fpr.player_role AS role,
+    p.inactive_at
 FROM fibbing_it_rounds AS fir
 JOIN questions AS q ON fir.normal_question_id = q.id
 JOIN questions_i18n AS qi ON q.id = qi.question_id AND qi.locale = 'en-GB'
@@ -1719,6 +3360,7 @@ ORDER BY COALESCE(vote_counts.votes, 0) DESC, p.nickname ASC, p.created_at ASC
 
 type GetVotingStateRow struct {
 	Round          int32
+	RoundType      string
 	GameStateID    uuid.UUID
 	Question       string
 	SubmitDeadline pgtype.Timestamp
@@ -1727,8 +3369,10 @@ type GetVotingStateRow struct {
 	Avatar         string
 	Votes          int64
 	Answer         pgtype.Text
+	Ranking        []string
 	IsReady        bool
 	Role           pgtype.Text
+	InactiveAt     pgtype.Timestamp
 }
 
 func (q *Queries) GetVotingState(ctx context.Context, roundID uuid.UUID) ([]GetVotingStateRow, error) {
@@ -1742,6 +3386,7 @@ func (q *Queries) GetVotingState(ctx context.Context, roundID uuid.UUID) ([]GetV
 		var i GetVotingStateRow
 		if err := rows.Scan(
 			&i.Round,
+			&i.RoundType,
 			&i.GameStateID,
 			&i.Question,
 			&i.SubmitDeadline,
@@ -1750,8 +3395,10 @@ func (q *Queries) GetVotingState(ctx context.Context, roundID uuid.UUID) ([]GetV
 			&i.Avatar,
 			&i.Votes,
 			&i.Answer,
+			&i.Ranking,
 			&i.IsReady,
 			&i.Role,
+			&i.InactiveAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1763,6 +3410,76 @@ func (q *Queries) GetVotingState(ctx context.Context, roundID uuid.UUID) ([]GetV
 	return items, nil
 }
 
+const isQuestionInRounds = `-- name: IsQuestionInRounds :one
+SELECT EXISTS (
+    SELECT 1
+    FROM fibbing_it_rounds
+    WHERE fibber_question_id = $1 OR normal_question_id = $1
+) AS in_rounds
+`
+
+func (q *Queries) IsQuestionInRounds(ctx context.Context, questionID uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, isQuestionInRounds, questionID)
+	var in_rounds bool
+	err := row.Scan(&in_rounds)
+	return in_rounds, err
+}
+
+const linkPlayerToAccount = `-- name: LinkPlayerToAccount :exec
+UPDATE players AS p
+SET
+    account_id = a.id,
+    avatar = CASE WHEN a.avatar = '' THEN p.avatar ELSE a.avatar END
+FROM accounts AS a
+WHERE a.id = $1 AND p.id = $2
+`
+
+type LinkPlayerToAccountParams struct {
+	ID   uuid.UUID
+	ID_2 uuid.UUID
+}
+
+func (q *Queries) LinkPlayerToAccount(ctx context.Context, arg LinkPlayerToAccountParams) error {
+	_, err := q.db.Exec(ctx, linkPlayerToAccount, arg.ID, arg.ID_2)
+	return err
+}
+
+const moveActivePlayersToRoom = `-- name: MoveActivePlayersToRoom :exec
+UPDATE rooms_players AS rp
+SET room_id = $1, updated_at = CURRENT_TIMESTAMP
+FROM players AS p
+WHERE
+    rp.player_id = p.id
+    AND rp.room_id = $2
+    AND p.inactive_at IS NULL
+`
+
+type MoveActivePlayersToRoomParams struct {
+	RoomID   uuid.UUID
+	RoomID_2 uuid.UUID
+}
+
+func (q *Queries) MoveActivePlayersToRoom(ctx context.Context, arg MoveActivePlayersToRoomParams) error {
+	_, err := q.db.Exec(ctx, moveActivePlayersToRoom, arg.RoomID, arg.RoomID_2)
+	return err
+}
+
+const moveRoomDisplays = `-- name: MoveRoomDisplays :exec
+UPDATE room_displays
+SET room_id = $1, updated_at = CURRENT_TIMESTAMP
+WHERE room_id = $2
+`
+
+type MoveRoomDisplaysParams struct {
+	RoomID   uuid.UUID
+	RoomID_2 uuid.UUID
+}
+
+func (q *Queries) MoveRoomDisplays(ctx context.Context, arg MoveRoomDisplaysParams) error {
+	_, err := q.db.Exec(ctx, moveRoomDisplays, arg.RoomID, arg.RoomID_2)
+	return err
+}
+
 const pauseGame = `-- name: PauseGame :one
 UPDATE game_state
 SET
@@ -1772,7 +3489,7 @@ WHERE
     id = $1
     AND paused_at IS NULL
     AND pause_time_remaining_ms > 0
-RETURNING id, created_at, updated_at, room_id, submit_deadline, state, pause_time_remaining_ms, paused_at, pause_deadline
+RETURNING id, created_at, updated_at, room_id, submit_deadline, state, pause_time_remaining_ms, paused_at, pause_deadline, max_rounds, round_types, question_seconds, voting_seconds, reveal_seconds, score_seconds, scorers, reveal_rule, tie_break, fibbers, fibber_selection, fibber_rotation, series_id, series_game, series_games, recent_games, revote_seconds, winner_seconds
 `
 
 type PauseGameParams struct {
@@ -1794,6 +3511,24 @@ func (q *Queries) PauseGame(ctx context.Context, arg PauseGameParams) (GameState
 		&i.PauseTimeRemainingMs,
 		&i.PausedAt,
 		&i.PauseDeadline,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.QuestionSeconds,
+		&i.VotingSeconds,
+		&i.RevealSeconds,
+		&i.ScoreSeconds,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
+		&i.SeriesID,
+		&i.SeriesGame,
+		&i.SeriesGames,
+		&i.RecentGames,
+		&i.RevoteSeconds,
+		&i.WinnerSeconds,
 	)
 	return i, err
 }
@@ -1802,7 +3537,7 @@ const reassignHostPlayer = `-- name: ReassignHostPlayer :one
 UPDATE rooms
 SET host_player = $2
 WHERE id = $1
-RETURNING id, created_at, updated_at, game_name, host_player, room_state, room_code
+RETURNING id, created_at, updated_at, game_name, host_player, room_state, room_code, series_id
 `
 
 type ReassignHostPlayerParams struct {
@@ -1821,6 +3556,7 @@ func (q *Queries) ReassignHostPlayer(ctx context.Context, arg ReassignHostPlayer
 		&i.HostPlayer,
 		&i.RoomState,
 		&i.RoomCode,
+		&i.SeriesID,
 	)
 	return i, err
 }
@@ -1853,6 +3589,20 @@ func (q *Queries) RemovePlayerFromRoom(ctx context.Context, playerID uuid.UUID)
 	return i, err
 }
 
+const resetPlayersReadyInRoom = `-- name: ResetPlayersReadyInRoom :exec
+UPDATE players SET is_ready = FALSE
+WHERE id IN (
+    SELECT rp.player_id
+    FROM rooms_players AS rp
+    WHERE rp.room_id = $1
+)
+`
+
+func (q *Queries) ResetPlayersReadyInRoom(ctx context.Context, roomID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, resetPlayersReadyInRoom, roomID)
+	return err
+}
+
 const resumeGame = `-- name: ResumeGame :one
 UPDATE game_state
 SET
@@ -1866,7 +3616,7 @@ SET
 WHERE
     id = $1
     AND paused_at IS NOT NULL
-RETURNING id, created_at, updated_at, room_id, submit_deadline, state, pause_time_remaining_ms, paused_at, pause_deadline
+RETURNING id, created_at, updated_at, room_id, submit_deadline, state, pause_time_remaining_ms, paused_at, pause_deadline, max_rounds, round_types, question_seconds, voting_seconds, reveal_seconds, score_seconds, scorers, reveal_rule, tie_break, fibbers, fibber_selection, fibber_rotation, series_id, series_game, series_games, recent_games, revote_seconds, winner_seconds
 `
 
 func (q *Queries) ResumeGame(ctx context.Context, id uuid.UUID) (GameState, error) {
@@ -1882,13 +3632,73 @@ func (q *Queries) ResumeGame(ctx context.Context, id uuid.UUID) (GameState, erro
 		&i.PauseTimeRemainingMs,
 		&i.PausedAt,
 		&i.PauseDeadline,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.QuestionSeconds,
+		&i.VotingSeconds,
+		&i.RevealSeconds,
+		&i.ScoreSeconds,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
+		&i.SeriesID,
+		&i.SeriesGame,
+		&i.SeriesGames,
+		&i.RecentGames,
+		&i.RevoteSeconds,
+		&i.WinnerSeconds,
 	)
 	return i, err
 }
 
+const setPlayerActive = `-- name: SetPlayerActive :exec
+UPDATE players SET disconnected_at = NULL, inactive_at = NULL
+WHERE id = $1
+`
+
+func (q *Queries) SetPlayerActive(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, setPlayerActive, id)
+	return err
+}
+
+const setPlayerDisconnected = `-- name: SetPlayerDisconnected :exec
+UPDATE players SET disconnected_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) SetPlayerDisconnected(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, setPlayerDisconnected, id)
+	return err
+}
+
+const setPlayerInactive = `-- name: SetPlayerInactive :execrows
+UPDATE players SET inactive_at = NOW()
+WHERE
+    id = $1
+    AND inactive_at IS NULL
+    AND disconnected_at IS NOT NULL
+    AND disconnected_at <= $2
+`
+
+type SetPlayerInactiveParams struct {
+	ID             uuid.UUID
+	DisconnectedAt pgtype.Timestamp
+}
+
+func (q *Queries) SetPlayerInactive(ctx context.Context, arg SetPlayerInactiveParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setPlayerInactive, arg.ID, arg.DisconnectedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const toggleAnswerIsReady = `-- name: ToggleAnswerIsReady :one
 UPDATE fibbing_it_answers SET is_ready = NOT is_ready
-WHERE player_id = $1 RETURNING id, created_at, updated_at, answer, player_id, round_id, is_ready
+WHERE player_id = $1 RETURNING id, created_at, updated_at, answer, player_id, round_id, is_ready, ranking
 `
 
 func (q *Queries) ToggleAnswerIsReady(ctx context.Context, playerID uuid.UUID) (FibbingItAnswer, error) {
@@ -1902,13 +3712,14 @@ func (q *Queries) ToggleAnswerIsReady(ctx context.Context, playerID uuid.UUID) (
 		&i.PlayerID,
 		&i.RoundID,
 		&i.IsReady,
+		&i.Ranking,
 	)
 	return i, err
 }
 
 const togglePlayerIsReady = `-- name: TogglePlayerIsReady :one
 UPDATE players SET is_ready = NOT is_ready
-WHERE id = $1 RETURNING id, created_at, updated_at, avatar, nickname, is_ready, locale
+WHERE id = $1 RETURNING id, created_at, updated_at, avatar, nickname, is_ready, locale, disconnected_at, inactive_at, account_id
 `
 
 func (q *Queries) TogglePlayerIsReady(ctx context.Context, id uuid.UUID) (Player, error) {
@@ -1922,17 +3733,25 @@ func (q *Queries) TogglePlayerIsReady(ctx context.Context, id uuid.UUID) (Player
 		&i.Nickname,
 		&i.IsReady,
 		&i.Locale,
+		&i.DisconnectedAt,
+		&i.InactiveAt,
+		&i.AccountID,
 	)
 	return i, err
 }
 
 const toggleVotingIsReady = `-- name: ToggleVotingIsReady :one
 UPDATE fibbing_it_votes SET is_ready = NOT is_ready
-WHERE player_id = $1 RETURNING id, created_at, updated_at, player_id, voted_for_player_id, round_id, is_ready
+WHERE player_id = $1 AND round_id = $2 RETURNING id, created_at, updated_at, player_id, voted_for_player_id, round_id, is_ready
 `
 
-func (q *Queries) ToggleVotingIsReady(ctx context.Context, playerID uuid.UUID) (FibbingItVote, error) {
-	row := q.db.QueryRow(ctx, toggleVotingIsReady, playerID)
+type ToggleVotingIsReadyParams struct {
+	PlayerID uuid.UUID
+	RoundID  uuid.UUID
+}
+
+func (q *Queries) ToggleVotingIsReady(ctx context.Context, arg ToggleVotingIsReadyParams) (FibbingItVote, error) {
+	row := q.db.QueryRow(ctx, toggleVotingIsReady, arg.PlayerID, arg.RoundID)
 	var i FibbingItVote
 	err := row.Scan(
 		&i.ID,
@@ -1959,9 +3778,43 @@ func (q *Queries) TryAcquireGameLock(ctx context.Context, dollar_1 string) (bool
 	return acquired, err
 }
 
+const updateAccountProfile = `-- name: UpdateAccountProfile :one
+UPDATE accounts
+SET nickname = $1, avatar = $2, locale = $3, updated_at = NOW()
+WHERE id = $4
+RETURNING id, created_at, updated_at, subject, nickname, avatar, locale
+`
+
+type UpdateAccountProfileParams struct {
+	Nickname string
+	Avatar   string
+	Locale   pgtype.Text
+	ID       uuid.UUID
+}
+
+func (q *Queries) UpdateAccountProfile(ctx context.Context, arg UpdateAccountProfileParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountProfile,
+		arg.Nickname,
+		arg.Avatar,
+		arg.Locale,
+		arg.ID,
+	)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Subject,
+		&i.Nickname,
+		&i.Avatar,
+		&i.Locale,
+	)
+	return i, err
+}
+
 const updateAvatar = `-- name: UpdateAvatar :one
 UPDATE players SET avatar = $1
-WHERE id = $2 RETURNING id, created_at, updated_at, avatar, nickname, is_ready, locale
+WHERE id = $2 RETURNING id, created_at, updated_at, avatar, nickname, is_ready, locale, disconnected_at, inactive_at, account_id
 `
 
 type UpdateAvatarParams struct {
@@ -1980,13 +3833,47 @@ func (q *Queries) UpdateAvatar(ctx context.Context, arg UpdateAvatarParams) (Pla
 		&i.Nickname,
 		&i.IsReady,
 		&i.Locale,
+		&i.DisconnectedAt,
+		&i.InactiveAt,
+		&i.AccountID,
 	)
 	return i, err
 }
 
+const updateFibbingItRoundRevealedPlayers = `-- name: UpdateFibbingItRoundRevealedPlayers :exec
+UPDATE fibbing_it_rounds SET revealed_players = $1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $2
+`
+
+type UpdateFibbingItRoundRevealedPlayersParams struct {
+	RevealedPlayers []string
+	ID              uuid.UUID
+}
+
+func (q *Queries) UpdateFibbingItRoundRevealedPlayers(ctx context.Context, arg UpdateFibbingItRoundRevealedPlayersParams) error {
+	_, err := q.db.Exec(ctx, updateFibbingItRoundRevealedPlayers, arg.RevealedPlayers, arg.ID)
+	return err
+}
+
+const updateFibbingItRoundRevoteCandidates = `-- name: UpdateFibbingItRoundRevoteCandidates :exec
+UPDATE fibbing_it_rounds SET revote_candidates = $1, revote_winners = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $3
+`
+
+type UpdateFibbingItRoundRevoteCandidatesParams struct {
+	RevoteCandidates []string
+	RevoteWinners    []string
+	ID               uuid.UUID
+}
+
+func (q *Queries) UpdateFibbingItRoundRevoteCandidates(ctx context.Context, arg UpdateFibbingItRoundRevoteCandidatesParams) error {
+	_, err := q.db.Exec(ctx, updateFibbingItRoundRevoteCandidates, arg.RevoteCandidates, arg.RevoteWinners, arg.ID)
+	return err
+}
+
 const updateGameState = `-- name: UpdateGameState :one
 UPDATE game_state SET state = $1, submit_deadline = $2
-WHERE id = $3 RETURNING id, created_at, updated_at, room_id, submit_deadline, state, pause_time_remaining_ms, paused_at, pause_deadline
+WHERE id = $3 RETURNING id, created_at, updated_at, room_id, submit_deadline, state, pause_time_remaining_ms, paused_at, pause_deadline, max_rounds, round_types, question_seconds, voting_seconds, reveal_seconds, score_seconds, scorers, reveal_rule, tie_break, fibbers, fibber_selection, fibber_rotation, series_id, series_game, series_games, recent_games, revote_seconds, winner_seconds
 `
 
 type UpdateGameStateParams struct {
@@ -2008,6 +3895,24 @@ func (q *Queries) UpdateGameState(ctx context.Context, arg UpdateGameStateParams
 		&i.PauseTimeRemainingMs,
 		&i.PausedAt,
 		&i.PauseDeadline,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.QuestionSeconds,
+		&i.VotingSeconds,
+		&i.RevealSeconds,
+		&i.ScoreSeconds,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
+		&i.SeriesID,
+		&i.SeriesGame,
+		&i.SeriesGames,
+		&i.RecentGames,
+		&i.RevoteSeconds,
+		&i.WinnerSeconds,
 	)
 	return i, err
 }
@@ -2016,7 +3921,7 @@ const updateGameStateIfInState = `-- name: UpdateGameStateIfInState :one
 UPDATE game_state
 SET state = $1, submit_deadline = $2
 WHERE id = $3 AND state = $4
-RETURNING id, created_at, updated_at, room_id, submit_deadline, state, pause_time_remaining_ms, paused_at, pause_deadline
+RETURNING id, created_at, updated_at, room_id, submit_deadline, state, pause_time_remaining_ms, paused_at, pause_deadline, max_rounds, round_types, question_seconds, voting_seconds, reveal_seconds, score_seconds, scorers, reveal_rule, tie_break, fibbers, fibber_selection, fibber_rotation, series_id, series_game, series_games, recent_games, revote_seconds, winner_seconds
 `
 
 type UpdateGameStateIfInStateParams struct {
@@ -2044,13 +3949,57 @@ func (q *Queries) UpdateGameStateIfInState(ctx context.Context, arg UpdateGameSt
 		&i.PauseTimeRemainingMs,
 		&i.PausedAt,
 		&i.PauseDeadline,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.QuestionSeconds,
+		&i.VotingSeconds,
+		&i.RevealSeconds,
+		&i.ScoreSeconds,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
+		&i.SeriesID,
+		&i.SeriesGame,
+		&i.SeriesGames,
+		&i.RecentGames,
+		&i.RevoteSeconds,
+		&i.WinnerSeconds,
+	)
+	return i, err
+}
+
+const updateGroupName = `-- name: UpdateGroupName :one
+UPDATE questions_groups
+SET group_name = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND archived_at IS NULL
+RETURNING id, created_at, updated_at, group_name, group_type, archived_at
+`
+
+type UpdateGroupNameParams struct {
+	ID        uuid.UUID
+	GroupName string
+}
+
+func (q *Queries) UpdateGroupName(ctx context.Context, arg UpdateGroupNameParams) (QuestionsGroup, error) {
+	row := q.db.QueryRow(ctx, updateGroupName, arg.ID, arg.GroupName)
+	var i QuestionsGroup
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GroupName,
+		&i.GroupType,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
 
 const updateLocale = `-- name: UpdateLocale :one
 UPDATE players SET locale = $1
-WHERE id = $2 RETURNING id, created_at, updated_at, avatar, nickname, is_ready, locale
+WHERE id = $2 RETURNING id, created_at, updated_at, avatar, nickname, is_ready, locale, disconnected_at, inactive_at, account_id
 `
 
 type UpdateLocaleParams struct {
@@ -2069,13 +4018,16 @@ func (q *Queries) UpdateLocale(ctx context.Context, arg UpdateLocaleParams) (Pla
 		&i.Nickname,
 		&i.IsReady,
 		&i.Locale,
+		&i.DisconnectedAt,
+		&i.InactiveAt,
+		&i.AccountID,
 	)
 	return i, err
 }
 
 const updateNickname = `-- name: UpdateNickname :one
 UPDATE players SET nickname = $1
-WHERE id = $2 RETURNING id, created_at, updated_at, avatar, nickname, is_ready, locale
+WHERE id = $2 RETURNING id, created_at, updated_at, avatar, nickname, is_ready, locale, disconnected_at, inactive_at, account_id
 `
 
 type UpdateNicknameParams struct {
@@ -2094,13 +4046,82 @@ func (q *Queries) UpdateNickname(ctx context.Context, arg UpdateNicknameParams)
 		&i.Nickname,
 		&i.IsReady,
 		&i.Locale,
+		&i.DisconnectedAt,
+		&i.InactiveAt,
+		&i.AccountID,
+	)
+	return i, err
+}
+
+const updateQuestion = `-- name: UpdateQuestion :one
+UPDATE questions
+SET group_id = $2, round_type = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND archived_at IS NULL
+RETURNING id, created_at, updated_at, game_name, round_type, enabled, group_id, archived_at
+`
+
+type UpdateQuestionParams struct {
+	ID        uuid.UUID
+	GroupID   uuid.UUID
+	RoundType string
+}
+
+func (q *Queries) UpdateQuestion(ctx context.Context, arg UpdateQuestionParams) (Question, error) {
+	row := q.db.QueryRow(ctx, updateQuestion, arg.ID, arg.GroupID, arg.RoundType)
+	var i Question
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GameName,
+		&i.RoundType,
+		&i.Enabled,
+		&i.GroupID,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
+const updateQuestionTranslation = `-- name: UpdateQuestionTranslation :one
+UPDATE questions_i18n
+SET
+    question = $1,
+    answer_options = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE question_id = $3 AND locale = $4
+RETURNING id, created_at, updated_at, question, locale, question_id, answer_options
+`
+
+type UpdateQuestionTranslationParams struct {
+	Question      string
+	AnswerOptions []string
+	QuestionID    uuid.UUID
+	Locale        string
+}
+
+func (q *Queries) UpdateQuestionTranslation(ctx context.Context, arg UpdateQuestionTranslationParams) (QuestionsI18n, error) {
+	row := q.db.QueryRow(ctx, updateQuestionTranslation,
+		arg.Question,
+		arg.AnswerOptions,
+		arg.QuestionID,
+		arg.Locale,
+	)
+	var i QuestionsI18n
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Question,
+		&i.Locale,
+		&i.QuestionID,
+		&i.AnswerOptions,
 	)
 	return i, err
 }
 
 const updateRoomState = `-- name: UpdateRoomState :one
 UPDATE rooms SET room_state = $1
-WHERE id = $2 RETURNING id, created_at, updated_at, game_name, host_player, room_state, room_code
+WHERE id = $2 RETURNING id, created_at, updated_at, game_name, host_player, room_state, room_code, series_id
 `
 
 type UpdateRoomStateParams struct {
@@ -2119,18 +4140,88 @@ func (q *Queries) UpdateRoomState(ctx context.Context, arg UpdateRoomStateParams
 		&i.HostPlayer,
 		&i.RoomState,
 		&i.RoomCode,
+		&i.SeriesID,
+	)
+	return i, err
+}
+
+const upsertAccount = `-- name: UpsertAccount :one
+INSERT INTO accounts (id, subject) VALUES ($1, $2)
+ON CONFLICT (subject) DO UPDATE SET updated_at = NOW()
+RETURNING id, created_at, updated_at, subject, nickname, avatar, locale
+`
+
+type UpsertAccountParams struct {
+	ID      uuid.UUID
+	Subject string
+}
+
+func (q *Queries) UpsertAccount(ctx context.Context, arg UpsertAccountParams) (Account, error) {
+	row := q.db.QueryRow(ctx, upsertAccount, arg.ID, arg.Subject)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Subject,
+		&i.Nickname,
+		&i.Avatar,
+		&i.Locale,
+	)
+	return i, err
+}
+
+const upsertAudienceMember = `-- name: UpsertAudienceMember :one
+INSERT INTO audience_members (id, room_id, nickname, avatar, locale)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE
+    SET
+        room_id = excluded.room_id,
+        nickname = excluded.nickname,
+        avatar = excluded.avatar,
+        locale = excluded.locale,
+        updated_at = CURRENT_TIMESTAMP
+RETURNING id, created_at, updated_at, room_id, nickname, avatar, locale
+`
+
+type UpsertAudienceMemberParams struct {
+	ID       uuid.UUID
+	RoomID   uuid.UUID
+	Nickname string
+	Avatar   string
+	Locale   pgtype.Text
+}
+
+func (q *Queries) UpsertAudienceMember(ctx context.Context, arg UpsertAudienceMemberParams) (AudienceMember, error) {
+	row := q.db.QueryRow(ctx, upsertAudienceMember,
+		arg.ID,
+		arg.RoomID,
+		arg.Nickname,
+		arg.Avatar,
+		arg.Locale,
+	)
+	var i AudienceMember
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RoomID,
+		&i.Nickname,
+		&i.Avatar,
+		&i.Locale,
 	)
 	return i, err
 }
 
 const upsertFibbingItAnswer = `-- name: UpsertFibbingItAnswer :one
-INSERT INTO fibbing_it_answers (id, answer, round_id, player_id)
-VALUES ($1, $2, $3, $4)
+INSERT INTO fibbing_it_answers (id, answer, round_id, player_id, ranking)
+VALUES ($1, $2, $3, $4, $5)
 ON CONFLICT (player_id, round_id) DO UPDATE
     SET
         answer = excluded.answer,
+        ranking = excluded.ranking,
         updated_at = CURRENT_TIMESTAMP
-RETURNING id, created_at, updated_at, answer, player_id, round_id, is_ready
+RETURNING id, created_at, updated_at, answer, player_id, round_id, is_ready, ranking
 `
 
 type UpsertFibbingItAnswerParams struct {
@@ -2138,6 +4229,7 @@ type UpsertFibbingItAnswerParams struct {
 	Answer   string
 	RoundID  uuid.UUID
 	PlayerID uuid.UUID
+	Ranking  []string
 }
 
 func (q *Queries) UpsertFibbingItAnswer(ctx context.Context, arg UpsertFibbingItAnswerParams) (FibbingItAnswer, error) {
@@ -2146,6 +4238,7 @@ func (q *Queries) UpsertFibbingItAnswer(ctx context.Context, arg UpsertFibbingIt
 		arg.Answer,
 		arg.RoundID,
 		arg.PlayerID,
+		arg.Ranking,
 	)
 	var i FibbingItAnswer
 	err := row.Scan(
@@ -2156,19 +4249,46 @@ func (q *Queries) UpsertFibbingItAnswer(ctx context.Context, arg UpsertFibbingIt
 		&i.PlayerID,
 		&i.RoundID,
 		&i.IsReady,
+		&i.Ranking,
 	)
 	return i, err
 }
 
-const upsertFibbingItVote = `-- name: UpsertFibbingItVote :exec
-INSERT INTO fibbing_it_votes (id, player_id, voted_for_player_id, round_id)
+const upsertFibbingItAudienceVote = `-- name: UpsertFibbingItAudienceVote :exec
+INSERT INTO fibbing_it_audience_votes (id, audience_member_id, voted_for_player_id, round_id)
 VALUES ($1, $2, $3, $4)
-ON CONFLICT (player_id, round_id) DO UPDATE
+ON CONFLICT (audience_member_id, round_id, voted_for_player_id) DO UPDATE
+    SET
+        updated_at = CURRENT_TIMESTAMP
+`
+
+type UpsertFibbingItAudienceVoteParams struct {
+	ID               uuid.UUID
+	AudienceMemberID uuid.UUID
+	VotedForPlayerID uuid.UUID
+	RoundID          uuid.UUID
+}
+
+func (q *Queries) UpsertFibbingItAudienceVote(ctx context.Context, arg UpsertFibbingItAudienceVoteParams) error {
+	_, err := q.db.Exec(ctx, upsertFibbingItAudienceVote,
+		arg.ID,
+		arg.AudienceMemberID,
+		arg.VotedForPlayerID,
+		arg.RoundID,
+	)
+	return err
+}
+
+const upsertFibbingItVote = `-- name: UpsertFibbingItVote :exec
+INSERT INTO fibbing_it_votes (id, player_id, voted_for_player_id, round_id, is_ready)
+VALUES ($1, $2, $3, $4, (
+    SELECT COALESCE(BOOL_OR(fv.is_ready), FALSE)
+    FROM fibbing_it_votes fv
+    WHERE fv.player_id = $2 AND fv.round_id = $4
+))
+ON CONFLICT (player_id, round_id, voted_for_player_id) DO UPDATE
     SET
-        updated_at = CURRENT_TIMESTAMP,
-        player_id = excluded.player_id,
-        voted_for_player_id = excluded.voted_for_player_id,
-        round_id = excluded.round_id
+        updated_at = CURRENT_TIMESTAMP
 RETURNING id, created_at, updated_at, player_id, voted_for_player_id, round_id, is_ready
 `
 
@@ -2188,3 +4308,143 @@ func (q *Queries) UpsertFibbingItVote(ctx context.Context, arg UpsertFibbingItVo
 	)
 	return err
 }
+
+const upsertRoomDisplay = `-- name: UpsertRoomDisplay :one
+INSERT INTO room_displays (id, room_id, locale)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE
+    SET
+        room_id = excluded.room_id,
+        locale = excluded.locale,
+        updated_at = CURRENT_TIMESTAMP
+RETURNING id, created_at, updated_at, room_id, locale
+`
+
+type UpsertRoomDisplayParams struct {
+	ID     uuid.UUID
+	RoomID uuid.UUID
+	Locale pgtype.Text
+}
+
+func (q *Queries) UpsertRoomDisplay(ctx context.Context, arg UpsertRoomDisplayParams) (RoomDisplay, error) {
+	row := q.db.QueryRow(ctx, upsertRoomDisplay,
+		arg.ID,
+		arg.RoomID,
+		arg.Locale,
+	)
+	var i RoomDisplay
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RoomID,
+		&i.Locale,
+	)
+	return i, err
+}
+
+const upsertRoomSettings = `-- name: UpsertRoomSettings :one
+INSERT INTO room_settings (
+    room_id,
+    max_rounds,
+    round_types,
+    question_seconds,
+    voting_seconds,
+    reveal_seconds,
+    score_seconds,
+    scorers,
+    reveal_rule,
+    tie_break,
+    fibbers,
+    fibber_selection,
+    fibber_rotation,
+    series_games,
+    recent_games,
+    revote_seconds,
+    winner_seconds
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+ON CONFLICT (room_id) DO UPDATE SET
+    max_rounds = excluded.max_rounds,
+    round_types = excluded.round_types,
+    question_seconds = excluded.question_seconds,
+    voting_seconds = excluded.voting_seconds,
+    reveal_seconds = excluded.reveal_seconds,
+    score_seconds = excluded.score_seconds,
+    scorers = excluded.scorers,
+    reveal_rule = excluded.reveal_rule,
+    tie_break = excluded.tie_break,
+    fibbers = excluded.fibbers,
+    fibber_selection = excluded.fibber_selection,
+    fibber_rotation = excluded.fibber_rotation,
+    series_games = excluded.series_games,
+    recent_games = excluded.recent_games,
+    revote_seconds = excluded.revote_seconds,
+    winner_seconds = excluded.winner_seconds,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING room_id, created_at, updated_at, max_rounds, round_types, question_seconds, voting_seconds, reveal_seconds, score_seconds, scorers, reveal_rule, tie_break, fibbers, fibber_selection, fibber_rotation, series_games, recent_games, revote_seconds, winner_seconds
+`
+
+type UpsertRoomSettingsParams struct {
+	RoomID          uuid.UUID
+	MaxRounds       int32
+	RoundTypes      []string
+	QuestionSeconds int32
+	VotingSeconds   int32
+	RevealSeconds   int32
+	ScoreSeconds    int32
+	Scorers         []string
+	RevealRule      string
+	TieBreak        string
+	Fibbers         int32
+	FibberSelection string
+	FibberRotation  string
+	SeriesGames     int32
+	RecentGames     int32
+	RevoteSeconds   int32
+	WinnerSeconds   int32
+}
+
+func (q *Queries) UpsertRoomSettings(ctx context.Context, arg UpsertRoomSettingsParams) (RoomSetting, error) {
+	row := q.db.QueryRow(ctx, upsertRoomSettings,
+		arg.RoomID,
+		arg.MaxRounds,
+		arg.RoundTypes,
+		arg.QuestionSeconds,
+		arg.VotingSeconds,
+		arg.RevealSeconds,
+		arg.ScoreSeconds,
+		arg.Scorers,
+		arg.RevealRule,
+		arg.TieBreak,
+		arg.Fibbers,
+		arg.FibberSelection,
+		arg.FibberRotation,
+		arg.SeriesGames,
+		arg.RecentGames,
+		arg.RevoteSeconds,
+		arg.WinnerSeconds,
+	)
+	var i RoomSetting
+	err := row.Scan(
+		&i.RoomID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.MaxRounds,
+		&i.RoundTypes,
+		&i.QuestionSeconds,
+		&i.VotingSeconds,
+		&i.RevealSeconds,
+		&i.ScoreSeconds,
+		&i.Scorers,
+		&i.RevealRule,
+		&i.TieBreak,
+		&i.Fibbers,
+		&i.FibberSelection,
+		&i.FibberRotation,
+		&i.SeriesGames,
+		&i.RecentGames,
+		&i.RevoteSeconds,
+		&i.WinnerSeconds,
+	)
+	return i, err
+}
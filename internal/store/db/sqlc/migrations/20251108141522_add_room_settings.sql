@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS room_settings (
+    room_id UUID PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    max_rounds INT NOT NULL DEFAULT 3,
+    FOREIGN KEY (room_id) REFERENCES rooms (id)
+);
+
+ALTER TABLE game_state
+ADD COLUMN max_rounds INT NOT NULL DEFAULT 3;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE game_state
+DROP COLUMN max_rounds;
+
+DROP TABLE IF EXISTS room_settings;
+
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE room_settings
+ADD COLUMN round_types TEXT[] NOT NULL DEFAULT '{free_form,multiple_choice,most_likely}';
+
+ALTER TABLE game_state
+ADD COLUMN round_types TEXT[] NOT NULL DEFAULT '{free_form,multiple_choice,most_likely}';
+
+ALTER TABLE fibbing_it_rounds
+ADD COLUMN round_type_index INT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE fibbing_it_rounds
+DROP COLUMN round_type_index;
+
+ALTER TABLE game_state
+DROP COLUMN round_types;
+
+ALTER TABLE room_settings
+DROP COLUMN round_types;
+
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE room_settings
+ADD COLUMN question_seconds INT NOT NULL DEFAULT 15,
+ADD COLUMN voting_seconds INT NOT NULL DEFAULT 60,
+ADD COLUMN reveal_seconds INT NOT NULL DEFAULT 15,
+ADD COLUMN score_seconds INT NOT NULL DEFAULT 15;
+
+ALTER TABLE game_state
+ADD COLUMN question_seconds INT NOT NULL DEFAULT 15,
+ADD COLUMN voting_seconds INT NOT NULL DEFAULT 60,
+ADD COLUMN reveal_seconds INT NOT NULL DEFAULT 15,
+ADD COLUMN score_seconds INT NOT NULL DEFAULT 15;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE game_state
+DROP COLUMN score_seconds,
+DROP COLUMN reveal_seconds,
+DROP COLUMN voting_seconds,
+DROP COLUMN question_seconds;
+
+ALTER TABLE room_settings
+DROP COLUMN score_seconds,
+DROP COLUMN reveal_seconds,
+DROP COLUMN voting_seconds,
+DROP COLUMN question_seconds;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE room_settings
+ADD COLUMN scorers TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE game_state
+ADD COLUMN scorers TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE game_state
+DROP COLUMN scorers;
+
+ALTER TABLE room_settings
+DROP COLUMN scorers;
+
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE room_settings
+ADD COLUMN reveal_rule TEXT NOT NULL DEFAULT 'unanimous',
+ADD COLUMN tie_break TEXT NOT NULL DEFAULT 'none';
+
+ALTER TABLE game_state
+ADD COLUMN reveal_rule TEXT NOT NULL DEFAULT 'unanimous',
+ADD COLUMN tie_break TEXT NOT NULL DEFAULT 'none';
+
+ALTER TABLE fibbing_it_rounds
+ADD COLUMN revote_candidates TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE fibbing_it_rounds
+DROP COLUMN revote_candidates;
+
+ALTER TABLE game_state
+DROP COLUMN reveal_rule,
+DROP COLUMN tie_break;
+
+ALTER TABLE room_settings
+DROP COLUMN reveal_rule,
+DROP COLUMN tie_break;
+
+-- +goose StatementEnd
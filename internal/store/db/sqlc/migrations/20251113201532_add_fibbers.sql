@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE room_settings
+ADD COLUMN fibbers INT NOT NULL DEFAULT 0;
+
+ALTER TABLE game_state
+ADD COLUMN fibbers INT NOT NULL DEFAULT 0;
+
+ALTER TABLE fibbing_it_votes
+DROP CONSTRAINT fibbing_it_votes_player_id_round_id_key,
+ADD CONSTRAINT fibbing_it_votes_player_id_round_id_voted_for_player_id_key
+UNIQUE (player_id, round_id, voted_for_player_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM fibbing_it_votes AS fv
+USING fibbing_it_votes AS newer
+WHERE fv.player_id = newer.player_id
+    AND fv.round_id = newer.round_id
+    AND fv.updated_at < newer.updated_at;
+
+ALTER TABLE fibbing_it_votes
+DROP CONSTRAINT fibbing_it_votes_player_id_round_id_voted_for_player_id_key,
+ADD CONSTRAINT fibbing_it_votes_player_id_round_id_key UNIQUE (player_id, round_id);
+
+ALTER TABLE game_state
+DROP COLUMN fibbers;
+
+ALTER TABLE room_settings
+DROP COLUMN fibbers;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE room_settings
+ADD COLUMN fibber_selection TEXT NOT NULL DEFAULT 'fair',
+ADD COLUMN fibber_rotation TEXT NOT NULL DEFAULT 'round_type';
+
+ALTER TABLE game_state
+ADD COLUMN fibber_selection TEXT NOT NULL DEFAULT 'random',
+ADD COLUMN fibber_rotation TEXT NOT NULL DEFAULT 'round_type';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE game_state
+DROP COLUMN fibber_selection,
+DROP COLUMN fibber_rotation;
+
+ALTER TABLE room_settings
+DROP COLUMN fibber_selection,
+DROP COLUMN fibber_rotation;
+
+-- +goose StatementEnd
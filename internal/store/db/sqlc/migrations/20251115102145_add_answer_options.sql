@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE questions_i18n
+ADD COLUMN answer_options TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE questions_i18n
+DROP COLUMN answer_options;
+
+-- +goose StatementEnd
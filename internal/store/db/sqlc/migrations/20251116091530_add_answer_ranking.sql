@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE fibbing_it_answers
+ADD COLUMN ranking TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE fibbing_it_answers
+DROP COLUMN ranking;
+
+-- +goose StatementEnd
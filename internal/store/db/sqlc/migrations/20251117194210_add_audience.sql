@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS audience_members (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    room_id UUID NOT NULL,
+    nickname TEXT NOT NULL,
+    avatar TEXT NOT NULL,
+    locale TEXT DEFAULT 'en-GB',
+    FOREIGN KEY (room_id) REFERENCES rooms (id)
+);
+
+CREATE TABLE IF NOT EXISTS fibbing_it_audience_votes (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    audience_member_id UUID NOT NULL,
+    voted_for_player_id UUID NOT NULL,
+    round_id UUID NOT NULL,
+    FOREIGN KEY (audience_member_id) REFERENCES audience_members (id) ON DELETE CASCADE,
+    FOREIGN KEY (voted_for_player_id) REFERENCES players (id),
+    FOREIGN KEY (round_id) REFERENCES fibbing_it_rounds (id),
+    UNIQUE (audience_member_id, round_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_audience_members_room_id ON audience_members (room_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_audience_members_room_id;
+DROP TABLE IF EXISTS fibbing_it_audience_votes;
+DROP TABLE IF EXISTS audience_members;
+
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS room_displays (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    room_id UUID NOT NULL,
+    locale TEXT DEFAULT 'en-GB',
+    FOREIGN KEY (room_id) REFERENCES rooms (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_room_displays_room_id ON room_displays (room_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_room_displays_room_id;
+DROP TABLE IF EXISTS room_displays;
+
+-- +goose StatementEnd
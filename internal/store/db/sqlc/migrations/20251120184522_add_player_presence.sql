@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE players
+ADD COLUMN disconnected_at TIMESTAMP,
+ADD COLUMN inactive_at TIMESTAMP;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE players
+DROP COLUMN disconnected_at,
+DROP COLUMN inactive_at;
+
+-- +goose StatementEnd
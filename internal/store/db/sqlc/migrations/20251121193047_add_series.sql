@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE room_settings
+ADD COLUMN series_games INT NOT NULL DEFAULT 1;
+
+ALTER TABLE game_state
+ADD COLUMN series_id UUID,
+ADD COLUMN series_game INT NOT NULL DEFAULT 1,
+ADD COLUMN series_games INT NOT NULL DEFAULT 1;
+
+ALTER TABLE rooms
+ADD COLUMN series_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_game_state_series_id ON game_state (series_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_game_state_series_id;
+
+ALTER TABLE rooms
+DROP COLUMN series_id;
+
+ALTER TABLE game_state
+DROP COLUMN series_id,
+DROP COLUMN series_game,
+DROP COLUMN series_games;
+
+ALTER TABLE room_settings
+DROP COLUMN series_games;
+
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS accounts (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    subject TEXT NOT NULL UNIQUE,
+    nickname TEXT NOT NULL DEFAULT '',
+    avatar TEXT NOT NULL DEFAULT '',
+    locale TEXT
+);
+
+ALTER TABLE players
+ADD COLUMN account_id UUID REFERENCES accounts (id);
+
+CREATE INDEX IF NOT EXISTS idx_players_account_id ON players (account_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_players_account_id;
+
+ALTER TABLE players
+DROP COLUMN account_id;
+
+DROP TABLE IF EXISTS accounts;
+
+-- +goose StatementEnd
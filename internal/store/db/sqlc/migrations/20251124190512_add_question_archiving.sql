@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Questions used in a round can't be deleted, so they are archived instead and hidden everywhere but old rounds.
+ALTER TABLE questions ADD COLUMN archived_at TIMESTAMP;
+ALTER TABLE questions_groups ADD COLUMN archived_at TIMESTAMP;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE questions_groups DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE questions DROP COLUMN IF EXISTS archived_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Curated pairs of a question shown to normal players and the question shown to the fibbers in the same round.
+CREATE TABLE IF NOT EXISTS question_pairs (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    normal_question_id UUID NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    fibber_question_id UUID NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    UNIQUE (normal_question_id, fibber_question_id),
+    CHECK (normal_question_id != fibber_question_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_question_pairs_fibber_question_id ON question_pairs (fibber_question_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_question_pairs_fibber_question_id;
+
+DROP TABLE IF EXISTS question_pairs;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- How many of the players' previous games to avoid repeating questions from, repeats within a game are always avoided.
+ALTER TABLE room_settings
+ADD COLUMN recent_games INT NOT NULL DEFAULT 0;
+
+ALTER TABLE game_state
+ADD COLUMN recent_games INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_fibbing_it_player_roles_player_id ON fibbing_it_player_roles (player_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS idx_fibbing_it_player_roles_player_id;
+
+ALTER TABLE game_state
+DROP COLUMN recent_games;
+
+ALTER TABLE room_settings
+DROP COLUMN recent_games;
+
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- The bonus points each scorer gave a player in a round, reason_points[i] is how many points reason_scorers[i] gave.
+ALTER TABLE fibbing_it_scores
+ADD COLUMN reason_scorers TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE fibbing_it_scores
+ADD COLUMN reason_points INT[] NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE fibbing_it_scores
+DROP COLUMN reason_points;
+
+ALTER TABLE fibbing_it_scores
+DROP COLUMN reason_scorers;
+
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Players who clearly won a spot before a revote, so they are still revealed once the revote is over.
+ALTER TABLE fibbing_it_rounds
+ADD COLUMN revote_winners TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE fibbing_it_rounds
+DROP COLUMN revote_winners;
+
+-- +goose StatementEnd
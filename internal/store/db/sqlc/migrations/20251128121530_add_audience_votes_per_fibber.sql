@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE fibbing_it_audience_votes
+DROP CONSTRAINT fibbing_it_audience_votes_audience_member_id_round_id_key,
+ADD CONSTRAINT fibbing_it_audience_votes_audience_member_id_round_id_voted_for_player_id_key
+UNIQUE (audience_member_id, round_id, voted_for_player_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM fibbing_it_audience_votes AS av
+USING fibbing_it_audience_votes AS newer
+WHERE av.audience_member_id = newer.audience_member_id
+    AND av.round_id = newer.round_id
+    AND av.updated_at < newer.updated_at;
+
+ALTER TABLE fibbing_it_audience_votes
+DROP CONSTRAINT fibbing_it_audience_votes_audience_member_id_round_id_voted_for_player_id_key,
+ADD CONSTRAINT fibbing_it_audience_votes_audience_member_id_round_id_key UNIQUE (audience_member_id, round_id);
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE game_state
+ALTER COLUMN fibber_selection SET DEFAULT 'fair';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE game_state
+ALTER COLUMN fibber_selection SET DEFAULT 'random';
+
+-- +goose StatementEnd
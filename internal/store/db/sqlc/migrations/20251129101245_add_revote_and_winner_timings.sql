@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE room_settings
+ADD COLUMN revote_seconds INT NOT NULL DEFAULT 20,
+ADD COLUMN winner_seconds INT NOT NULL DEFAULT 15;
+
+ALTER TABLE game_state
+ADD COLUMN revote_seconds INT NOT NULL DEFAULT 20,
+ADD COLUMN winner_seconds INT NOT NULL DEFAULT 15;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE game_state
+DROP COLUMN winner_seconds,
+DROP COLUMN revote_seconds;
+
+ALTER TABLE room_settings
+DROP COLUMN winner_seconds,
+DROP COLUMN revote_seconds;
+
+-- +goose StatementEnd
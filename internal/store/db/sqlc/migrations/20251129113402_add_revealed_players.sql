@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Players the group revealed at the end of the round, so stats can tell if a fibber was caught.
+ALTER TABLE fibbing_it_rounds
+ADD COLUMN revealed_players TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE fibbing_it_rounds
+DROP COLUMN revealed_players;
+
+-- +goose StatementEnd
@@ -15,6 +15,15 @@ INSERT INTO rooms_players (room_id, player_id) VALUES ($1, $2) RETURNING *;
 DELETE FROM rooms_players
 WHERE player_id = $1 RETURNING *;
 
+-- name: MoveActivePlayersToRoom :exec
+UPDATE rooms_players AS rp
+SET room_id = $1, updated_at = CURRENT_TIMESTAMP
+FROM players AS p
+WHERE
+    rp.player_id = p.id
+    AND rp.room_id = $2
+    AND p.inactive_at IS NULL;
+
 -- name: UpdateRoomState :one
 UPDATE rooms SET room_state = $1
 WHERE id = $2 RETURNING *;
@@ -39,14 +48,61 @@ WHERE id = $2 RETURNING *;
 UPDATE players SET is_ready = NOT is_ready
 WHERE id = $1 RETURNING *;
 
+-- name: ResetPlayersReadyInRoom :exec
+UPDATE players SET is_ready = FALSE
+WHERE id IN (
+    SELECT rp.player_id
+    FROM rooms_players AS rp
+    WHERE rp.room_id = $1
+);
+
+-- name: SetPlayerDisconnected :exec
+UPDATE players SET disconnected_at = NOW()
+WHERE id = $1;
+
+-- name: SetPlayerInactive :execrows
+UPDATE players SET inactive_at = NOW()
+WHERE
+    id = $1
+    AND inactive_at IS NULL
+    AND disconnected_at IS NOT NULL
+    AND disconnected_at <= $2;
+
+-- name: SetPlayerActive :exec
+UPDATE players SET disconnected_at = NULL, inactive_at = NULL
+WHERE id = $1;
+
 -- name: AddFibbingItRound :one
 INSERT INTO fibbing_it_rounds (
-    id, round_type, round, fibber_question_id, normal_question_id, game_state_id
-) VALUES ($1, $2, $3, $4, $5, $6) RETURNING *;
+    id, round_type, round, fibber_question_id, normal_question_id, game_state_id, round_type_index
+) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *;
 
 -- name: AddGameState :one
-INSERT INTO game_state (id, room_id, submit_deadline, state) VALUES (
-    $1, $2, $3, $4
+INSERT INTO game_state (
+    id,
+    room_id,
+    submit_deadline,
+    state,
+    max_rounds,
+    round_types,
+    question_seconds,
+    voting_seconds,
+    reveal_seconds,
+    score_seconds,
+    scorers,
+    reveal_rule,
+    tie_break,
+    fibbers,
+    fibber_selection,
+    fibber_rotation,
+    series_id,
+    series_game,
+    series_games,
+    recent_games,
+    revote_seconds,
+    winner_seconds
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
 ) RETURNING *;
 
 -- name: UpdateGameState :one
@@ -54,11 +110,12 @@ UPDATE game_state SET state = $1, submit_deadline = $2
 WHERE id = $3 RETURNING *;
 
 -- name: UpsertFibbingItAnswer :one
-INSERT INTO fibbing_it_answers (id, answer, round_id, player_id)
-VALUES ($1, $2, $3, $4)
+INSERT INTO fibbing_it_answers (id, answer, round_id, player_id, ranking)
+VALUES ($1, $2, $3, $4, $5)
 ON CONFLICT (player_id, round_id) DO UPDATE
     SET
         answer = excluded.answer,
+        ranking = excluded.ranking,
         updated_at = CURRENT_TIMESTAMP
 RETURNING *;
 
@@ -68,16 +125,39 @@ INSERT INTO fibbing_it_player_roles (
 ) VALUES ($1, $2, $3, $4) RETURNING *;
 
 -- name: UpsertFibbingItVote :exec
-INSERT INTO fibbing_it_votes (id, player_id, voted_for_player_id, round_id)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (player_id, round_id) DO UPDATE
+INSERT INTO fibbing_it_votes (id, player_id, voted_for_player_id, round_id, is_ready)
+VALUES ($1, $2, $3, $4, (
+    SELECT COALESCE(BOOL_OR(fv.is_ready), FALSE)
+    FROM fibbing_it_votes fv
+    WHERE fv.player_id = $2 AND fv.round_id = $4
+))
+ON CONFLICT (player_id, round_id, voted_for_player_id) DO UPDATE
     SET
-        updated_at = CURRENT_TIMESTAMP,
-        player_id = excluded.player_id,
-        voted_for_player_id = excluded.voted_for_player_id,
-        round_id = excluded.round_id
+        updated_at = CURRENT_TIMESTAMP
 RETURNING *;
 
+-- name: DeleteFibbingItVotesByRoundID :exec
+DELETE FROM fibbing_it_votes
+WHERE round_id = $1;
+
+-- name: DeleteOldestFibbingItVotes :exec
+DELETE FROM fibbing_it_votes
+WHERE id IN (
+    SELECT fv.id
+    FROM fibbing_it_votes fv
+    WHERE fv.player_id = $1 AND fv.round_id = $2
+    ORDER BY fv.updated_at DESC
+    OFFSET $3
+);
+
+-- name: UpdateFibbingItRoundRevoteCandidates :exec
+UPDATE fibbing_it_rounds SET revote_candidates = $1, revote_winners = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $3;
+
+-- name: UpdateFibbingItRoundRevealedPlayers :exec
+UPDATE fibbing_it_rounds SET revealed_players = $1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $2;
+
 -- name: GetAllPlayersInRoom :many
 SELECT
     p.id,
@@ -85,6 +165,8 @@ SELECT
     p.avatar,
     p.locale,
     p.is_ready,
+    p.disconnected_at,
+    p.inactive_at,
     r.host_player,
     r.room_code
 FROM players AS p
@@ -99,7 +181,8 @@ SELECT
     p.id,
     p.nickname,
     p.avatar,
-    p.locale
+    p.locale,
+    p.inactive_at
 FROM players AS p
 JOIN rooms_players AS rp ON p.id = rp.player_id
 JOIN game_state AS gs ON rp.room_id = gs.room_id
@@ -141,7 +224,25 @@ SELECT
     gs.state,
     gs.pause_time_remaining_ms,
     gs.paused_at,
-    gs.pause_deadline
+    gs.pause_deadline,
+    gs.max_rounds,
+    gs.round_types,
+    gs.question_seconds,
+    gs.voting_seconds,
+    gs.reveal_seconds,
+    gs.score_seconds,
+    gs.scorers,
+    gs.reveal_rule,
+    gs.tie_break,
+    gs.fibbers,
+    gs.fibber_selection,
+    gs.fibber_rotation,
+    gs.series_id,
+    gs.series_game,
+    gs.series_games,
+    gs.recent_games,
+    gs.revote_seconds,
+    gs.winner_seconds
 FROM game_state AS gs
 JOIN rooms_players AS rp ON gs.room_id = rp.room_id
 WHERE rp.player_id = $1;
@@ -156,7 +257,25 @@ SELECT
     gs.state,
     gs.pause_time_remaining_ms,
     gs.paused_at,
-    gs.pause_deadline
+    gs.pause_deadline,
+    gs.max_rounds,
+    gs.round_types,
+    gs.question_seconds,
+    gs.voting_seconds,
+    gs.reveal_seconds,
+    gs.score_seconds,
+    gs.scorers,
+    gs.reveal_rule,
+    gs.tie_break,
+    gs.fibbers,
+    gs.fibber_selection,
+    gs.fibber_rotation,
+    gs.series_id,
+    gs.series_game,
+    gs.series_games,
+    gs.recent_games,
+    gs.revote_seconds,
+    gs.winner_seconds
 FROM game_state gs
 WHERE gs.id = $1;
 
@@ -193,12 +312,72 @@ FOR UPDATE OF r NOWAIT;
 
 -- name: GetRoomByCode :one
 SELECT * FROM rooms
-WHERE room_code = $1;
+WHERE room_code = $1
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: GetRoomByGameStateID :one
+SELECT r.*
+FROM rooms AS r
+JOIN game_state AS gs ON r.id = gs.room_id
+WHERE gs.id = $1;
+
+-- name: GetRoomSettings :one
+SELECT * FROM room_settings
+WHERE room_id = $1;
+
+-- name: UpsertRoomSettings :one
+INSERT INTO room_settings (
+    room_id,
+    max_rounds,
+    round_types,
+    question_seconds,
+    voting_seconds,
+    reveal_seconds,
+    score_seconds,
+    scorers,
+    reveal_rule,
+    tie_break,
+    fibbers,
+    fibber_selection,
+    fibber_rotation,
+    series_games,
+    recent_games,
+    revote_seconds,
+    winner_seconds
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+ON CONFLICT (room_id) DO UPDATE SET
+    max_rounds = excluded.max_rounds,
+    round_types = excluded.round_types,
+    question_seconds = excluded.question_seconds,
+    voting_seconds = excluded.voting_seconds,
+    reveal_seconds = excluded.reveal_seconds,
+    score_seconds = excluded.score_seconds,
+    scorers = excluded.scorers,
+    reveal_rule = excluded.reveal_rule,
+    tie_break = excluded.tie_break,
+    fibbers = excluded.fibbers,
+    fibber_selection = excluded.fibber_selection,
+    fibber_rotation = excluded.fibber_rotation,
+    series_games = excluded.series_games,
+    recent_games = excluded.recent_games,
+    revote_seconds = excluded.revote_seconds,
+    winner_seconds = excluded.winner_seconds,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
 
 -- name: GetLatestRoundByPlayerID :one
 SELECT
     fir.*,
-    gs.submit_deadline
+    gs.submit_deadline,
+    gs.max_rounds,
+    gs.round_types,
+    gs.scorers,
+    gs.reveal_rule,
+    gs.tie_break,
+    gs.fibbers,
+    gs.fibber_selection,
+    gs.fibber_rotation
 FROM fibbing_it_rounds AS fir
 JOIN game_state AS gs ON fir.game_state_id = gs.id
 JOIN rooms_players AS rp ON gs.room_id = rp.room_id
@@ -209,9 +388,23 @@ LIMIT 1;
 -- name: GetLatestRoundByGameStateID :one
 SELECT
     fir.*,
-    gs.submit_deadline
+    gs.submit_deadline,
+    gs.max_rounds,
+    gs.round_types,
+    gs.scorers,
+    gs.reveal_rule,
+    gs.tie_break,
+    gs.fibbers,
+    gs.fibber_selection,
+    gs.fibber_rotation,
+    gs.series_id,
+    gs.series_game,
+    gs.series_games,
+    gs.recent_games,
+    r.game_name
 FROM fibbing_it_rounds AS fir
 JOIN game_state AS gs ON fir.game_state_id = gs.id
+JOIN rooms AS r ON gs.room_id = r.id
 WHERE gs.id = $1
 ORDER BY fir.created_at DESC
 LIMIT 1;
@@ -267,7 +460,8 @@ SELECT
     COALESCE(fia.answer, '') AS current_answer,
     COALESCE(fia.is_ready, FALSE) AS is_answer_ready,
     fr.normal_question_id,
-    fr.fibber_question_id
+    fr.fibber_question_id,
+    p.inactive_at
 FROM game_state AS gs
 JOIN fibbing_it_rounds AS fr
     ON
@@ -289,14 +483,25 @@ LEFT JOIN
 WHERE gs.id = $1
 ORDER BY p.nickname ASC;
 
--- name: GetFibberByRoundID :one
+-- name: GetFibberCountsByGameStateID :many
+SELECT
+    fpr.player_id,
+    COUNT(*) AS fibber_count
+FROM fibbing_it_player_roles AS fpr
+JOIN fibbing_it_rounds AS fr ON fpr.round_id = fr.id
+WHERE fr.game_state_id = $1 AND fpr.player_role = 'fibber'
+GROUP BY fpr.player_id;
+
+-- name: GetFibbersByRoundID :many
 SELECT *
 FROM fibbing_it_player_roles
-WHERE round_id = $1 AND player_role = 'fibber';
+WHERE round_id = $1 AND player_role = 'fibber'
+ORDER BY created_at ASC;
 
 -- name: GetVotingState :many
 SELECT
     fir.round AS round,
+    fir.round_type,
     gs.id AS game_state_id,
     qi.question,
     gs.submit_deadline,
@@ -305,8 +510,10 @@ SELECT
     p.avatar,
     COALESCE(vote_counts.votes, 0) AS votes,
     fia.answer,
+    fia.ranking,
     COALESCE(voter_ready.is_ready, FALSE) AS is_ready,
-    fpr.player_role AS role
+    fpr.player_role AS role,
+    p.inactive_at
 FROM fibbing_it_rounds AS fir
 JOIN questions AS q ON fir.normal_question_id = q.id
 JOIN questions_i18n AS qi ON q.id = qi.question_id AND qi.locale = 'en-GB'
@@ -354,11 +561,12 @@ WITH latest_round AS (
     LIMIT 1
 )
 
-SELECT COUNT(rp.*) = SUM(CASE WHEN COALESCE(fa.is_ready, FALSE) THEN 1 ELSE 0 END) AS all_players_ready
+SELECT COUNT(rp.*) = COALESCE(SUM(CASE WHEN COALESCE(fa.is_ready, FALSE) THEN 1 ELSE 0 END), 0) AS all_players_ready
 FROM rooms_players rp
 CROSS JOIN latest_round lr
+JOIN players p ON p.id = rp.player_id
 LEFT JOIN fibbing_it_answers fa ON fa.player_id = rp.player_id AND fa.round_id = lr.round_id
-WHERE rp.room_id = lr.room_id;
+WHERE rp.room_id = lr.room_id AND p.inactive_at IS NULL;
 
 -- name: GetAllPlayerAnswerIsReadyByPlayerID :one
 WITH latest_round AS (
@@ -373,15 +581,21 @@ WITH latest_round AS (
     LIMIT 1
 )
 
-SELECT COUNT(rp.*) = SUM(CASE WHEN COALESCE(fa.is_ready, FALSE) THEN 1 ELSE 0 END) AS all_players_ready
+SELECT COUNT(rp.*) = COALESCE(SUM(CASE WHEN COALESCE(fa.is_ready, FALSE) THEN 1 ELSE 0 END), 0) AS all_players_ready
 FROM rooms_players rp
 CROSS JOIN latest_round lr
+JOIN players p ON p.id = rp.player_id
 LEFT JOIN fibbing_it_answers fa ON fa.player_id = rp.player_id AND fa.round_id = lr.round_id
-WHERE rp.room_id = lr.room_id;
+WHERE rp.room_id = lr.room_id AND p.inactive_at IS NULL;
 
 -- name: ToggleVotingIsReady :one
 UPDATE fibbing_it_votes SET is_ready = NOT is_ready
-WHERE player_id = $1 RETURNING *;
+WHERE player_id = $1 AND round_id = $2 RETURNING *;
+
+-- name: CountVotesByPlayerIDAndRoundID :one
+SELECT COUNT(*)
+FROM fibbing_it_votes
+WHERE player_id = $1 AND round_id = $2;
 
 -- name: GetAllPlayersVotingIsReady :one
 WITH latest_round AS (
@@ -395,18 +609,23 @@ WITH latest_round AS (
     LIMIT 1
 )
 
-SELECT COUNT(rp.*) = SUM(CASE WHEN COALESCE(fv.is_ready, FALSE) THEN 1 ELSE 0 END) AS all_players_ready
+SELECT
+    COUNT(DISTINCT rp.player_id)
+    = COUNT(DISTINCT fv.player_id) FILTER (WHERE fv.is_ready)
+        AS all_players_ready
 FROM rooms_players rp
 CROSS JOIN latest_round lr
+JOIN players p ON p.id = rp.player_id
 LEFT JOIN fibbing_it_votes fv ON fv.player_id = rp.player_id AND fv.round_id = lr.round_id
-WHERE rp.room_id = lr.room_id;
+WHERE rp.room_id = lr.room_id AND p.inactive_at IS NULL;
 
 -- name: GetAllPlayersVotingIsReadyByPlayerID :one
 SELECT
-    COUNT(*)
-    = SUM(CASE WHEN COALESCE(fa.is_ready, FALSE) THEN 1 ELSE 0 END)
+    COUNT(DISTINCT rp.player_id)
+    = COUNT(DISTINCT fa.player_id) FILTER (WHERE fa.is_ready)
         AS all_players_ready
 FROM rooms_players rp
+JOIN players p ON p.id = rp.player_id
 LEFT JOIN fibbing_it_votes fa
     ON fa.player_id = rp.player_id AND fa.round_id = (
         SELECT fir.id
@@ -422,13 +641,22 @@ WHERE rp.room_id = (
     FROM rooms_players rp
     WHERE rp.player_id = $1
     LIMIT 1
-);
+)
+AND p.inactive_at IS NULL;
 
 -- name: AddFibbingItScore :one
-INSERT INTO fibbing_it_scores (id, player_id, score, round_id) VALUES (
-    $1, $2, $3, $4
+INSERT INTO fibbing_it_scores (id, player_id, score, round_id, reason_scorers, reason_points) VALUES (
+    $1, $2, $3, $4, $5, $6
 ) RETURNING *;
 
+-- name: GetScoreReasonsByRoundID :many
+SELECT
+    player_id,
+    reason_scorers,
+    reason_points
+FROM fibbing_it_scores
+WHERE round_id = $1;
+
 -- name: GetAllVotesForRoundByGameStateID :many
 WITH latest_round_type AS (
     SELECT round_type
@@ -444,15 +672,18 @@ SELECT
     p1.avatar AS voter_avatar,
     v.voted_for_player_id AS voted_for_id,
     p2.nickname AS voted_for_nickname,
-    r.player_id AS fibber_id,
-    p3.nickname AS fibber_nickname,
-    v.round_id
+    COALESCE(voter_role.player_role = 'fibber', FALSE) AS voter_is_fibber,
+    COALESCE(voted_for_role.player_role = 'fibber', FALSE) AS voted_for_fibber,
+    v.round_id,
+    v.updated_at AS voted_at
 FROM fibbing_it_votes v
 JOIN players p1 ON v.player_id = p1.id
 JOIN players p2 ON v.voted_for_player_id = p2.id
 JOIN fibbing_it_rounds fr ON v.round_id = fr.id
-JOIN fibbing_it_player_roles r ON fr.id = r.round_id AND r.player_role = 'fibber'
-JOIN players p3 ON r.player_id = p3.id
+LEFT JOIN fibbing_it_player_roles voter_role
+    ON fr.id = voter_role.round_id AND v.player_id = voter_role.player_id
+LEFT JOIN fibbing_it_player_roles voted_for_role
+    ON fr.id = voted_for_role.round_id AND v.voted_for_player_id = voted_for_role.player_id
 CROSS JOIN latest_round_type lrt
 WHERE fr.game_state_id = $1 AND fr.round_type = lrt.round_type
 ORDER BY v.round_id DESC;
@@ -481,14 +712,187 @@ GROUP BY
     p.avatar,
     p.nickname;
 
+-- name: GetSeriesScores :many
+SELECT
+    s.player_id,
+    p.avatar,
+    p.nickname,
+    SUM(s.score) AS total_score
+FROM
+    fibbing_it_scores s
+JOIN
+    fibbing_it_rounds r
+    ON s.round_id = r.id
+JOIN
+    game_state gs
+    ON r.game_state_id = gs.id
+JOIN
+    players p
+    ON s.player_id = p.id
+WHERE
+    gs.series_id = $1 AND gs.id != $2
+GROUP BY
+    s.player_id,
+    p.avatar,
+    p.nickname;
+
+-- name: GetRecapRoundsByGameStateID :many
+SELECT
+    fr.id,
+    fr.round,
+    fr.round_type,
+    COALESCE(nq.question, nqd.question, '') AS normal_question,
+    COALESCE(fq.question, fqd.question, '') AS fibber_question
+FROM fibbing_it_rounds AS fr
+LEFT JOIN questions_i18n AS nq
+    ON fr.normal_question_id = nq.question_id AND nq.locale = sqlc.arg(locale)
+LEFT JOIN questions_i18n AS nqd
+    ON fr.normal_question_id = nqd.question_id AND nqd.locale = sqlc.arg(default_locale)
+LEFT JOIN questions_i18n AS fq
+    ON fr.fibber_question_id = fq.question_id AND fq.locale = sqlc.arg(locale)
+LEFT JOIN questions_i18n AS fqd
+    ON fr.fibber_question_id = fqd.question_id AND fqd.locale = sqlc.arg(default_locale)
+WHERE fr.game_state_id = sqlc.arg(game_state_id)
+ORDER BY fr.created_at ASC;
+
+-- name: GetRecapAnswersByGameStateID :many
+SELECT
+    fr.id AS round_id,
+    p.id AS player_id,
+    p.nickname,
+    p.avatar,
+    fpr.player_role AS role,
+    COALESCE(fia.answer, '') AS answer,
+    fia.ranking,
+    COALESCE((
+        SELECT SUM(fs.score)
+        FROM fibbing_it_scores AS fs
+        WHERE fs.round_id = fr.id AND fs.player_id = p.id
+    ), 0)::BIGINT AS score,
+    fr.created_at AS round_started_at,
+    fia.created_at AS answered_at
+FROM fibbing_it_player_roles AS fpr
+JOIN fibbing_it_rounds AS fr ON fpr.round_id = fr.id
+JOIN players AS p ON fpr.player_id = p.id
+LEFT JOIN fibbing_it_answers AS fia
+    ON fr.id = fia.round_id AND p.id = fia.player_id
+WHERE fr.game_state_id = $1
+ORDER BY fr.created_at ASC, p.nickname ASC;
+
+-- name: GetRecapVotesByGameStateID :many
+SELECT
+    v.round_id,
+    v.player_id AS voter_id,
+    p1.nickname AS voter_nickname,
+    v.voted_for_player_id AS voted_for_id,
+    p2.nickname AS voted_for_nickname
+FROM fibbing_it_votes AS v
+JOIN fibbing_it_rounds AS fr ON v.round_id = fr.id
+JOIN players AS p1 ON v.player_id = p1.id
+JOIN players AS p2 ON v.voted_for_player_id = p2.id
+WHERE fr.game_state_id = $1
+ORDER BY fr.created_at ASC, p1.nickname ASC;
+
+-- name: GetAccountBySubject :one
+SELECT * FROM accounts WHERE subject = $1;
+
+-- name: UpsertAccount :one
+INSERT INTO accounts (id, subject) VALUES ($1, $2)
+ON CONFLICT (subject) DO UPDATE SET updated_at = NOW()
+RETURNING *;
+
+-- name: UpdateAccountProfile :one
+UPDATE accounts
+SET nickname = $1, avatar = $2, locale = $3, updated_at = NOW()
+WHERE id = $4
+RETURNING *;
+
+-- name: LinkPlayerToAccount :exec
+UPDATE players AS p
+SET
+    account_id = a.id,
+    avatar = CASE WHEN a.avatar = '' THEN p.avatar ELSE a.avatar END
+FROM accounts AS a
+WHERE a.id = $1 AND p.id = $2;
+
+-- name: GetAccountStats :one
+WITH account_games AS (
+    SELECT DISTINCT
+        fr.game_state_id,
+        fpr.player_id
+    FROM fibbing_it_player_roles AS fpr
+    JOIN fibbing_it_rounds AS fr ON fpr.round_id = fr.id
+    JOIN players AS p ON fpr.player_id = p.id
+    WHERE p.account_id = $1
+),
+
+game_scores AS (
+    SELECT
+        fr.game_state_id,
+        s.player_id,
+        SUM(s.score) AS total_score
+    FROM fibbing_it_scores AS s
+    JOIN fibbing_it_rounds AS fr ON s.round_id = fr.id
+    WHERE fr.game_state_id IN (SELECT game_state_id FROM account_games)
+    GROUP BY fr.game_state_id, s.player_id
+),
+
+fibber_rounds AS (
+    SELECT fpr.player_id::TEXT = ANY(fr.revealed_players) AS revealed
+    FROM fibbing_it_player_roles AS fpr
+    JOIN fibbing_it_rounds AS fr ON fpr.round_id = fr.id
+    JOIN players AS p ON fpr.player_id = p.id
+    WHERE p.account_id = $1 AND fpr.player_role = 'fibber'
+)
+
+SELECT
+    (SELECT COUNT(DISTINCT game_state_id) FROM account_games) AS games_played,
+    (
+        SELECT COUNT(*)
+        FROM account_games AS ag
+        JOIN game_state AS gs ON ag.game_state_id = gs.id
+        JOIN game_scores AS gsc
+            ON ag.game_state_id = gsc.game_state_id AND ag.player_id = gsc.player_id
+        WHERE
+            gs.state = 'FibbingItWinner'
+            AND gsc.total_score = (
+                SELECT MAX(best.total_score) FROM game_scores AS best
+                WHERE best.game_state_id = ag.game_state_id
+            )
+    ) AS wins,
+    (SELECT COUNT(*) FROM fibber_rounds) AS fibber_rounds,
+    -- INFO: A fibber got away with it if the group didn't reveal them at the end of the round.
+    (SELECT COUNT(*) FROM fibber_rounds WHERE NOT revealed) AS fibber_successes;
+
+-- name: GetLeaderboard :many
+-- INFO: Players who are signed in are ranked by their account, anonymous players are ranked by their nickname.
+SELECT
+    p.account_id,
+    COALESCE(NULLIF(MAX(a.nickname), ''), MAX(p.nickname))::TEXT AS nickname,
+    COALESCE(NULLIF(MAX(a.avatar), ''), MAX(p.avatar))::TEXT AS avatar,
+    SUM(s.score)::BIGINT AS total_score,
+    COUNT(DISTINCT fr.game_state_id) AS games_played
+FROM fibbing_it_scores AS s
+JOIN fibbing_it_rounds AS fr ON s.round_id = fr.id
+JOIN game_state AS gs ON fr.game_state_id = gs.id
+JOIN players AS p ON s.player_id = p.id
+LEFT JOIN accounts AS a ON p.account_id = a.id
+WHERE
+    gs.state = 'FibbingItWinner'
+    AND s.created_at >= sqlc.arg(since)
+    AND (sqlc.arg(round_type)::TEXT = '' OR fr.round_type = sqlc.arg(round_type)::TEXT)
+GROUP BY p.account_id, CASE WHEN p.account_id IS NULL THEN p.nickname ELSE '' END
+ORDER BY total_score DESC, nickname ASC
+LIMIT sqlc.arg(max_entries);
+
 -- name: AddQuestion :one
 INSERT INTO questions (id, game_name, group_id, round_type) VALUES (
     $1, $2, $3, $4
 ) RETURNING *;
 
 -- name: AddQuestionTranslation :one
-INSERT INTO questions_i18n (id, question, locale, question_id) VALUES (
-    $1, $2, $3, $4
+INSERT INTO questions_i18n (id, question, locale, question_id, answer_options) VALUES (
+    $1, $2, $3, $4, $5
 ) RETURNING *;
 
 -- name: GetRandomQuestionByRound :many
@@ -506,6 +910,8 @@ JOIN (
         q.game_name = $1
         AND q.round_type = $2
         AND q.enabled = TRUE
+        AND q.archived_at IS NULL
+        AND NOT (q.id = ANY(sqlc.arg(excluded_question_ids)::uuid[]))
     ORDER BY RANDOM()
     LIMIT 1
 ) random_question ON qi.question_id = random_question.id;
@@ -523,7 +929,9 @@ JOIN (
         (sqlc.arg(group_type)::text = '' OR qg.group_type = sqlc.arg(group_type))
         AND q.group_id = sqlc.arg(group_id)
         AND q.enabled = TRUE
+        AND q.archived_at IS NULL
         AND q.id != sqlc.arg(excluded_question_id)
+        AND NOT (q.id = ANY(sqlc.arg(excluded_question_ids)::uuid[]))
         AND q.round_type = sqlc.arg(round_type)
     ORDER BY RANDOM()
     LIMIT 1
@@ -538,13 +946,33 @@ FROM questions_i18n qi
 JOIN questions q ON qi.question_id = q.id
 WHERE q.id = $1;
 
+-- name: GetAnswerOptionsByPlayerID :many
+WITH latest_round AS (
+    SELECT
+        fr.id,
+        fr.normal_question_id
+    FROM fibbing_it_rounds AS fr
+    JOIN game_state AS gs ON fr.game_state_id = gs.id
+    JOIN rooms_players AS rp ON gs.room_id = rp.room_id
+    WHERE rp.player_id = $1
+    ORDER BY fr.created_at DESC
+    LIMIT 1
+)
+SELECT
+    qi.locale,
+    qi.answer_options,
+    p.locale AS player_locale
+FROM latest_round AS lr
+JOIN players AS p ON p.id = $1
+JOIN questions_i18n AS qi ON lr.normal_question_id = qi.question_id;
+
 -- name: DisableQuestion :one
 UPDATE questions SET enabled = FALSE
 WHERE id = $1 RETURNING *;
 
 -- name: EnableQuestion :one
 UPDATE questions SET enabled = TRUE
-WHERE id = $1 RETURNING *;
+WHERE id = $1 AND archived_at IS NULL RETURNING *;
 
 -- name: AddGroup :one
 INSERT INTO questions_groups (id, group_name, group_type)
@@ -555,6 +983,8 @@ RETURNING *;
 SELECT *
 FROM
     questions_groups
+WHERE
+    archived_at IS NULL
 ORDER BY group_name DESC;
 
 -- name: GetGroupByName :one
@@ -562,13 +992,15 @@ SELECT *
 FROM
     questions_groups
 WHERE
-    group_name = $1;
+    group_name = $1
+    AND archived_at IS NULL;
 
 -- name: GetQuestions :many
 SELECT
     q.*,
     qi.question,
     qi.locale,
+    qi.answer_options,
     qg.group_name,
     qg.group_type
 FROM questions q
@@ -579,9 +1011,179 @@ WHERE
     AND ($2::text = '' OR q.round_type = $2)
     AND ($3::text = '' OR qg.group_name = $3)
     AND ($4::boolean IS NULL OR q.enabled = $4)
+    AND q.archived_at IS NULL
 ORDER BY q.created_at DESC
 LIMIT $5 OFFSET $6;
 
+-- name: GetQuestionPack :many
+SELECT
+    q.id,
+    q.round_type,
+    q.enabled,
+    qg.group_name,
+    qi.locale,
+    qi.question,
+    qi.answer_options
+FROM questions AS q
+JOIN questions_groups AS qg ON q.group_id = qg.id
+JOIN questions_i18n AS qi ON q.id = qi.question_id
+WHERE
+    (sqlc.arg(group_name)::TEXT = '' OR qg.group_name = sqlc.arg(group_name)::TEXT)
+    AND q.archived_at IS NULL
+ORDER BY qg.group_name ASC, q.created_at ASC, q.id ASC, qi.locale ASC;
+
+-- name: GetDuplicateQuestionTranslations :many
+SELECT
+    qi.question,
+    qi.locale
+FROM questions_i18n AS qi
+JOIN UNNEST(sqlc.arg(questions)::TEXT [], sqlc.arg(locales)::TEXT []) AS t (question, locale)
+    ON qi.question = t.question AND qi.locale = t.locale;
+
+-- name: GetQuestionByID :one
+SELECT *
+FROM questions
+WHERE id = $1 AND archived_at IS NULL;
+
+-- name: UpdateQuestion :one
+UPDATE questions
+SET group_id = $2, round_type = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND archived_at IS NULL
+RETURNING *;
+
+-- name: UpdateQuestionTranslation :one
+UPDATE questions_i18n
+SET
+    question = sqlc.arg(question),
+    answer_options = sqlc.arg(answer_options),
+    updated_at = CURRENT_TIMESTAMP
+WHERE question_id = sqlc.arg(question_id) AND locale = sqlc.arg(locale)
+RETURNING *;
+
+-- name: DeleteQuestionTranslation :execrows
+DELETE FROM questions_i18n
+WHERE question_id = $1 AND locale = $2;
+
+-- name: DeleteQuestionTranslations :exec
+DELETE FROM questions_i18n
+WHERE question_id = $1;
+
+-- name: IsQuestionInRounds :one
+SELECT EXISTS (
+    SELECT 1
+    FROM fibbing_it_rounds
+    WHERE fibber_question_id = sqlc.arg(question_id) OR normal_question_id = sqlc.arg(question_id)
+) AS in_rounds;
+
+-- name: ArchiveQuestion :exec
+UPDATE questions
+SET
+    enabled = FALSE,
+    archived_at = COALESCE(archived_at, CURRENT_TIMESTAMP),
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: DeleteQuestion :exec
+DELETE FROM questions
+WHERE id = $1;
+
+-- name: GetQuestionIDsInGroup :many
+SELECT id
+FROM questions
+WHERE group_id = $1;
+
+-- name: GetGroupByID :one
+SELECT *
+FROM questions_groups
+WHERE id = $1 AND archived_at IS NULL;
+
+-- name: UpdateGroupName :one
+UPDATE questions_groups
+SET group_name = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND archived_at IS NULL
+RETURNING *;
+
+-- name: ArchiveGroup :exec
+UPDATE questions_groups
+SET archived_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: DeleteGroup :exec
+DELETE FROM questions_groups
+WHERE id = $1;
+
+-- name: GetRandomQuestionPair :one
+SELECT qp.*
+FROM question_pairs qp
+JOIN questions nq ON qp.normal_question_id = nq.id
+JOIN questions fq ON qp.fibber_question_id = fq.id
+WHERE
+    nq.game_name = $1
+    AND nq.round_type = $2
+    AND fq.round_type = $2
+    AND nq.enabled = TRUE
+    AND fq.enabled = TRUE
+    AND nq.archived_at IS NULL
+    AND fq.archived_at IS NULL
+    AND NOT (nq.id = ANY(sqlc.arg(excluded_question_ids)::uuid[]))
+    AND NOT (fq.id = ANY(sqlc.arg(excluded_question_ids)::uuid[]))
+ORDER BY RANDOM()
+LIMIT 1;
+
+-- name: AddQuestionPair :one
+INSERT INTO question_pairs (id, normal_question_id, fibber_question_id)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetQuestionPairs :many
+SELECT *
+FROM question_pairs
+WHERE normal_question_id = sqlc.arg(question_id) OR fibber_question_id = sqlc.arg(question_id)
+ORDER BY created_at;
+
+-- name: DeleteQuestionPair :execrows
+DELETE FROM question_pairs
+WHERE normal_question_id = $1 AND fibber_question_id = $2;
+
+-- name: GetUsedQuestionIDs :many
+SELECT
+    fr.game_state_id,
+    fr.normal_question_id,
+    fr.fibber_question_id
+FROM fibbing_it_rounds AS fr
+WHERE fr.game_state_id = sqlc.arg(game_state_id)
+    OR fr.game_state_id IN (
+        SELECT gs.id
+        FROM game_state AS gs
+        WHERE gs.id != sqlc.arg(game_state_id) AND EXISTS (
+            SELECT 1
+            FROM fibbing_it_rounds AS pr
+            JOIN fibbing_it_player_roles AS fpr ON pr.id = fpr.round_id
+            WHERE pr.game_state_id = gs.id AND fpr.player_id = ANY(sqlc.arg(player_ids)::uuid[])
+        )
+        ORDER BY gs.created_at DESC
+        LIMIT sqlc.arg(recent_games)
+    );
+
+-- name: ContinueSeries :exec
+UPDATE rooms
+SET series_id = (
+    SELECT gs.series_id
+    FROM game_state AS gs
+    WHERE gs.room_id = $2 AND gs.series_game < gs.series_games
+)
+WHERE id = $1;
+
+-- name: GetLatestGameInSeries :one
+SELECT
+    gs.series_id,
+    gs.series_game,
+    gs.series_games
+FROM game_state AS gs
+WHERE gs.series_id = $1
+ORDER BY gs.series_game DESC
+LIMIT 1;
+
 -- name: ReassignHostPlayer :one
 UPDATE rooms
 SET host_player = $2
@@ -659,3 +1261,102 @@ WHERE
     paused_at IS NOT NULL
     AND pause_deadline < CURRENT_TIMESTAMP
 RETURNING id;
+
+-- name: UpsertAudienceMember :one
+INSERT INTO audience_members (id, room_id, nickname, avatar, locale)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE
+    SET
+        room_id = excluded.room_id,
+        nickname = excluded.nickname,
+        avatar = excluded.avatar,
+        locale = excluded.locale,
+        updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetAudienceMemberByID :one
+SELECT
+    am.id,
+    am.nickname,
+    am.avatar,
+    am.locale,
+    r.room_code,
+    r.room_state,
+    r.host_player
+FROM audience_members AS am
+JOIN rooms AS r ON am.room_id = r.id
+WHERE am.id = $1;
+
+-- name: GetAudienceByGameStateID :many
+SELECT am.*
+FROM audience_members AS am
+JOIN game_state AS gs ON am.room_id = gs.room_id
+WHERE gs.id = $1
+ORDER BY am.created_at;
+
+-- name: UpsertFibbingItAudienceVote :exec
+INSERT INTO fibbing_it_audience_votes (id, audience_member_id, voted_for_player_id, round_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (audience_member_id, round_id, voted_for_player_id) DO UPDATE
+    SET
+        updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteOldestFibbingItAudienceVotes :exec
+DELETE FROM fibbing_it_audience_votes
+WHERE id IN (
+    SELECT av.id
+    FROM fibbing_it_audience_votes av
+    WHERE av.audience_member_id = $1 AND av.round_id = $2
+    ORDER BY av.updated_at DESC
+    OFFSET $3
+);
+
+-- name: GetAudienceVotesByRoundID :many
+SELECT
+    p.nickname,
+    COUNT(av.id) AS votes
+FROM fibbing_it_audience_votes AS av
+JOIN players AS p ON av.voted_for_player_id = p.id
+WHERE av.round_id = $1
+GROUP BY p.nickname
+ORDER BY votes DESC, p.nickname;
+
+-- name: UpsertRoomDisplay :one
+INSERT INTO room_displays (id, room_id, locale)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE
+    SET
+        room_id = excluded.room_id,
+        locale = excluded.locale,
+        updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: MoveRoomDisplays :exec
+UPDATE room_displays
+SET room_id = $1, updated_at = CURRENT_TIMESTAMP
+WHERE room_id = $2;
+
+-- name: GetRoomDisplayByID :one
+SELECT
+    rd.id,
+    rd.locale,
+    r.room_code,
+    r.room_state,
+    r.host_player
+FROM room_displays AS rd
+JOIN rooms AS r ON rd.room_id = r.id
+WHERE rd.id = $1;
+
+-- name: GetRoomDisplaysInLobby :many
+SELECT rd.*
+FROM room_displays AS rd
+JOIN rooms AS r ON rd.room_id = r.id
+WHERE r.room_code = $1 AND r.room_state = 'CREATED'
+ORDER BY rd.created_at;
+
+-- name: GetRoomDisplaysByGameStateID :many
+SELECT rd.*
+FROM room_displays AS rd
+JOIN game_state AS gs ON rd.room_id = gs.room_id
+WHERE gs.id = $1
+ORDER BY rd.created_at;
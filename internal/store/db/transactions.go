@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/invopop/ctxi18n"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -61,11 +63,33 @@ type StartGameArgs struct {
 	NormalsQuestionID uuid.UUID
 	FibberQuestionID  uuid.UUID
 	Players           []GetAllPlayersInRoomRow
-	FibberLoc         int
-	Deadline          time.Time
+	FibberLocs        []int
+	MaxRounds         int
+	RoundTypes        []string
+	QuestionSeconds   int
+	VotingSeconds     int
+	RevealSeconds     int
+	RevoteSeconds     int
+	ScoreSeconds      int
+	WinnerSeconds     int
+	Scorers           []string
+	RevealRule        string
+	TieBreak          string
+	Fibbers           int
+	FibberSelection   string
+	FibberRotation    string
+	// SeriesID is set when the room is playing the next game in a series, otherwise a new series is started.
+	SeriesID    uuid.NullUUID
+	SeriesGames int
+	RecentGames int
+	Deadline    time.Time
 }
 
 func (s *DB) StartGame(ctx context.Context, arg StartGameArgs) error {
+	if len(arg.RoundTypes) == 0 {
+		return errors.New("at least one round type is required to start a game")
+	}
+
 	return s.TransactionWithRetry(ctx, func(q *Queries) error {
 		_, err := q.UpdateRoomState(ctx, UpdateRoomStateParams{
 			RoomState: Playing.String(),
@@ -75,11 +99,34 @@ func (s *DB) StartGame(ctx context.Context, arg StartGameArgs) error {
 			return err
 		}
 
+		series, err := getSeries(ctx, q, arg.SeriesID, arg.SeriesGames)
+		if err != nil {
+			return err
+		}
+
 		_, err = q.AddGameState(ctx, AddGameStateParams{
-			ID:             arg.GameStateID,
-			RoomID:         arg.RoomID,
-			State:          FibbingITQuestion.String(),
-			SubmitDeadline: pgtype.Timestamp{Time: arg.Deadline, Valid: true},
+			ID:              arg.GameStateID,
+			RoomID:          arg.RoomID,
+			State:           FibbingITQuestion.String(),
+			SubmitDeadline:  pgtype.Timestamp{Time: arg.Deadline, Valid: true},
+			MaxRounds:       int32(arg.MaxRounds),
+			RoundTypes:      arg.RoundTypes,
+			QuestionSeconds: int32(arg.QuestionSeconds),
+			VotingSeconds:   int32(arg.VotingSeconds),
+			RevealSeconds:   int32(arg.RevealSeconds),
+			ScoreSeconds:    int32(arg.ScoreSeconds),
+			Scorers:         arg.Scorers,
+			RevealRule:      arg.RevealRule,
+			TieBreak:        arg.TieBreak,
+			Fibbers:         int32(arg.Fibbers),
+			FibberSelection: arg.FibberSelection,
+			FibberRotation:  arg.FibberRotation,
+			SeriesID:        series.SeriesID,
+			SeriesGame:      series.SeriesGame,
+			SeriesGames:     series.SeriesGames,
+			RecentGames:     int32(arg.RecentGames),
+			RevoteSeconds:   int32(arg.RevoteSeconds),
+			WinnerSeconds:   int32(arg.WinnerSeconds),
 		})
 		if err != nil {
 			return err
@@ -91,7 +138,8 @@ func (s *DB) StartGame(ctx context.Context, arg StartGameArgs) error {
 		}
 		round, err := q.AddFibbingItRound(ctx, AddFibbingItRoundParams{
 			ID:               roundID,
-			RoundType:        "free_form",
+			RoundType:        arg.RoundTypes[0],
+			RoundTypeIndex:   0,
 			Round:            1,
 			FibberQuestionID: arg.FibberQuestionID,
 			NormalQuestionID: arg.NormalsQuestionID,
@@ -103,7 +151,7 @@ func (s *DB) StartGame(ctx context.Context, arg StartGameArgs) error {
 
 		for i, player := range arg.Players {
 			role := "normal"
-			if i == arg.FibberLoc {
+			if slices.Contains(arg.FibberLocs, i) {
 				role = "fibber"
 			}
 
@@ -125,14 +173,44 @@ func (s *DB) StartGame(ctx context.Context, arg StartGameArgs) error {
 	})
 }
 
+// getSeries works out which game in the series this is, the number of games is fixed when the series starts.
+func getSeries(
+	ctx context.Context,
+	q *Queries,
+	seriesID uuid.NullUUID,
+	seriesGames int,
+) (GetLatestGameInSeriesRow, error) {
+	if seriesID.Valid {
+		series, err := q.GetLatestGameInSeries(ctx, seriesID)
+		if err != nil {
+			return GetLatestGameInSeriesRow{}, err
+		}
+
+		series.SeriesGame++
+		return series, nil
+	}
+
+	newSeriesID, err := uuid.NewV7()
+	if err != nil {
+		return GetLatestGameInSeriesRow{}, err
+	}
+
+	return GetLatestGameInSeriesRow{
+		SeriesID:    uuid.NullUUID{UUID: newSeriesID, Valid: true},
+		SeriesGame:  1,
+		SeriesGames: int32(max(seriesGames, 1)),
+	}, nil
+}
+
 type NewRoundArgs struct {
 	GameStateID       uuid.UUID
 	NormalsQuestionID uuid.UUID
 	FibberQuestionID  uuid.UUID
 	RoundType         string
+	RoundTypeIndex    int32
 	Round             int32
 	Players           []GetAllPlayersByGameStateIDRow
-	FibberLoc         int
+	FibberLocs        []int
 }
 
 func (s *DB) NewRound(ctx context.Context, arg NewRoundArgs) error {
@@ -144,6 +222,7 @@ func (s *DB) NewRound(ctx context.Context, arg NewRoundArgs) error {
 		newRound, err := q.AddFibbingItRound(ctx, AddFibbingItRoundParams{
 			ID:               roundID,
 			RoundType:        arg.RoundType,
+			RoundTypeIndex:   arg.RoundTypeIndex,
 			Round:            arg.Round,
 			FibberQuestionID: arg.FibberQuestionID,
 			NormalQuestionID: arg.NormalsQuestionID,
@@ -155,7 +234,7 @@ func (s *DB) NewRound(ctx context.Context, arg NewRoundArgs) error {
 
 		for i, player := range arg.Players {
 			role := "normal"
-			if i == arg.FibberLoc {
+			if slices.Contains(arg.FibberLocs, i) {
 				role = "fibber"
 			}
 
@@ -189,10 +268,12 @@ func (s *DB) AddScores(ctx context.Context, arg NewScoresArgs) error {
 				return err
 			}
 			_, err = q.AddFibbingItScore(ctx, AddFibbingItScoreParams{
-				ID:       scoreID,
-				PlayerID: player.PlayerID,
-				RoundID:  player.RoundID,
-				Score:    player.Score,
+				ID:            scoreID,
+				PlayerID:      player.PlayerID,
+				RoundID:       player.RoundID,
+				Score:         player.Score,
+				ReasonScorers: player.ReasonScorers,
+				ReasonPoints:  player.ReasonPoints,
 			})
 			if err != nil {
 				return err
@@ -208,6 +289,8 @@ type CreateQuestionArgs struct {
 	RoundType string
 	Text      string
 	Locale    string
+	// AnswerOptions are the answers players pick from in a multiple choice round, empty uses the default scale.
+	AnswerOptions []string
 }
 
 func (s *DB) CreateQuestionWithTranslation(ctx context.Context, arg CreateQuestionArgs) (uuid.UUID, error) {
@@ -238,10 +321,11 @@ func (s *DB) CreateQuestionWithTranslation(ctx context.Context, arg CreateQuesti
 			return err
 		}
 		_, err = q.AddQuestionTranslation(ctx, AddQuestionTranslationParams{
-			ID:         translationID,
-			Question:   arg.Text,
-			QuestionID: newQuestion.ID,
-			Locale:     arg.Locale,
+			ID:            translationID,
+			Question:      arg.Text,
+			QuestionID:    newQuestion.ID,
+			Locale:        arg.Locale,
+			AnswerOptions: arg.AnswerOptions,
 		})
 		if err != nil {
 			return err
@@ -254,6 +338,171 @@ func (s *DB) CreateQuestionWithTranslation(ctx context.Context, arg CreateQuesti
 	return questionID, err
 }
 
+type ImportGroupArgs struct {
+	GroupName string
+	GroupType string
+}
+
+type ImportQuestionArgs struct {
+	GroupName    string
+	RoundType    string
+	Enabled      bool
+	Translations []ImportTranslationArgs
+}
+
+type ImportTranslationArgs struct {
+	Text          string
+	Locale        string
+	AnswerOptions []string
+}
+
+type ImportQuestionPackArgs struct {
+	GameName  string
+	Groups    []ImportGroupArgs
+	Questions []ImportQuestionArgs
+}
+
+// ImportQuestionPack adds every question in the pack in one transaction, so if any of them fail, say because a
+// translation already exists, none of the pack is added. Groups which already exist are reused.
+func (s *DB) ImportQuestionPack(ctx context.Context, arg ImportQuestionPackArgs) error {
+	return s.TransactionWithRetry(ctx, func(q *Queries) error {
+		groupIDs := map[string]uuid.UUID{}
+		for _, group := range arg.Groups {
+			existing, err := q.GetGroupByName(ctx, group.GroupName)
+			if err == nil {
+				groupIDs[group.GroupName] = existing.ID
+				continue
+			} else if !errors.Is(err, sql.ErrNoRows) && !errors.Is(err, pgx.ErrNoRows) {
+				return err
+			}
+
+			groupID, err := uuid.NewV7()
+			if err != nil {
+				return err
+			}
+			newGroup, err := q.AddGroup(ctx, AddGroupParams{
+				ID:        groupID,
+				GroupName: group.GroupName,
+				GroupType: group.GroupType,
+			})
+			if err != nil {
+				return err
+			}
+			groupIDs[group.GroupName] = newGroup.ID
+		}
+
+		for _, question := range arg.Questions {
+			groupID, ok := groupIDs[question.GroupName]
+			if !ok {
+				group, err := q.GetGroupByName(ctx, question.GroupName)
+				if err != nil {
+					return err
+				}
+				groupID = group.ID
+				groupIDs[question.GroupName] = groupID
+			}
+
+			questionID, err := uuid.NewV7()
+			if err != nil {
+				return err
+			}
+			_, err = q.AddQuestion(ctx, AddQuestionParams{
+				ID:        questionID,
+				GameName:  arg.GameName,
+				RoundType: question.RoundType,
+				GroupID:   groupID,
+			})
+			if err != nil {
+				return err
+			}
+
+			if !question.Enabled {
+				_, err = q.DisableQuestion(ctx, questionID)
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, translation := range question.Translations {
+				translationID, err := uuid.NewV7()
+				if err != nil {
+					return err
+				}
+				_, err = q.AddQuestionTranslation(ctx, AddQuestionTranslationParams{
+					ID:            translationID,
+					Question:      translation.Text,
+					QuestionID:    questionID,
+					Locale:        translation.Locale,
+					AnswerOptions: translation.AnswerOptions,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// DeleteOrArchiveQuestion deletes the question and its translations, unless it was asked in a round. Rounds reference
+// the question so it is archived instead, which keeps old rounds and recaps working. Returns true if it was archived.
+func (s *DB) DeleteOrArchiveQuestion(ctx context.Context, id uuid.UUID) (bool, error) {
+	var archived bool
+	err := s.TransactionWithRetry(ctx, func(q *Queries) error {
+		var err error
+		archived, err = deleteOrArchiveQuestion(ctx, q, id)
+		return err
+	})
+
+	return archived, err
+}
+
+// DeleteOrArchiveGroup deletes or archives every question in the group, see DeleteOrArchiveQuestion. The group is
+// only deleted if all of its questions were, otherwise it is archived with them. Returns true if it was archived.
+func (s *DB) DeleteOrArchiveGroup(ctx context.Context, id uuid.UUID) (bool, error) {
+	var archived bool
+	err := s.TransactionWithRetry(ctx, func(q *Queries) error {
+		archived = false
+		questionIDs, err := q.GetQuestionIDsInGroup(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, questionID := range questionIDs {
+			questionArchived, err := deleteOrArchiveQuestion(ctx, q, questionID)
+			if err != nil {
+				return err
+			}
+			archived = archived || questionArchived
+		}
+
+		if archived {
+			return q.ArchiveGroup(ctx, id)
+		}
+		return q.DeleteGroup(ctx, id)
+	})
+
+	return archived, err
+}
+
+func deleteOrArchiveQuestion(ctx context.Context, q *Queries, id uuid.UUID) (bool, error) {
+	inRounds, err := q.IsQuestionInRounds(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if inRounds {
+		return true, q.ArchiveQuestion(ctx, id)
+	}
+
+	err = q.DeleteQuestionTranslations(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return false, q.DeleteQuestion(ctx, id)
+}
+
 type UpdateNicknameArgs struct {
 	PlayerID uuid.UUID
 	Nickname string
@@ -321,9 +570,10 @@ type UpdateStateToQuestionArgs struct {
 	NormalsQuestionID uuid.UUID
 	FibberQuestionID  uuid.UUID
 	RoundType         string
+	RoundTypeIndex    int32
 	RoundNumber       int32
 	Players           []GetAllPlayersByGameStateIDRow
-	FibberLoc         int
+	FibberLocs        []int
 }
 
 type UpdateStateToQuestionResult struct {
@@ -409,6 +659,7 @@ func (s *DB) UpdateStateToQuestion(ctx context.Context, arg UpdateStateToQuestio
 		_, err = q.AddFibbingItRound(ctx, AddFibbingItRoundParams{
 			ID:               roundID,
 			RoundType:        arg.RoundType,
+			RoundTypeIndex:   arg.RoundTypeIndex,
 			Round:            arg.RoundNumber,
 			FibberQuestionID: arg.FibberQuestionID,
 			NormalQuestionID: arg.NormalsQuestionID,
@@ -420,7 +671,7 @@ func (s *DB) UpdateStateToQuestion(ctx context.Context, arg UpdateStateToQuestio
 
 		for i, player := range arg.Players {
 			role := "normal"
-			if i == arg.FibberLoc {
+			if slices.Contains(arg.FibberLocs, i) {
 				role = "fibber"
 			}
 
@@ -609,6 +860,106 @@ func (s *DB) JoinRoom(ctx context.Context, arg JoinRoomArgs) (JoinRoomResult, er
 	return result, err
 }
 
+type RematchArgs struct {
+	RoomID    uuid.UUID
+	NewRoomID uuid.UUID
+	PlayerID  uuid.UUID
+}
+
+// Rematch opens a new lobby with the same room code, host and settings as a game that has ended, and moves everyone
+// still connected into it. The old room is left finished, so its rounds and scores are kept as they were. If the
+// game was part of a series that isn't over yet, the next game carries on the series.
+func (s *DB) Rematch(ctx context.Context, arg RematchArgs) error {
+	return s.TransactionWithRetry(ctx, func(q *Queries) error {
+		room, err := q.GetRoomByPlayerIDForUpdate(ctx, arg.PlayerID)
+		if err != nil {
+			if IsLockConflict(err) {
+				return errors.New("room is currently being modified, please try again")
+			}
+			return err
+		}
+
+		if room.ID != arg.RoomID {
+			return errors.New("rematch has already started")
+		}
+
+		_, err = q.UpdateRoomState(ctx, UpdateRoomStateParams{
+			RoomState: Finished.String(),
+			ID:        room.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.AddRoom(ctx, AddRoomParams{
+			ID:         arg.NewRoomID,
+			GameName:   room.GameName,
+			HostPlayer: room.HostPlayer,
+			RoomCode:   room.RoomCode,
+			RoomState:  Created.String(),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.ContinueSeries(ctx, ContinueSeriesParams{
+			ID:     arg.NewRoomID,
+			RoomID: room.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		settings, err := q.GetRoomSettings(ctx, room.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		if err == nil {
+			_, err = q.UpsertRoomSettings(ctx, UpsertRoomSettingsParams{
+				RoomID:          arg.NewRoomID,
+				MaxRounds:       settings.MaxRounds,
+				RoundTypes:      settings.RoundTypes,
+				QuestionSeconds: settings.QuestionSeconds,
+				VotingSeconds:   settings.VotingSeconds,
+				RevealSeconds:   settings.RevealSeconds,
+				ScoreSeconds:    settings.ScoreSeconds,
+				Scorers:         settings.Scorers,
+				RevealRule:      settings.RevealRule,
+				TieBreak:        settings.TieBreak,
+				Fibbers:         settings.Fibbers,
+				FibberSelection: settings.FibberSelection,
+				FibberRotation:  settings.FibberRotation,
+				SeriesGames:     settings.SeriesGames,
+				RecentGames:     settings.RecentGames,
+				RevoteSeconds:   settings.RevoteSeconds,
+				WinnerSeconds:   settings.WinnerSeconds,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err = q.MoveActivePlayersToRoom(ctx, MoveActivePlayersToRoomParams{
+			RoomID:   arg.NewRoomID,
+			RoomID_2: room.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.ResetPlayersReadyInRoom(ctx, arg.NewRoomID)
+		if err != nil {
+			return err
+		}
+
+		return q.MoveRoomDisplays(ctx, MoveRoomDisplaysParams{
+			RoomID:   arg.NewRoomID,
+			RoomID_2: room.ID,
+		})
+	})
+}
+
 type UpdateStateToScoreArgs struct {
 	GameStateID uuid.UUID
 	Deadline    time.Time
@@ -694,8 +1045,9 @@ type UpdateStateToVotingArgs struct {
 }
 
 type UpdateStateToVotingResult struct {
-	Round   int32
-	RoundID uuid.UUID
+	Round            int32
+	RoundID          uuid.UUID
+	RevoteCandidates []string
 }
 
 func (s *DB) UpdateStateToVoting(ctx context.Context, arg UpdateStateToVotingArgs) (UpdateStateToVotingResult, error) {
@@ -720,6 +1072,7 @@ func (s *DB) UpdateStateToVoting(ctx context.Context, arg UpdateStateToVotingArg
 					}
 					result.Round = round.Round
 					result.RoundID = round.ID
+					result.RevoteCandidates = round.RevoteCandidates
 					return nil
 				}
 				return errors.New("game state is not in FIBBING_IT_QUESTION state")
@@ -739,6 +1092,7 @@ func (s *DB) UpdateStateToVoting(ctx context.Context, arg UpdateStateToVotingArg
 			}
 			result.Round = round.Round
 			result.RoundID = round.ID
+			result.RevoteCandidates = round.RevoteCandidates
 			return nil
 		}
 
@@ -766,6 +1120,7 @@ func (s *DB) UpdateStateToVoting(ctx context.Context, arg UpdateStateToVotingArg
 
 		result.Round = round.Round
 		result.RoundID = round.ID
+		result.RevoteCandidates = round.RevoteCandidates
 		return nil
 	})
 
@@ -855,3 +1210,104 @@ func (s *DB) UpdateStateToReveal(ctx context.Context, arg UpdateStateToRevealArg
 
 	return result, err
 }
+
+type StartRevoteArgs struct {
+	GameStateID uuid.UUID
+	RoundID     uuid.UUID
+	Candidates  []string
+	// Winners are the players who clearly won a spot before the revote, they stay revealed after it.
+	Winners  []string
+	Deadline time.Time
+}
+
+// StartRevote keeps the game in the voting state but clears the votes of the round, so players can vote again
+// between the tied candidates only.
+func (s *DB) StartRevote(ctx context.Context, arg StartRevoteArgs) error {
+	return s.TransactionWithRetry(ctx, func(q *Queries) error {
+		_, err := q.UpdateGameStateIfInState(ctx, UpdateGameStateIfInStateParams{
+			State:          FibbingItVoting.String(),
+			SubmitDeadline: pgtype.Timestamp{Time: arg.Deadline, Valid: true},
+			ID:             arg.GameStateID,
+			State_2:        FibbingItVoting.String(),
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("game state is not in FIBBING_IT_VOTING state")
+			}
+			return err
+		}
+
+		err = q.DeleteFibbingItVotesByRoundID(ctx, arg.RoundID)
+		if err != nil {
+			return err
+		}
+
+		return q.UpdateFibbingItRoundRevoteCandidates(ctx, UpdateFibbingItRoundRevoteCandidatesParams{
+			RevoteCandidates: arg.Candidates,
+			RevoteWinners:    arg.Winners,
+			ID:               arg.RoundID,
+		})
+	})
+}
+
+type SubmitVoteArgs struct {
+	ID               uuid.UUID
+	PlayerID         uuid.UUID
+	VotedForPlayerID uuid.UUID
+	RoundID          uuid.UUID
+	// MaxVotes is how many players someone can vote for in a round, one for every fibber.
+	MaxVotes int
+}
+
+// SubmitVote adds the vote and, once the player has used all of their votes, drops their oldest vote so a new
+// vote replaces it.
+func (s *DB) SubmitVote(ctx context.Context, arg SubmitVoteArgs) error {
+	return s.TransactionWithRetry(ctx, func(q *Queries) error {
+		err := q.UpsertFibbingItVote(ctx, UpsertFibbingItVoteParams{
+			ID:               arg.ID,
+			PlayerID:         arg.PlayerID,
+			VotedForPlayerID: arg.VotedForPlayerID,
+			RoundID:          arg.RoundID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.DeleteOldestFibbingItVotes(ctx, DeleteOldestFibbingItVotesParams{
+			PlayerID: arg.PlayerID,
+			RoundID:  arg.RoundID,
+			Offset:   int32(max(arg.MaxVotes, 1)),
+		})
+	})
+}
+
+type SubmitAudienceVoteArgs struct {
+	ID               uuid.UUID
+	AudienceMemberID uuid.UUID
+	VotedForPlayerID uuid.UUID
+	RoundID          uuid.UUID
+	// MaxVotes is how many players an audience member can vote for in a round, one for every fibber like players.
+	MaxVotes int
+}
+
+// SubmitAudienceVote works like SubmitVote, once the audience member has used all of their votes their oldest vote
+// is dropped so a new vote replaces it.
+func (s *DB) SubmitAudienceVote(ctx context.Context, arg SubmitAudienceVoteArgs) error {
+	return s.TransactionWithRetry(ctx, func(q *Queries) error {
+		err := q.UpsertFibbingItAudienceVote(ctx, UpsertFibbingItAudienceVoteParams{
+			ID:               arg.ID,
+			AudienceMemberID: arg.AudienceMemberID,
+			VotedForPlayerID: arg.VotedForPlayerID,
+			RoundID:          arg.RoundID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.DeleteOldestFibbingItAudienceVotes(ctx, DeleteOldestFibbingItAudienceVotesParams{
+			AudienceMemberID: arg.AudienceMemberID,
+			RoundID:          arg.RoundID,
+			Offset:           int32(max(arg.MaxVotes, 1)),
+		})
+	})
+}
@@ -0,0 +1,176 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	"gitlab.com/hmajid2301/banterbus/internal/transport/http/middleware"
+	"gitlab.com/hmajid2301/banterbus/internal/views"
+)
+
+type AccountServicer interface {
+	SignIn(ctx context.Context, subject string) (service.Account, error)
+	GetAccount(ctx context.Context, subject string) (service.Account, error)
+	GetAccountBySubject(ctx context.Context, subject string) (service.Account, error)
+	UpdateProfile(ctx context.Context, subject string, profile service.AccountProfile) (service.Account, error)
+	LinkPlayer(ctx context.Context, accountID uuid.UUID, playerID uuid.UUID) error
+}
+
+type Account struct {
+	Account service.Account
+}
+
+type UpdateProfile struct {
+	Nickname string `json:"nickname" validate:"max=30"`
+	Avatar   string `json:"avatar"   validate:"max=2048"`
+	// Locale is used instead of the language picked in the browser, if empty the browser's language is used.
+	Locale string `json:"locale"`
+}
+
+// accountHandler handles both GET and PUT requests for /account
+func (s *Server) accountHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			s.getAccountHandler(w, r)
+		case http.MethodPut:
+			s.updateAccountHandler(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func (s *Server) getAccountHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Accounts are only known when auth is enabled, as the subject comes from the player's JWT.
+	subject, ok := middleware.AccountSubject(ctx)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	account, err := s.AccountService.GetAccount(ctx, subject)
+	if err != nil {
+		if errors.Is(err, service.ErrAccountNotFound) {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+
+		s.Logger.ErrorContext(ctx, "failed to get account", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeAccount(w, r, account)
+}
+
+func (s *Server) updateAccountHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	subject, ok := middleware.AccountSubject(ctx)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to ready request body", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var profile UpdateProfile
+	if err := json.Unmarshal(body, &profile); err != nil {
+		s.Logger.ErrorContext(ctx, "failed to unmarshal json", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(profile)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to validate json", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	if profile.Locale != "" {
+		languages, err := views.ListLanguages()
+		if err != nil {
+			s.Logger.ErrorContext(ctx, "failed to list supported languages", slog.Any("error", err))
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+
+		if _, ok := languages[profile.Locale]; !ok {
+			s.Logger.WarnContext(ctx, "invalid locale", slog.String("locale", profile.Locale))
+			http.Error(w, "Invalid locale", http.StatusBadRequest)
+			return
+		}
+	}
+
+	account, err := s.AccountService.UpdateProfile(ctx, subject, service.AccountProfile{
+		Nickname: profile.Nickname,
+		Avatar:   profile.Avatar,
+		Locale:   profile.Locale,
+	})
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to update account", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeAccount(w, r, account)
+}
+
+func (s *Server) writeAccount(w http.ResponseWriter, r *http.Request, account service.Account) {
+	ctx := r.Context()
+
+	resp, err := json.Marshal(Account{Account: account})
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to encode account", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(resp)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to write JSON", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// savedNickname is the nickname to pre-fill for signed in players, anonymous players start with an empty one.
+func (s *Server) savedNickname(r *http.Request) string {
+	ctx := r.Context()
+
+	subject, ok := middleware.AccountSubject(ctx)
+	if !ok {
+		return ""
+	}
+
+	account, err := s.AccountService.GetAccountBySubject(ctx, subject)
+	if err != nil {
+		if !errors.Is(err, service.ErrAccountNotFound) {
+			s.Logger.WarnContext(ctx, "failed to get account", slog.Any("error", err))
+		}
+		return ""
+	}
+
+	return account.Nickname
+}
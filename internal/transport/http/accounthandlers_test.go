@@ -0,0 +1,241 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/invopop/ctxi18n"
+	"github.com/invopop/ctxi18n/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	httpTransport "gitlab.com/hmajid2301/banterbus/internal/transport/http"
+	"gitlab.com/hmajid2301/banterbus/internal/views"
+)
+
+var (
+	testAccountID      = uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8f1"))
+	testAccountSubject = "auth0|123456"
+	testJWTSecret      = []byte("secret")
+)
+
+type mockAccountServicer struct{}
+
+func (m *mockAccountServicer) SignIn(ctx context.Context, subject string) (service.Account, error) {
+	return service.Account{ID: testAccountID, Nickname: "Majiy"}, nil
+}
+
+func (m *mockAccountServicer) GetAccount(ctx context.Context, subject string) (service.Account, error) {
+	if subject != testAccountSubject {
+		return service.Account{}, service.ErrAccountNotFound
+	}
+
+	return service.Account{
+		ID:       testAccountID,
+		Nickname: "Majiy",
+		Stats: service.AccountStats{
+			GamesPlayed:       2,
+			Wins:              1,
+			FibberRounds:      2,
+			FibberSuccesses:   1,
+			FibberSuccessRate: 0.5,
+		},
+	}, nil
+}
+
+func (m *mockAccountServicer) GetAccountBySubject(ctx context.Context, subject string) (service.Account, error) {
+	return service.Account{ID: testAccountID, Nickname: "Majiy"}, nil
+}
+
+func (m *mockAccountServicer) UpdateProfile(
+	ctx context.Context,
+	subject string,
+	profile service.AccountProfile,
+) (service.Account, error) {
+	return service.Account{
+		ID:       testAccountID,
+		Nickname: profile.Nickname,
+		Avatar:   profile.Avatar,
+		Locale:   profile.Locale,
+	}, nil
+}
+
+func (m *mockAccountServicer) LinkPlayer(ctx context.Context, accountID uuid.UUID, playerID uuid.UUID) error {
+	return nil
+}
+
+// setupAccountHandlersTest is like setupGameHandlersTest but with auth enabled, as accounts come from the JWT.
+func setupAccountHandlersTest(t *testing.T) *httptest.Server {
+	loc := i18n.Code("en-GB")
+	err := ctxi18n.LoadWithDefault(views.Locales, loc)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	keyfunc := func(token *jwt.Token) (any, error) {
+		return testJWTSecret, nil
+	}
+
+	server := httpTransport.NewServer(
+		&mockWebsocketer{},
+		logger,
+		http.Dir("../../../static"),
+		keyfunc,
+		&mockQuestionServicer{},
+		&mockRecapServicer{},
+		&mockAccountServicer{},
+		&mockLeaderboardServicer{},
+		httpTransport.ServerConfig{
+			Host:          "localhost",
+			Port:          8080,
+			Environment:   "test",
+			DefaultLocale: loc,
+		},
+	)
+
+	testServer := httptest.NewServer(server.Server.Handler)
+	t.Cleanup(testServer.Close)
+
+	return testServer
+}
+
+func newAccountRequest(t *testing.T, method string, url string, body io.Reader) *http.Request {
+	return newAccountRequestAs(t, testAccountSubject, method, url, body)
+}
+
+func newAccountRequestAs(t *testing.T, subject string, method string, url string, body io.Reader) *http.Request {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject: subject,
+	}).SignedString(testJWTSecret)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(method, url, body)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func TestAccountHandlerGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should return account with stats", func(t *testing.T) {
+		t.Parallel()
+		testServer := setupAccountHandlersTest(t)
+
+		req := newAccountRequest(t, http.MethodGet, testServer.URL+"/account", nil)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var body struct {
+			Account service.Account
+		}
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "Majiy", body.Account.Nickname)
+		assert.Equal(t, 2, body.Account.Stats.GamesPlayed)
+		assert.InDelta(t, 0.5, body.Account.Stats.FibberSuccessRate, 0.001)
+	})
+
+	t.Run("Should return not found for player that has never signed in", func(t *testing.T) {
+		t.Parallel()
+		testServer := setupAccountHandlersTest(t)
+
+		req := newAccountRequestAs(t, "auth0|654321", http.MethodGet, testServer.URL+"/account", nil)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Should return unauthorized without JWT", func(t *testing.T) {
+		t.Parallel()
+		testServer := setupAccountHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/account")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
+
+func TestAccountHandlerUpdate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should update profile", func(t *testing.T) {
+		t.Parallel()
+		testServer := setupAccountHandlersTest(t)
+
+		body := `{"nickname": "Majiy", "avatar": "https://example.com/avatar.svg", "locale": "de-DE"}`
+		req := newAccountRequest(t, http.MethodPut, testServer.URL+"/account", strings.NewReader(body))
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		var account struct {
+			Account service.Account
+		}
+		err = json.NewDecoder(resp.Body).Decode(&account)
+		require.NoError(t, err)
+		assert.Equal(t, "de-DE", account.Account.Locale)
+	})
+
+	t.Run("Should return bad request for unsupported locale", func(t *testing.T) {
+		t.Parallel()
+		testServer := setupAccountHandlersTest(t)
+
+		body := `{"nickname": "Majiy", "locale": "xx-XX"}`
+		req := newAccountRequest(t, http.MethodPut, testServer.URL+"/account", strings.NewReader(body))
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Should return bad request for nickname that is too long", func(t *testing.T) {
+		t.Parallel()
+		testServer := setupAccountHandlersTest(t)
+
+		body := `{"nickname": "` + strings.Repeat("a", 31) + `"}`
+		req := newAccountRequest(t, http.MethodPut, testServer.URL+"/account", strings.NewReader(body))
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestAccountHandlerIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should pre-fill nickname for signed in player", func(t *testing.T) {
+		t.Parallel()
+		testServer := setupAccountHandlersTest(t)
+
+		req := newAccountRequest(t, http.MethodGet, testServer.URL+"/", nil)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), `value="Majiy"`)
+	})
+}
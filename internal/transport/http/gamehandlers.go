@@ -19,7 +19,7 @@ func (s *Server) indexHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	templ.Handler(pages.Index(languages, s.Config.Environment)).ServeHTTP(w, r)
+	templ.Handler(pages.Index(languages, s.Config.Environment, s.savedNickname(r))).ServeHTTP(w, r)
 }
 
 func (s *Server) joinHandler(w http.ResponseWriter, r *http.Request) {
@@ -33,7 +33,21 @@ func (s *Server) joinHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	templ.Handler(pages.Join(languages, s.Config.Environment, roomCode)).ServeHTTP(w, r)
+	templ.Handler(pages.Join(languages, s.Config.Environment, roomCode, s.savedNickname(r))).ServeHTTP(w, r)
+}
+
+func (s *Server) displayHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	roomCode := r.PathValue("room_code")
+
+	languages, err := views.ListLanguages()
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to list supported languages", slog.Any("error", err))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	templ.Handler(pages.Display(languages, s.Config.Environment, roomCode)).ServeHTTP(w, r)
 }
 
 func (s *Server) subscribeHandler(w http.ResponseWriter, r *http.Request) {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"slices"
 	"testing"
 
 	"github.com/gofrs/uuid/v5"
@@ -39,16 +40,18 @@ func (m *mockQuestionServicer) Add(
 	text string,
 	group string,
 	roundType string,
+	answerOptions []string,
 ) (service.Question, error) {
 	if m.addErr != nil {
 		return service.Question{}, m.addErr
 	}
 	q := service.Question{
-		ID:        uuid.Must(uuid.NewV4()).String(),
-		Text:      text,
-		GroupName: group,
-		RoundType: roundType,
-		Enabled:   true,
+		ID:            uuid.Must(uuid.NewV4()).String(),
+		Text:          text,
+		GroupName:     group,
+		RoundType:     roundType,
+		Enabled:       true,
+		AnswerOptions: answerOptions,
 	}
 	m.questions = append(m.questions, q)
 	return q, nil
@@ -59,13 +62,15 @@ func (m *mockQuestionServicer) AddTranslation(
 	questionID uuid.UUID,
 	text string,
 	locale string,
+	answerOptions []string,
 ) (service.QuestionTranslation, error) {
 	if m.addErr != nil {
 		return service.QuestionTranslation{}, m.addErr
 	}
 	return service.QuestionTranslation{
-		Text:   text,
-		Locale: locale,
+		Text:          text,
+		Locale:        locale,
+		AnswerOptions: answerOptions,
 	}, nil
 }
 
@@ -111,6 +116,146 @@ func (m *mockQuestionServicer) GetGroups(ctx context.Context) ([]service.Group,
 	return m.groups, nil
 }
 
+func (m *mockQuestionServicer) ExportPack(ctx context.Context, groupName string) (service.QuestionPack, error) {
+	if m.getErr != nil {
+		return service.QuestionPack{}, m.getErr
+	}
+	return service.QuestionPack{
+		Groups: []service.Group{{Name: "animals", Type: "questions"}},
+		Questions: []service.PackQuestion{
+			{
+				Row:       1,
+				GroupName: "animals",
+				RoundType: "multiple_choice",
+				Enabled:   true,
+				Translations: []service.QuestionTranslation{
+					{Text: "Cats are better than dogs", Locale: "en-GB", AnswerOptions: []string{"Yes", "No"}},
+					{Text: "Katzen sind besser als Hunde", Locale: "de-DE", AnswerOptions: []string{"Ja", "Nein"}},
+				},
+			},
+		},
+	}, nil
+}
+
+func (m *mockQuestionServicer) ImportPack(
+	ctx context.Context,
+	pack service.QuestionPack,
+	dryRun bool,
+) (service.ImportResult, error) {
+	if m.addErr != nil {
+		return service.ImportResult{}, m.addErr
+	}
+
+	result := service.ImportResult{DryRun: dryRun, Groups: len(pack.Groups), Errors: []service.ImportError{}}
+	for _, question := range pack.Questions {
+		if !slices.Contains(service.AllRoundTypes(), question.RoundType) {
+			result.Errors = append(result.Errors, service.ImportError{Row: question.Row, Message: "invalid round type"})
+			continue
+		}
+		result.Questions++
+		result.Translations += len(question.Translations)
+	}
+
+	if len(result.Errors) > 0 {
+		return result, service.ErrInvalidQuestionPack
+	}
+	return result, nil
+}
+
+func (m *mockQuestionServicer) UpdateQuestion(
+	ctx context.Context,
+	id uuid.UUID,
+	groupName string,
+	roundType string,
+) error {
+	if groupName == "missing" {
+		return service.ErrGroupNotFound
+	}
+	return m.addErr
+}
+
+func (m *mockQuestionServicer) UpdateTranslation(
+	ctx context.Context,
+	questionID uuid.UUID,
+	text string,
+	locale string,
+	answerOptions []string,
+) (service.QuestionTranslation, error) {
+	if locale == "pt-PT" {
+		return service.QuestionTranslation{}, service.ErrTranslationNotFound
+	}
+	return service.QuestionTranslation{Text: text, Locale: locale, AnswerOptions: answerOptions}, m.addErr
+}
+
+func (m *mockQuestionServicer) DeleteTranslation(ctx context.Context, questionID uuid.UUID, locale string) error {
+	if locale == "en-GB" {
+		return service.ErrDefaultTranslation
+	}
+	return nil
+}
+
+func (m *mockQuestionServicer) DeleteQuestion(ctx context.Context, id uuid.UUID) (bool, error) {
+	if id == uuid.Nil {
+		return false, service.ErrQuestionNotFound
+	}
+	return true, nil
+}
+
+func (m *mockQuestionServicer) UpdateGroup(ctx context.Context, id uuid.UUID, name string) (service.Group, error) {
+	if name == "taken" {
+		return service.Group{}, service.ErrGroupExists
+	}
+	return service.Group{ID: id.String(), Name: name, Type: "questions"}, nil
+}
+
+func (m *mockQuestionServicer) DeleteGroup(ctx context.Context, id uuid.UUID) (bool, error) {
+	if id == uuid.Nil {
+		return false, service.ErrGroupNotFound
+	}
+	return false, nil
+}
+
+func (m *mockQuestionServicer) AddPair(
+	ctx context.Context,
+	normalQuestionID uuid.UUID,
+	fibberQuestionID uuid.UUID,
+) (service.QuestionPair, error) {
+	if normalQuestionID == uuid.Nil {
+		return service.QuestionPair{}, service.ErrQuestionNotFound
+	}
+	if normalQuestionID == fibberQuestionID {
+		return service.QuestionPair{}, service.ErrInvalidQuestionPair
+	}
+	return service.QuestionPair{
+		NormalQuestionID: normalQuestionID.String(),
+		FibberQuestionID: fibberQuestionID.String(),
+	}, nil
+}
+
+func (m *mockQuestionServicer) GetPairs(ctx context.Context, questionID uuid.UUID) ([]service.QuestionPair, error) {
+	if questionID == uuid.Nil {
+		return nil, service.ErrQuestionNotFound
+	}
+	return []service.QuestionPair{
+		{
+			ID:               "0193a62a-4dff-774c-850a-b1fe78e2a920",
+			NormalQuestionID: questionID.String(),
+			FibberQuestionID: "0193a62a-4dff-774c-850a-b1fe78e2a921",
+		},
+	}, nil
+}
+
+func (m *mockQuestionServicer) RemovePair(
+	ctx context.Context,
+	normalQuestionID uuid.UUID,
+	fibberQuestionID uuid.UUID,
+) error {
+	if fibberQuestionID == uuid.Nil {
+		return service.ErrQuestionPairNotFound
+	}
+	return nil
+}
+
 func setupGameHandlersTest(t *testing.T) (*httptest.Server, *httpTransport.Server) {
 	loc := i18n.Code("en-GB")
 	err := ctxi18n.LoadWithDefault(views.Locales, loc)
@@ -118,6 +263,9 @@ func setupGameHandlersTest(t *testing.T) (*httptest.Server, *httpTransport.Serve
 
 	mockWS := &mockWebsocketer{}
 	mockQS := &mockQuestionServicer{}
+	mockRS := &mockRecapServicer{}
+	mockAS := &mockAccountServicer{}
+	mockLS := &mockLeaderboardServicer{}
 
 	// Create a test logger to avoid nil pointer issues
 	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
@@ -128,6 +276,9 @@ func setupGameHandlersTest(t *testing.T) (*httptest.Server, *httpTransport.Serve
 		http.Dir("../../../static"),
 		nil,
 		mockQS,
+		mockRS,
+		mockAS,
+		mockLS,
 		httpTransport.ServerConfig{
 			Host:          "localhost",
 			Port:          8080,
@@ -199,6 +350,34 @@ func TestGameHandlerJoin(t *testing.T) {
 	})
 }
 
+func TestGameHandlerDisplay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should return HTML page for valid room code", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/display/ABC12")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Content-Type"), "text/html")
+	})
+
+	t.Run("Should return HTML page for empty room code", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/display/")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Content-Type"), "text/html")
+	})
+}
+
 func TestGameHandlerSubscribe(t *testing.T) {
 	t.Parallel()
 
@@ -218,6 +397,9 @@ func TestGameHandlerSubscribe(t *testing.T) {
 			http.Dir("../../../static"),
 			nil,
 			mockQS,
+			&mockRecapServicer{},
+			&mockAccountServicer{},
+			&mockLeaderboardServicer{},
 			httpTransport.ServerConfig{
 				Host:          "localhost",
 				Port:          8080,
@@ -258,6 +440,9 @@ func TestGameHandlerSubscribe(t *testing.T) {
 			http.Dir("../../../static"),
 			nil,
 			mockQS,
+			&mockRecapServicer{},
+			&mockAccountServicer{},
+			&mockLeaderboardServicer{},
 			httpTransport.ServerConfig{
 				Host:          "localhost",
 				Port:          8080,
@@ -16,11 +16,14 @@ import (
 )
 
 type Server struct {
-	Logger          *slog.Logger
-	Websocket       websocketer
-	Config          ServerConfig
-	Server          *http.Server
-	QuestionService QuestionServicer
+	Logger             *slog.Logger
+	Websocket          websocketer
+	Config             ServerConfig
+	Server             *http.Server
+	QuestionService    QuestionServicer
+	RecapService       RecapServicer
+	AccountService     AccountServicer
+	LeaderboardService LeaderboardServicer
 }
 
 type ServerConfig struct {
@@ -41,14 +44,20 @@ func NewServer(
 	staticFS http.FileSystem,
 	keyfunc jwt.Keyfunc,
 	questionService QuestionServicer,
+	recapService RecapServicer,
+	accountService AccountServicer,
+	leaderboardService LeaderboardServicer,
 	config ServerConfig,
 
 ) *Server {
 	s := &Server{
-		Websocket:       websocketer,
-		Logger:          logger,
-		Config:          config,
-		QuestionService: questionService,
+		Websocket:          websocketer,
+		Logger:             logger,
+		Config:             config,
+		QuestionService:    questionService,
+		RecapService:       recapService,
+		AccountService:     accountService,
+		LeaderboardService: leaderboardService,
 	}
 
 	handler := s.setupHTTPRoutes(config, keyfunc, staticFS)
@@ -81,21 +90,36 @@ func (s *Server) setupHTTPRoutes(config ServerConfig, keyfunc jwt.Keyfunc, stati
 	publicGroup.HandleFunc("/readiness", s.readinessHandler)
 	publicGroup.Handle("/static/", http.StripPrefix("/static", http.FileServer(staticFS)))
 
-	// Game routes (with locale middleware)
-	gameGroup := router.Group("game", m.Locale)
+	// Game routes (with locale middleware, players can optionally sign in)
+	gameGroup := router.Group("game", m.Locale, m.OptionalJWT)
 	gameGroup.HandleFunc("/", s.indexHandler)
 	gameGroup.HandleFunc("/join/{room_code}", s.joinHandler)
+	gameGroup.HandleFunc("/display/{room_code}", s.displayHandler)
+	gameGroup.HandleFunc("/recap/{game_state_id}", s.recapHandler)
+	// Recaps are for the players, so don't need auth, the game state ID is only known to people in the room
+	gameGroup.Handle("/api/games/{game_state_id}", s.methodHandler("GET", s.getRecapHandler))
+	gameGroup.Handle("/leaderboard", s.methodHandler("GET", s.leaderboardHandler))
+	gameGroup.Handle("/api/leaderboard", s.methodHandler("GET", s.getLeaderboardHandler))
 
 	// API routes (with locale + auth middleware)
 	apiGroup := router.Group("api", m.Locale, m.ValidateJWT)
 	apiGroup.Handle("/question", s.questionHandler())
-	apiGroup.HandleFunc("/question/{id}/locale/{locale}", s.addQuestionTranslationHandler)
+	apiGroup.Handle("/question/{id}/locale/{locale}", s.questionTranslationHandler())
 	apiGroup.Handle("/question/group", s.questionGroupHandler())
+	apiGroup.Handle("/question/pack", s.questionPackHandler())
+	apiGroup.Handle("/account", s.accountHandler())
 
 	// Admin routes (with locale + admin auth middleware)
 	adminGroup := router.Group("admin", m.Locale, m.ValidateAdminJWT)
 	adminGroup.Handle("/question/{id}/enable", s.methodHandler("PUT", s.enableQuestionHandler))
 	adminGroup.Handle("/question/{id}/disable", s.methodHandler("PUT", s.disableQuestionHandler))
+	adminGroup.Handle("/question/{id}", s.adminQuestionHandler())
+	adminGroup.Handle("/question/{id}/pair", s.questionPairHandler())
+	adminGroup.Handle("/question/{id}/pair/{fibber_id}", s.methodHandler("DELETE", s.deleteQuestionPairHandler))
+	// INFO: Adding and editing translations is done in the API routes, only deleting them needs an admin.
+	adminGroup.Handle("DELETE /question/{id}/locale/{locale}", http.HandlerFunc(s.deleteQuestionTranslationHandler))
+	// INFO: Not under /question/group, as /question/group/{id} would conflict with /question/{id}/enable.
+	adminGroup.Handle("/group/{id}", s.adminGroupHandler())
 
 	s.registerDebugRoutes(router)
 
@@ -121,8 +145,9 @@ func (s *Server) setupHTTPRoutes(config ServerConfig, keyfunc jwt.Keyfunc, stati
 
 	// Create a new mux for final routing that bypasses middleware for WebSocket
 	finalMux := http.NewServeMux()
-	finalMux.Handle("/ws", http.HandlerFunc(s.subscribeHandler)) // WebSocket with NO middleware
-	finalMux.Handle("/", handler)                                // All other routes with full middleware
+	// WebSocket with NO middleware, apart from signing in players so their games are linked to their account
+	finalMux.Handle("/ws", m.OptionalJWT(http.HandlerFunc(s.subscribeHandler)))
+	finalMux.Handle("/", handler) // All other routes with full middleware
 
 	return finalMux
 }
@@ -173,6 +198,62 @@ func (s *Server) questionGroupHandler() http.Handler {
 	})
 }
 
+// questionTranslationHandler handles both POST and PUT requests for /question/{id}/locale/{locale}
+func (s *Server) questionTranslationHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			s.addQuestionTranslationHandler(w, r)
+		case http.MethodPut:
+			s.updateQuestionTranslationHandler(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// adminQuestionHandler handles both PUT and DELETE requests for /question/{id}
+func (s *Server) adminQuestionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			s.updateQuestionHandler(w, r)
+		case http.MethodDelete:
+			s.deleteQuestionHandler(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// questionPairHandler handles both GET and POST requests for /question/{id}/pair
+func (s *Server) questionPairHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			s.addQuestionPairHandler(w, r)
+		case http.MethodGet:
+			s.getQuestionPairsHandler(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+// adminGroupHandler handles both PUT and DELETE requests for /group/{id}
+func (s *Server) adminGroupHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			s.updateGroupHandler(w, r)
+		case http.MethodDelete:
+			s.deleteGroupHandler(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
 // methodHandler restricts a handler to a specific HTTP method
 func (s *Server) methodHandler(method string, handler http.HandlerFunc) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
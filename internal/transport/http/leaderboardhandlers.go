@@ -0,0 +1,96 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/a-h/templ"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	"gitlab.com/hmajid2301/banterbus/internal/views"
+	"gitlab.com/hmajid2301/banterbus/internal/views/pages"
+)
+
+type LeaderboardServicer interface {
+	GetLeaderboard(ctx context.Context, period string, roundType string) (service.Leaderboard, error)
+}
+
+func (s *Server) leaderboardHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	leaderboard, ok := s.getLeaderboard(w, r)
+	if !ok {
+		return
+	}
+
+	languages, err := views.ListLanguages()
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to list supported languages", slog.Any("error", err))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	templ.Handler(pages.Leaderboard(languages, s.Config.Environment, leaderboard)).ServeHTTP(w, r)
+}
+
+type Leaderboard struct {
+	Leaderboard service.Leaderboard
+}
+
+func (s *Server) getLeaderboardHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	leaderboard, ok := s.getLeaderboard(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := json.Marshal(Leaderboard{Leaderboard: leaderboard})
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to encode leaderboard", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(resp)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to write JSON", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// getLeaderboard writes the error response itself, so callers should just return when it isn't ok. The period
+// defaults to all time and the round type to every round type.
+func (s *Server) getLeaderboard(w http.ResponseWriter, r *http.Request) (service.Leaderboard, bool) {
+	ctx := r.Context()
+
+	period := r.URL.Query().Get("period")
+	if period == "" {
+		period = service.LeaderboardAllTime
+	}
+	roundType := r.URL.Query().Get("round_type")
+
+	leaderboard, err := s.LeaderboardService.GetLeaderboard(ctx, period, roundType)
+	if errors.Is(err, service.ErrInvalidLeaderboard) {
+		s.Logger.WarnContext(
+			ctx,
+			"invalid leaderboard",
+			slog.String("period", period),
+			slog.String("round_type", roundType),
+		)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return service.Leaderboard{}, false
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get leaderboard", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return service.Leaderboard{}, false
+	}
+
+	return leaderboard, true
+}
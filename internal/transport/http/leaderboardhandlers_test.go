@@ -0,0 +1,120 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+)
+
+type mockLeaderboardServicer struct{}
+
+func (m *mockLeaderboardServicer) GetLeaderboard(
+	ctx context.Context,
+	period string,
+	roundType string,
+) (service.Leaderboard, error) {
+	if period == "monthly" {
+		return service.Leaderboard{}, service.ErrInvalidLeaderboard
+	}
+
+	return service.Leaderboard{
+		Period:    period,
+		RoundType: roundType,
+		Entries: []service.LeaderboardEntry{
+			{Rank: 1, Nickname: "Host Player", Score: 500, GamesPlayed: 2},
+			{Rank: 2, Nickname: "Other Player", Score: 300, GamesPlayed: 1},
+		},
+	}, nil
+}
+
+func TestLeaderboardHandlerPage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should return HTML page", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/leaderboard?period=weekly")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "Host Player")
+		assert.Contains(t, string(body), "Other Player")
+	})
+
+	t.Run("Should return bad request for invalid period", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/leaderboard?period=monthly")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestLeaderboardHandlerAPI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should default to all time leaderboard for every round type", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/api/leaderboard")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		var body struct {
+			Leaderboard service.Leaderboard
+		}
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, service.LeaderboardAllTime, body.Leaderboard.Period)
+		assert.Empty(t, body.Leaderboard.RoundType)
+		assert.Len(t, body.Leaderboard.Entries, 2)
+	})
+
+	t.Run("Should filter by period and round type", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/api/leaderboard?period=daily&round_type=free_form")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body struct {
+			Leaderboard service.Leaderboard
+		}
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, service.LeaderboardDaily, body.Leaderboard.Period)
+		assert.Equal(t, "free_form", body.Leaderboard.RoundType)
+	})
+
+	t.Run("Should not allow other methods", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Post(testServer.URL+"/api/leaderboard", "application/json", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -8,6 +9,21 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// accessTokenCookie lets browsers sign in on pages and websockets, where they can't set the authorization header.
+const accessTokenCookie = "access_token"
+
+type accountSubjectKey struct{}
+
+// WithAccountSubject stores the subject of a player's validated JWT, so later handlers know they are signed in.
+func WithAccountSubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, accountSubjectKey{}, subject)
+}
+
+func AccountSubject(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(accountSubjectKey{}).(string)
+	return subject, ok && subject != ""
+}
+
 func (m Middleware) ValidateJWT(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if m.DisableAuth {
@@ -27,10 +43,48 @@ func (m Middleware) ValidateJWT(next http.Handler) http.Handler {
 			return
 		}
 
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(withSubject(r, token)))
+	})
+}
+
+// OptionalJWT signs in players who send a valid JWT, from the authorization header or the access token cookie.
+// Unlike ValidateJWT, players without a token, or with an invalid one, carry on playing anonymously.
+func (m Middleware) OptionalJWT(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.DisableAuth || m.Keyfunc == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		bearerToken, err := getBearerToken(r.Header.Get("authorization"))
+		if err != nil {
+			cookie, cookieErr := r.Cookie(accessTokenCookie)
+			if cookieErr != nil || cookie.Value == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			bearerToken = cookie.Value
+		}
+
+		token, err := jwt.Parse(bearerToken, m.Keyfunc)
+		if err != nil || !token.Valid {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(withSubject(r, token)))
 	})
 }
 
+func withSubject(r *http.Request, token *jwt.Token) context.Context {
+	subject, err := token.Claims.GetSubject()
+	if err != nil || subject == "" {
+		return r.Context()
+	}
+
+	return WithAccountSubject(r.Context(), subject)
+}
+
 func getBearerToken(authHeader string) (string, error) {
 	if authHeader == "" {
 		return "", fmt.Errorf("no authorization header")
@@ -1,11 +1,14 @@
 package middleware_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gitlab.com/hmajid2301/banterbus/internal/transport/http/middleware"
 )
@@ -94,6 +97,85 @@ func TestValidateJWT(t *testing.T) {
 	})
 }
 
+func TestOptionalJWT(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("secret")
+	keyfunc := func(token *jwt.Token) (any, error) {
+		return secret, nil
+	}
+	signedToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject: "auth0|123456",
+	}).SignedString(secret)
+	require.NoError(t, err)
+
+	// Create a test handler which writes the subject of the signed in player
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subject, _ := middleware.AccountSubject(r.Context())
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(subject))
+	})
+
+	t.Run("Should continue anonymously when no token", func(t *testing.T) {
+		t.Parallel()
+
+		m := middleware.Middleware{Keyfunc: keyfunc}
+		wrappedHandler := m.OptionalJWT(testHandler)
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		recorder := httptest.NewRecorder()
+		wrappedHandler.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Empty(t, recorder.Body.String())
+	})
+
+	t.Run("Should continue anonymously with malformed JWT", func(t *testing.T) {
+		t.Parallel()
+
+		m := middleware.Middleware{Keyfunc: keyfunc}
+		wrappedHandler := m.OptionalJWT(testHandler)
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("Authorization", "Bearer invalid.jwt.token")
+		recorder := httptest.NewRecorder()
+		wrappedHandler.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Empty(t, recorder.Body.String())
+	})
+
+	t.Run("Should sign in player with bearer token", func(t *testing.T) {
+		t.Parallel()
+
+		m := middleware.Middleware{Keyfunc: keyfunc}
+		wrappedHandler := m.OptionalJWT(testHandler)
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("Authorization", "Bearer "+signedToken)
+		recorder := httptest.NewRecorder()
+		wrappedHandler.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "auth0|123456", recorder.Body.String())
+	})
+
+	t.Run("Should sign in player with access token cookie", func(t *testing.T) {
+		t.Parallel()
+
+		m := middleware.Middleware{Keyfunc: keyfunc}
+		wrappedHandler := m.OptionalJWT(testHandler)
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.AddCookie(&http.Cookie{Name: "access_token", Value: signedToken})
+		recorder := httptest.NewRecorder()
+		wrappedHandler.ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "auth0|123456", recorder.Body.String())
+	})
+}
+
 func TestValidateAdminJWT(t *testing.T) {
 	t.Parallel()
 
@@ -123,3 +205,22 @@ func TestValidateAdminJWT(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
 	})
 }
+
+func TestAccountSubject(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should get subject from context", func(t *testing.T) {
+		t.Parallel()
+		ctx := middleware.WithAccountSubject(t.Context(), "auth0|123456")
+
+		subject, ok := middleware.AccountSubject(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, "auth0|123456", subject)
+	})
+
+	t.Run("Should not get subject when player is not signed in", func(t *testing.T) {
+		t.Parallel()
+		_, ok := middleware.AccountSubject(context.Background())
+		assert.False(t, ok)
+	})
+}
@@ -3,10 +3,12 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"log/slog"
 	"math"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -17,12 +19,19 @@ import (
 )
 
 type QuestionServicer interface {
-	Add(ctx context.Context, text string, group string, roundType string) (service.Question, error)
+	Add(
+		ctx context.Context,
+		text string,
+		group string,
+		roundType string,
+		answerOptions []string,
+	) (service.Question, error)
 	AddTranslation(
 		ctx context.Context,
 		questionID uuid.UUID,
 		text string,
 		locale string,
+		answerOptions []string,
 	) (service.QuestionTranslation, error)
 	GetQuestions(
 		ctx context.Context,
@@ -34,12 +43,31 @@ type QuestionServicer interface {
 	EnableQuestion(ctx context.Context, id uuid.UUID) error
 	AddGroup(ctx context.Context, name string, groupType ...string) (service.Group, error)
 	GetGroups(ctx context.Context) ([]service.Group, error)
+	ExportPack(ctx context.Context, groupName string) (service.QuestionPack, error)
+	ImportPack(ctx context.Context, pack service.QuestionPack, dryRun bool) (service.ImportResult, error)
+	UpdateQuestion(ctx context.Context, id uuid.UUID, groupName string, roundType string) error
+	UpdateTranslation(
+		ctx context.Context,
+		questionID uuid.UUID,
+		text string,
+		locale string,
+		answerOptions []string,
+	) (service.QuestionTranslation, error)
+	DeleteTranslation(ctx context.Context, questionID uuid.UUID, locale string) error
+	DeleteQuestion(ctx context.Context, id uuid.UUID) (bool, error)
+	UpdateGroup(ctx context.Context, id uuid.UUID, name string) (service.Group, error)
+	DeleteGroup(ctx context.Context, id uuid.UUID) (bool, error)
+	AddPair(ctx context.Context, normalQuestionID uuid.UUID, fibberQuestionID uuid.UUID) (service.QuestionPair, error)
+	GetPairs(ctx context.Context, questionID uuid.UUID) ([]service.QuestionPair, error)
+	RemovePair(ctx context.Context, normalQuestionID uuid.UUID, fibberQuestionID uuid.UUID) error
 }
 
 type NewQuestion struct {
 	Text      string `json:"text"       validate:"required"`
 	GroupName string `json:"group_name" validate:"required"`
 	RoundType string `json:"round_type" validate:"required"`
+	// AnswerOptions are only used by multiple choice questions, if empty the default scale is used.
+	AnswerOptions []string `json:"answer_options"`
 }
 
 func (s *Server) addQuestionHandler(w http.ResponseWriter, r *http.Request) {
@@ -68,8 +96,24 @@ func (s *Server) addQuestionHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = s.QuestionService.Add(ctx, newQuestion.Text, newQuestion.GroupName, newQuestion.RoundType)
-	if err != nil {
+	if !slices.Contains(service.AllRoundTypes(), newQuestion.RoundType) {
+		s.Logger.WarnContext(ctx, "invalid round type", slog.String("round_type", newQuestion.RoundType))
+		http.Error(w, invalidRoundTypeMsg(), http.StatusBadRequest)
+		return
+	}
+
+	_, err = s.QuestionService.Add(
+		ctx,
+		newQuestion.Text,
+		newQuestion.GroupName,
+		newQuestion.RoundType,
+		newQuestion.AnswerOptions,
+	)
+	if errors.Is(err, service.ErrInvalidAnswerOptions) {
+		s.Logger.WarnContext(ctx, "invalid answer options", slog.Any("error", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
 		s.Logger.ErrorContext(ctx, "failed to add question", slog.Any("error", err))
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return
@@ -79,7 +123,8 @@ func (s *Server) addQuestionHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 type NewQuestionTranslation struct {
-	Text string `json:"text" validate:"required"`
+	Text          string   `json:"text"           validate:"required"`
+	AnswerOptions []string `json:"answer_options"`
 }
 
 func (s *Server) addQuestionTranslationHandler(w http.ResponseWriter, r *http.Request) {
@@ -111,16 +156,7 @@ func (s *Server) addQuestionTranslationHandler(w http.ResponseWriter, r *http.Re
 	id := r.PathValue("id")
 	locale := r.PathValue("locale")
 
-	// Validate locale parameter to prevent path traversal
-	allowedLocales := []string{"en-GB", "de-DE", "pt-PT"}
-	validLocale := false
-	for _, validLoc := range allowedLocales {
-		if locale == validLoc {
-			validLocale = true
-			break
-		}
-	}
-	if !validLocale {
+	if !isQuestionLocale(locale) {
 		s.Logger.WarnContext(ctx, "invalid locale parameter", slog.String("locale", locale))
 		http.Error(w, "Invalid locale", http.StatusBadRequest)
 		return
@@ -133,8 +169,12 @@ func (s *Server) addQuestionTranslationHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	_, err = s.QuestionService.AddTranslation(ctx, questionID, newQuestion.Text, locale)
-	if err != nil {
+	_, err = s.QuestionService.AddTranslation(ctx, questionID, newQuestion.Text, locale, newQuestion.AnswerOptions)
+	if errors.Is(err, service.ErrInvalidAnswerOptions) {
+		s.Logger.WarnContext(ctx, "invalid answer options", slog.Any("error", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
 		s.Logger.ErrorContext(ctx, "failed to add question translation", slog.Any("error", err))
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return
@@ -153,27 +193,9 @@ func (s *Server) getQuestionsHandler(w http.ResponseWriter, r *http.Request) {
 	roundType := r.URL.Query().Get("round_type")
 	groupName := r.URL.Query().Get("group_name")
 
-	if roundType != "" {
-		validRoundTypes := []string{
-			service.RoundTypeFreeForm,
-			service.RoundTypeMultipleChoice,
-			service.RoundTypeMostLikely,
-		}
-		isValidRoundType := false
-		for _, validType := range validRoundTypes {
-			if roundType == validType {
-				isValidRoundType = true
-				break
-			}
-		}
-		if !isValidRoundType {
-			http.Error(
-				w,
-				"Invalid round_type. Valid values: free_form, multiple_choice, most_likely",
-				http.StatusBadRequest,
-			)
-			return
-		}
+	if roundType != "" && !slices.Contains(service.AllRoundTypes(), roundType) {
+		http.Error(w, invalidRoundTypeMsg(), http.StatusBadRequest)
+		return
 	}
 
 	if groupName != "" && len(strings.TrimSpace(groupName)) == 0 {
@@ -371,3 +393,419 @@ func (s *Server) getGroupsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+func invalidRoundTypeMsg() string {
+	return "Invalid round_type. Valid values: " + strings.Join(service.AllRoundTypes(), ", ")
+}
+
+// isQuestionLocale validates the locale path parameter, to prevent path traversal.
+func isQuestionLocale(locale string) bool {
+	allowedLocales := []string{"en-GB", "de-DE", "pt-PT"}
+	return slices.Contains(allowedLocales, locale)
+}
+
+type UpdateQuestion struct {
+	// GroupName and RoundType are both optional, only the ones set are changed.
+	GroupName string `json:"group_name"`
+	RoundType string `json:"round_type"`
+}
+
+func (s *Server) updateQuestionHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to ready request body", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var updateQuestion UpdateQuestion
+	if err := json.Unmarshal(body, &updateQuestion); err != nil {
+		s.Logger.ErrorContext(ctx, "failed to unmarshal json", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	if updateQuestion.GroupName == "" && updateQuestion.RoundType == "" {
+		http.Error(w, "Bad Request: group_name or round_type is required", http.StatusBadRequest)
+		return
+	}
+
+	if updateQuestion.RoundType != "" && !slices.Contains(service.AllRoundTypes(), updateQuestion.RoundType) {
+		s.Logger.WarnContext(ctx, "invalid round type", slog.String("round_type", updateQuestion.RoundType))
+		http.Error(w, invalidRoundTypeMsg(), http.StatusBadRequest)
+		return
+	}
+
+	questionID, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to parse question UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	err = s.QuestionService.UpdateQuestion(ctx, questionID, updateQuestion.GroupName, updateQuestion.RoundType)
+	if errors.Is(err, service.ErrQuestionNotFound) || errors.Is(err, service.ErrGroupNotFound) {
+		s.Logger.WarnContext(ctx, "failed to find question or group", slog.Any("error", err))
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if errors.Is(err, service.ErrInvalidAnswerOptions) {
+		s.Logger.WarnContext(ctx, "invalid answer options", slog.Any("error", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to update question", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Deleted is the response when deleting questions or groups, which are archived rather than deleted if a question
+// has been used in a round.
+type Deleted struct {
+	Archived bool
+}
+
+func (s *Server) deleteQuestionHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	questionID, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to parse question UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	archived, err := s.QuestionService.DeleteQuestion(ctx, questionID)
+	if errors.Is(err, service.ErrQuestionNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to delete question", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeDeleted(w, r, archived)
+}
+
+func (s *Server) updateQuestionTranslationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to ready request body", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var translation NewQuestionTranslation
+	if err := json.Unmarshal(body, &translation); err != nil {
+		s.Logger.ErrorContext(ctx, "failed to unmarshal json", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(translation)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to validate json", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	locale := r.PathValue("locale")
+	if !isQuestionLocale(locale) {
+		s.Logger.WarnContext(ctx, "invalid locale parameter", slog.String("locale", locale))
+		http.Error(w, "Invalid locale", http.StatusBadRequest)
+		return
+	}
+
+	questionID, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to parse question UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	_, err = s.QuestionService.UpdateTranslation(ctx, questionID, translation.Text, locale, translation.AnswerOptions)
+	if errors.Is(err, service.ErrQuestionNotFound) || errors.Is(err, service.ErrTranslationNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if errors.Is(err, service.ErrInvalidAnswerOptions) {
+		s.Logger.WarnContext(ctx, "invalid answer options", slog.Any("error", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to update question translation", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteQuestionTranslationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	locale := r.PathValue("locale")
+	if !isQuestionLocale(locale) {
+		s.Logger.WarnContext(ctx, "invalid locale parameter", slog.String("locale", locale))
+		http.Error(w, "Invalid locale", http.StatusBadRequest)
+		return
+	}
+
+	questionID, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to parse question UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	err = s.QuestionService.DeleteTranslation(ctx, questionID, locale)
+	if errors.Is(err, service.ErrTranslationNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if errors.Is(err, service.ErrDefaultTranslation) {
+		s.Logger.WarnContext(ctx, "tried to delete default translation", slog.String("locale", locale))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to delete question translation", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+type UpdateGroup struct {
+	Name string `json:"group_name" validate:"required"`
+}
+
+func (s *Server) updateGroupHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to ready request body", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var updateGroup UpdateGroup
+	if err := json.Unmarshal(body, &updateGroup); err != nil {
+		s.Logger.ErrorContext(ctx, "failed to unmarshal json", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(updateGroup)
+	if err != nil || strings.TrimSpace(updateGroup.Name) == "" {
+		s.Logger.ErrorContext(ctx, "failed to validate json", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	groupID, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to parse group UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	_, err = s.QuestionService.UpdateGroup(ctx, groupID, updateGroup.Name)
+	if errors.Is(err, service.ErrGroupNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if errors.Is(err, service.ErrGroupExists) {
+		s.Logger.WarnContext(ctx, "group name already used", slog.String("group_name", updateGroup.Name))
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to update group", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteGroupHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	groupID, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to parse group UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	archived, err := s.QuestionService.DeleteGroup(ctx, groupID)
+	if errors.Is(err, service.ErrGroupNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to delete group", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeDeleted(w, r, archived)
+}
+
+func (s *Server) writeDeleted(w http.ResponseWriter, r *http.Request, archived bool) {
+	ctx := r.Context()
+
+	resp, err := json.Marshal(Deleted{Archived: archived})
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to encode deleted", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(resp)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to write JSON", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+}
+
+type NewQuestionPair struct {
+	FibberQuestionID string `json:"fibber_question_id" validate:"required,uuid"`
+}
+
+// addQuestionPairHandler pairs the question in the path, which normal players get, with the fibber question.
+func (s *Server) addQuestionPairHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to ready request body", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var newPair NewQuestionPair
+	if err := json.Unmarshal(body, &newPair); err != nil {
+		s.Logger.ErrorContext(ctx, "failed to unmarshal json", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(newPair)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to validate json", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	questionID, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to parse question UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	_, err = s.QuestionService.AddPair(ctx, questionID, uuid.FromStringOrNil(newPair.FibberQuestionID))
+	if errors.Is(err, service.ErrQuestionNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if errors.Is(err, service.ErrInvalidQuestionPair) {
+		s.Logger.WarnContext(ctx, "invalid question pair", slog.Any("error", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if errors.Is(err, service.ErrQuestionPairExists) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to add question pair", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+type QuestionPairs struct {
+	Pairs []service.QuestionPair
+}
+
+func (s *Server) getQuestionPairsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	questionID, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to parse question UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	pairs, err := s.QuestionService.GetPairs(ctx, questionID)
+	if errors.Is(err, service.ErrQuestionNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get question pairs", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := json.Marshal(QuestionPairs{Pairs: pairs})
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to encode question pairs", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(resp)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to write JSON", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *Server) deleteQuestionPairHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	questionID, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to parse question UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	fibberQuestionID, err := uuid.FromString(r.PathValue("fibber_id"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to parse fibber question UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	err = s.QuestionService.RemovePair(ctx, questionID, fibberQuestionID)
+	if errors.Is(err, service.ErrQuestionPairNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to delete question pair", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
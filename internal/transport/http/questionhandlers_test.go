@@ -0,0 +1,247 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	questionURL = "/question/0193a62a-4dff-774c-850a-b1fe78e2a910"
+	groupURL    = "/group/0193a62a-4dff-774c-850a-b1fe78e2a911"
+	missingID   = "00000000-0000-0000-0000-000000000000"
+)
+
+func sendRequest(t *testing.T, method string, url string, body string) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+
+	return resp
+}
+
+func TestQuestionHandlerUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		body       string
+		statusCode int
+	}{
+		{"Should update question", `{"group_name": "animals", "round_type": "most_likely"}`, http.StatusOK},
+		{"Should return bad request when nothing to update", `{}`, http.StatusBadRequest},
+		{"Should return bad request for invalid round type", `{"round_type": "not_a_round"}`, http.StatusBadRequest},
+		{"Should return not found for missing group", `{"group_name": "missing"}`, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testServer, _ := setupGameHandlersTest(t)
+
+			resp := sendRequest(t, http.MethodPut, testServer.URL+questionURL, tt.body)
+			assert.Equal(t, tt.statusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestQuestionHandlerDelete(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should archive question used in a round", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp := sendRequest(t, http.MethodDelete, testServer.URL+questionURL, "")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body struct {
+			Archived bool
+		}
+		err := json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.True(t, body.Archived)
+	})
+
+	t.Run("Should return not found for missing question", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp := sendRequest(t, http.MethodDelete, testServer.URL+"/question/"+missingID, "")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Should not allow other methods", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp := sendRequest(t, http.MethodPatch, testServer.URL+questionURL, "")
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
+
+func TestQuestionHandlerTranslation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		method     string
+		locale     string
+		body       string
+		statusCode int
+	}{
+		{"Should update translation", http.MethodPut, "de-DE", `{"text": "Was ist dein Lieblingstier?"}`, http.StatusOK},
+		{"Should return not found for missing translation", http.MethodPut, "pt-PT", `{"text": "Olá"}`, http.StatusNotFound},
+		{"Should return bad request for missing text", http.MethodPut, "de-DE", `{}`, http.StatusBadRequest},
+		{"Should return bad request for invalid locale", http.MethodPut, "xx-XX", `{"text": "Hi"}`, http.StatusBadRequest},
+		{"Should delete translation", http.MethodDelete, "de-DE", "", http.StatusOK},
+		{"Should not delete default translation", http.MethodDelete, "en-GB", "", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testServer, _ := setupGameHandlersTest(t)
+
+			resp := sendRequest(t, tt.method, testServer.URL+questionURL+"/locale/"+tt.locale, tt.body)
+			assert.Equal(t, tt.statusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestQuestionHandlerGroup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		method     string
+		url        string
+		body       string
+		statusCode int
+	}{
+		{"Should rename group", http.MethodPut, groupURL, `{"group_name": "animals"}`, http.StatusOK},
+		{"Should return conflict for name in use", http.MethodPut, groupURL, `{"group_name": "taken"}`, http.StatusConflict},
+		{"Should return bad request for empty name", http.MethodPut, groupURL, `{"group_name": " "}`, http.StatusBadRequest},
+		{"Should delete group", http.MethodDelete, groupURL, "", http.StatusOK},
+		{"Should return not found for missing group", http.MethodDelete, "/group/" + missingID, "", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testServer, _ := setupGameHandlersTest(t)
+
+			resp := sendRequest(t, tt.method, testServer.URL+tt.url, tt.body)
+			assert.Equal(t, tt.statusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestQuestionPairHandlerAdd(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		url        string
+		body       string
+		statusCode int
+	}{
+		{
+			"Should add pair",
+			questionURL,
+			`{"fibber_question_id": "0193a62a-4dff-774c-850a-b1fe78e2a921"}`,
+			http.StatusCreated,
+		},
+		{
+			"Should return bad request for invalid fibber question ID",
+			questionURL,
+			`{"fibber_question_id": "cat"}`,
+			http.StatusBadRequest,
+		},
+		{
+			"Should return bad request when pairing question with itself",
+			questionURL,
+			`{"fibber_question_id": "0193a62a-4dff-774c-850a-b1fe78e2a910"}`,
+			http.StatusBadRequest,
+		},
+		{
+			"Should return not found for missing question",
+			"/question/" + missingID,
+			`{"fibber_question_id": "0193a62a-4dff-774c-850a-b1fe78e2a921"}`,
+			http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			testServer, _ := setupGameHandlersTest(t)
+
+			resp := sendRequest(t, http.MethodPost, testServer.URL+tt.url+"/pair", tt.body)
+			assert.Equal(t, tt.statusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestQuestionPairHandlerGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should get pairs", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp := sendRequest(t, http.MethodGet, testServer.URL+questionURL+"/pair", "")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body struct {
+			Pairs []struct {
+				NormalQuestionID string
+				FibberQuestionID string
+			}
+		}
+		err := json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Len(t, body.Pairs, 1)
+		assert.Equal(t, "0193a62a-4dff-774c-850a-b1fe78e2a910", body.Pairs[0].NormalQuestionID)
+		assert.Equal(t, "0193a62a-4dff-774c-850a-b1fe78e2a921", body.Pairs[0].FibberQuestionID)
+	})
+
+	t.Run("Should return not found for missing question", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp := sendRequest(t, http.MethodGet, testServer.URL+"/question/"+missingID+"/pair", "")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestQuestionPairHandlerDelete(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should delete pair", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		url := testServer.URL + questionURL + "/pair/0193a62a-4dff-774c-850a-b1fe78e2a921"
+		resp := sendRequest(t, http.MethodDelete, url, "")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Should return not found for missing pair", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp := sendRequest(t, http.MethodDelete, testServer.URL+questionURL+"/pair/"+missingID, "")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -0,0 +1,369 @@
+package http
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+)
+
+const (
+	csvContentType = "text/csv"
+	// answerOptionsSeparator joins answer options into a single CSV column.
+	answerOptionsSeparator = "|"
+)
+
+var questionPackCSVHeader = []string{
+	"question_key",
+	"group_name",
+	"group_type",
+	"round_type",
+	"enabled",
+	"locale",
+	"text",
+	"answer_options",
+}
+
+// QuestionPack is the JSON format of a question pack, a question has a translation for every locale it supports.
+type QuestionPack struct {
+	Groups    []QuestionPackGroup    `json:"groups"`
+	Questions []QuestionPackQuestion `json:"questions"`
+}
+
+type QuestionPackGroup struct {
+	Name string `json:"group_name"`
+	Type string `json:"group_type,omitempty"`
+}
+
+type QuestionPackQuestion struct {
+	GroupName string `json:"group_name"`
+	RoundType string `json:"round_type"`
+	// Enabled defaults to true, so packs only need to set it for disabled questions.
+	Enabled      *bool                     `json:"enabled,omitempty"`
+	Translations []QuestionPackTranslation `json:"translations"`
+}
+
+type QuestionPackTranslation struct {
+	Locale        string   `json:"locale"`
+	Text          string   `json:"text"`
+	AnswerOptions []string `json:"answer_options,omitempty"`
+}
+
+type QuestionPackImport struct {
+	Result service.ImportResult
+}
+
+// questionPackHandler handles both GET and POST requests for /question/pack
+func (s *Server) questionPackHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			s.importQuestionPackHandler(w, r)
+		case http.MethodGet:
+			s.exportQuestionPackHandler(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func (s *Server) importQuestionPackHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dryRun := false
+	if dryRunQuery := r.URL.Query().Get("dry_run"); dryRunQuery != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(dryRunQuery)
+		if err != nil {
+			s.Logger.WarnContext(ctx, "failed to parse dry_run", slog.Any("error", err))
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to ready request body", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	defer r.Body.Close()
+
+	var pack service.QuestionPack
+	var parseErrors []service.ImportError
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == csvContentType {
+		pack, parseErrors, err = parseQuestionPackCSV(body)
+	} else {
+		pack, err = parseQuestionPackJSON(body)
+	}
+	if err != nil {
+		s.Logger.WarnContext(ctx, "failed to parse question pack", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	result := service.ImportResult{DryRun: dryRun, Errors: parseErrors}
+	if len(parseErrors) == 0 {
+		result, err = s.QuestionService.ImportPack(ctx, pack, dryRun)
+	} else {
+		err = service.ErrInvalidQuestionPack
+	}
+
+	status := http.StatusCreated
+	if dryRun {
+		status = http.StatusOK
+	}
+
+	if errors.Is(err, service.ErrInvalidQuestionPack) {
+		s.Logger.WarnContext(ctx, "invalid question pack", slog.Int("errors", len(result.Errors)))
+		// INFO: A dry run is asking for the errors, so it still succeeded.
+		if !dryRun {
+			status = http.StatusBadRequest
+		}
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to import question pack", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := json.Marshal(QuestionPackImport{Result: result})
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to encode import result", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_, err = w.Write(resp)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to write JSON", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *Server) exportQuestionPackHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		http.Error(w, "Invalid format: must be json or csv", http.StatusBadRequest)
+		return
+	}
+
+	pack, err := s.QuestionService.ExportPack(ctx, r.URL.Query().Get("group_name"))
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to export question pack", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	var resp []byte
+	if format == "csv" {
+		resp, err = writeQuestionPackCSV(pack)
+		w.Header().Set("Content-Type", csvContentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="questions.csv"`)
+	} else {
+		resp, err = json.Marshal(newQuestionPackJSON(pack))
+		w.Header().Set("Content-Type", "application/json")
+	}
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to encode question pack", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(resp)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to write question pack", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func parseQuestionPackJSON(body []byte) (service.QuestionPack, error) {
+	var questionPack QuestionPack
+	if err := json.Unmarshal(body, &questionPack); err != nil {
+		return service.QuestionPack{}, err
+	}
+
+	pack := service.QuestionPack{}
+	for _, group := range questionPack.Groups {
+		pack.Groups = append(pack.Groups, service.Group{Name: group.Name, Type: group.Type})
+	}
+
+	for i, question := range questionPack.Questions {
+		translations := []service.QuestionTranslation{}
+		for _, translation := range question.Translations {
+			translations = append(translations, service.QuestionTranslation{
+				Text:          translation.Text,
+				Locale:        translation.Locale,
+				AnswerOptions: translation.AnswerOptions,
+			})
+		}
+
+		pack.Questions = append(pack.Questions, service.PackQuestion{
+			Row:          i + 1,
+			GroupName:    question.GroupName,
+			RoundType:    question.RoundType,
+			Enabled:      question.Enabled == nil || *question.Enabled,
+			Translations: translations,
+		})
+	}
+
+	return pack, nil
+}
+
+func newQuestionPackJSON(pack service.QuestionPack) QuestionPack {
+	questionPack := QuestionPack{Groups: []QuestionPackGroup{}, Questions: []QuestionPackQuestion{}}
+	for _, group := range pack.Groups {
+		questionPack.Groups = append(questionPack.Groups, QuestionPackGroup{Name: group.Name, Type: group.Type})
+	}
+
+	for _, question := range pack.Questions {
+		translations := []QuestionPackTranslation{}
+		for _, translation := range question.Translations {
+			translations = append(translations, QuestionPackTranslation{
+				Locale:        translation.Locale,
+				Text:          translation.Text,
+				AnswerOptions: translation.AnswerOptions,
+			})
+		}
+
+		enabled := question.Enabled
+		questionPack.Questions = append(questionPack.Questions, QuestionPackQuestion{
+			GroupName:    question.GroupName,
+			RoundType:    question.RoundType,
+			Enabled:      &enabled,
+			Translations: translations,
+		})
+	}
+
+	return questionPack
+}
+
+// parseQuestionPackCSV reads a pack with a row for each translation. Rows with the same question key are
+// translations of the same question, which takes its group, round type and enabled flag from its first row. Rows
+// which can't be read are returned as import errors, so they are reported like any other invalid row.
+func parseQuestionPackCSV(body []byte) (service.QuestionPack, []service.ImportError, error) {
+	reader := csv.NewReader(strings.NewReader(string(body)))
+	reader.FieldsPerRecord = len(questionPackCSVHeader)
+
+	header, err := reader.Read()
+	if err != nil {
+		return service.QuestionPack{}, nil, err
+	}
+	for i, column := range questionPackCSVHeader {
+		if header[i] != column {
+			return service.QuestionPack{}, nil, fmt.Errorf("expected column %d to be %s", i+1, column)
+		}
+	}
+
+	pack := service.QuestionPack{}
+	parseErrors := []service.ImportError{}
+	groups := map[string]bool{}
+	questions := map[string]int{}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return service.QuestionPack{}, nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		key, groupName, groupType, roundType := record[0], record[1], record[2], record[3]
+		enabledValue, locale, text, answerOptionsValue := record[4], record[5], record[6], record[7]
+
+		enabled := true
+		if enabledValue != "" {
+			enabled, err = strconv.ParseBool(enabledValue)
+			if err != nil {
+				parseErrors = append(parseErrors, service.ImportError{
+					Row:     line,
+					Locale:  locale,
+					Message: fmt.Sprintf("invalid enabled value %q", enabledValue),
+				})
+				continue
+			}
+		}
+
+		if groupName != "" && !groups[groupName] {
+			groups[groupName] = true
+			pack.Groups = append(pack.Groups, service.Group{Name: groupName, Type: groupType})
+		}
+
+		var answerOptions []string
+		if answerOptionsValue != "" {
+			answerOptions = strings.Split(answerOptionsValue, answerOptionsSeparator)
+		}
+
+		i, ok := questions[key]
+		if !ok || key == "" {
+			i = len(pack.Questions)
+			questions[key] = i
+			pack.Questions = append(pack.Questions, service.PackQuestion{
+				Row:       line,
+				GroupName: groupName,
+				RoundType: roundType,
+				Enabled:   enabled,
+			})
+		}
+
+		pack.Questions[i].Translations = append(pack.Questions[i].Translations, service.QuestionTranslation{
+			Text:          text,
+			Locale:        locale,
+			AnswerOptions: answerOptions,
+		})
+	}
+
+	return pack, parseErrors, nil
+}
+
+func writeQuestionPackCSV(pack service.QuestionPack) ([]byte, error) {
+	var b strings.Builder
+	writer := csv.NewWriter(&b)
+
+	err := writer.Write(questionPackCSVHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	groupTypes := map[string]string{}
+	for _, group := range pack.Groups {
+		groupTypes[group.Name] = group.Type
+	}
+
+	for i, question := range pack.Questions {
+		for _, translation := range question.Translations {
+			err = writer.Write([]string{
+				strconv.Itoa(i + 1),
+				question.GroupName,
+				groupTypes[question.GroupName],
+				question.RoundType,
+				strconv.FormatBool(question.Enabled),
+				translation.Locale,
+				translation.Text,
+				strings.Join(translation.AnswerOptions, answerOptionsSeparator),
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	writer.Flush()
+	return []byte(b.String()), writer.Error()
+}
@@ -0,0 +1,191 @@
+package http_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+)
+
+const questionPackCSV = `question_key,group_name,group_type,round_type,enabled,locale,text,answer_options
+1,animals,questions,multiple_choice,true,en-GB,Cats are better than dogs,Yes|No
+1,animals,questions,multiple_choice,true,de-DE,Katzen sind besser als Hunde,Ja|Nein
+2,animals,questions,free_form,false,en-GB,What is your favourite animal?,
+`
+
+type questionPackImportResponse struct {
+	Result service.ImportResult
+}
+
+func TestQuestionPackHandlerExport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should export pack as JSON", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/question/pack?group_name=animals")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"groups": [{"group_name": "animals", "group_type": "questions"}],
+			"questions": [{
+				"group_name": "animals",
+				"round_type": "multiple_choice",
+				"enabled": true,
+				"translations": [
+					{"locale": "en-GB", "text": "Cats are better than dogs", "answer_options": ["Yes", "No"]},
+					{"locale": "de-DE", "text": "Katzen sind besser als Hunde", "answer_options": ["Ja", "Nein"]}
+				]
+			}]
+		}`, string(body))
+	})
+
+	t.Run("Should export pack as CSV", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/question/pack?format=csv")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"question_key,group_name,group_type,round_type,enabled,locale,text,answer_options",
+			"1,animals,questions,multiple_choice,true,en-GB,Cats are better than dogs,Yes|No",
+			"1,animals,questions,multiple_choice,true,de-DE,Katzen sind besser als Hunde,Ja|Nein",
+			"",
+		}, "\n"), string(body))
+	})
+
+	t.Run("Should return bad request for invalid format", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/question/pack?format=xml")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestQuestionPackHandlerImport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should import JSON pack", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		pack := `{
+			"groups": [{"group_name": "animals"}],
+			"questions": [{
+				"group_name": "animals",
+				"round_type": "free_form",
+				"translations": [{"locale": "en-GB", "text": "What is your favourite animal?"}]
+			}]
+		}`
+		resp, err := http.Post(testServer.URL+"/question/pack", "application/json", strings.NewReader(pack))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var body questionPackImportResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, service.ImportResult{
+			Groups:       1,
+			Questions:    1,
+			Translations: 1,
+			Errors:       []service.ImportError{},
+		}, body.Result)
+	})
+
+	t.Run("Should import CSV pack, merging translations of the same question", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Post(
+			testServer.URL+"/question/pack",
+			"text/csv; charset=utf-8",
+			strings.NewReader(questionPackCSV),
+		)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var body questionPackImportResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, 1, body.Result.Groups)
+		assert.Equal(t, 2, body.Result.Questions)
+		assert.Equal(t, 3, body.Result.Translations)
+	})
+
+	t.Run("Should report errors without failing in dry run", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		csv := questionPackCSV + "3,animals,questions,not_a_round_type,true,en-GB,Dogs are better than cats,\n"
+		resp, err := http.Post(testServer.URL+"/question/pack?dry_run=true", "text/csv", strings.NewReader(csv))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body questionPackImportResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.True(t, body.Result.DryRun)
+		assert.Equal(t, []service.ImportError{{Row: 5, Message: "invalid round type"}}, body.Result.Errors)
+	})
+
+	t.Run("Should return bad request with errors for invalid pack", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		csv := questionPackCSV + "3,animals,questions,free_form,maybe,en-GB,Dogs are better than cats,\n"
+		resp, err := http.Post(testServer.URL+"/question/pack", "text/csv", strings.NewReader(csv))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var body questionPackImportResponse
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, []service.ImportError{
+			{Row: 5, Locale: "en-GB", Message: `invalid enabled value "maybe"`},
+		}, body.Result.Errors)
+	})
+
+	t.Run("Should return bad request for CSV with wrong columns", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		csv := "group_name,text\nanimals,Cats are better than dogs\n"
+		resp, err := http.Post(testServer.URL+"/question/pack", "text/csv", strings.NewReader(csv))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/a-h/templ"
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	"gitlab.com/hmajid2301/banterbus/internal/views"
+	"gitlab.com/hmajid2301/banterbus/internal/views/pages"
+)
+
+type RecapServicer interface {
+	GetRecap(ctx context.Context, gameStateID uuid.UUID) (service.GameRecap, error)
+}
+
+func (s *Server) recapHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	recap, ok := s.getRecap(w, r)
+	if !ok {
+		return
+	}
+
+	languages, err := views.ListLanguages()
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to list supported languages", slog.Any("error", err))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	templ.Handler(pages.Recap(languages, s.Config.Environment, recap)).ServeHTTP(w, r)
+}
+
+type Recap struct {
+	Recap service.GameRecap
+}
+
+func (s *Server) getRecapHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	recap, ok := s.getRecap(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := json.Marshal(Recap{Recap: recap})
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to encode recap", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write(resp)
+	if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to write JSON", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// getRecap writes the error response itself, so callers should just return when it isn't ok.
+func (s *Server) getRecap(w http.ResponseWriter, r *http.Request) (service.GameRecap, bool) {
+	ctx := r.Context()
+
+	gameStateID, err := uuid.FromString(r.PathValue("game_state_id"))
+	if err != nil {
+		s.Logger.WarnContext(ctx, "failed to parse game state UUID", slog.Any("error", err))
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return service.GameRecap{}, false
+	}
+
+	recap, err := s.RecapService.GetRecap(ctx, gameStateID)
+	if errors.Is(err, service.ErrGameNotFound) {
+		http.Error(w, "Not Found", http.StatusNotFound)
+		return service.GameRecap{}, false
+	} else if errors.Is(err, service.ErrGameNotOver) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return service.GameRecap{}, false
+	} else if err != nil {
+		s.Logger.ErrorContext(ctx, "failed to get recap", slog.Any("error", err))
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return service.GameRecap{}, false
+	}
+
+	return recap, true
+}
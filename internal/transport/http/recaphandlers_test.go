@@ -0,0 +1,138 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+)
+
+var (
+	finishedGameStateID = uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8e1"))
+	playingGameStateID  = uuid.Must(uuid.FromString("0193a62a-4dff-774c-850a-b1fe78e2a8e2"))
+)
+
+type mockRecapServicer struct{}
+
+func (m *mockRecapServicer) GetRecap(ctx context.Context, gameStateID uuid.UUID) (service.GameRecap, error) {
+	switch gameStateID {
+	case finishedGameStateID:
+		return service.GameRecap{
+			GameStateID: gameStateID,
+			Rounds: []service.RoundRecap{
+				{
+					Round:          1,
+					RoundType:      "free_form",
+					NormalQuestion: "What is your favourite food?",
+					FibberQuestion: "What is your least favourite food?",
+					Players: []service.PlayerRecap{
+						{Nickname: "Host Player", Role: service.NormalRole, Answer: "Pizza", Points: 100},
+						{Nickname: "Other Player", Role: service.FibberRole, Answer: "Olives"},
+					},
+					Votes: []service.VoteRecap{
+						{VoterNickname: "Host Player", VotedForNickname: "Other Player"},
+					},
+				},
+			},
+		}, nil
+	case playingGameStateID:
+		return service.GameRecap{}, service.ErrGameNotOver
+	default:
+		return service.GameRecap{}, service.ErrGameNotFound
+	}
+}
+
+func TestRecapHandlerPage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should return HTML page for finished game", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/recap/" + finishedGameStateID.String())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "What is your least favourite food?")
+		assert.Contains(t, string(body), "Olives")
+	})
+
+	t.Run("Should return not found for unknown game", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/recap/" + uuid.Must(uuid.NewV4()).String())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestRecapHandlerAPI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should return recap as JSON for finished game", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/api/games/" + finishedGameStateID.String())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		var body struct {
+			Recap service.GameRecap
+		}
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, finishedGameStateID, body.Recap.GameStateID)
+		assert.Len(t, body.Recap.Rounds, 1)
+		assert.Equal(t, service.FibberRole, body.Recap.Rounds[0].Players[1].Role)
+	})
+
+	t.Run("Should return conflict for game still being played", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/api/games/" + playingGameStateID.String())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Should return bad request for invalid game state ID", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Get(testServer.URL + "/api/games/not-a-uuid")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Should not allow other methods", func(t *testing.T) {
+		t.Parallel()
+		testServer, _ := setupGameHandlersTest(t)
+
+		resp, err := http.Post(testServer.URL+"/api/games/"+finishedGameStateID.String(), "application/json", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
@@ -0,0 +1,62 @@
+package websockets
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	"gitlab.com/hmajid2301/banterbus/internal/transport/http/middleware"
+)
+
+type AccountServicer interface {
+	SignIn(ctx context.Context, subject string) (service.Account, error)
+	LinkPlayer(ctx context.Context, accountID uuid.UUID, playerID uuid.UUID) error
+}
+
+// signIn gets the account of a player who connected with a valid JWT, anonymous players don't have one. Failing to
+// sign in shouldn't stop anyone from playing, so they carry on anonymously.
+func (s *Subscriber) signIn(ctx context.Context) *service.Account {
+	subject, ok := middleware.AccountSubject(ctx)
+	if !ok {
+		return nil
+	}
+
+	account, err := s.accountService.SignIn(ctx, subject)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to sign in player", slog.Any("error", err))
+		return nil
+	}
+
+	return &account
+}
+
+// linkAccount links the player the client is playing as to their account, so the game counts towards their stats.
+// The lobby is updated in place, so everyone sees the player's saved avatar.
+func (s *Subscriber) linkAccount(ctx context.Context, client *Client, lobby *service.Lobby) {
+	if client.account == nil {
+		return
+	}
+
+	err := s.accountService.LinkPlayer(ctx, client.account.ID, client.playerID)
+	if err != nil {
+		s.logger.WarnContext(
+			ctx,
+			"failed to link player to account",
+			slog.Any("error", err),
+			slog.String("player_id", client.playerID.String()),
+		)
+		return
+	}
+
+	if client.account.Avatar == "" {
+		return
+	}
+
+	for i, player := range lobby.Players {
+		if player.ID == client.playerID {
+			lobby.Players[i].Avatar = client.account.Avatar
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package websockets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+	"gitlab.com/hmajid2301/banterbus/internal/telemetry"
+	"gitlab.com/hmajid2301/banterbus/internal/views/sections"
+)
+
+type AudienceServicer interface {
+	Join(ctx context.Context, roomCode string, audienceID uuid.UUID, nickname string) (service.AudienceMember, error)
+	GetAudienceMember(ctx context.Context, audienceID uuid.UUID) (service.AudienceMember, error)
+	GetAudience(ctx context.Context, gameStateID uuid.UUID) ([]service.AudienceMember, error)
+	SubmitVote(ctx context.Context, audienceID uuid.UUID, votedNickname string, submittedAt time.Time) error
+}
+
+func (j *JoinAudience) Handle(ctx context.Context, client *Client, sub *Subscriber) error {
+	telemetry.AddGameContextToSpan(ctx, telemetry.GameContext{
+		PlayerID: &client.playerID,
+		RoomCode: j.RoomCode,
+	})
+
+	telemetry.AddPlayerActionAttributes(ctx, client.playerID.String(), "join_audience", false, false)
+
+	member, err := sub.audienceService.Join(ctx, j.RoomCode, client.playerID, j.PlayerNickname)
+	if err != nil {
+		telemetry.RecordBusinessLogicError(ctx, "join_audience", err.Error(), telemetry.GameContext{
+			PlayerID: &client.playerID,
+			RoomCode: j.RoomCode,
+		})
+		errStr := "Failed to join the audience"
+		if errors.Is(err, service.ErrGameNotPlaying) {
+			errStr = "Game has not started yet, join as a player instead"
+		}
+		clientErr := sub.updateClientAboutErr(ctx, client.playerID, errStr)
+		return errors.Join(clientErr, err)
+	}
+
+	component, err := sub.getAudienceView(ctx, member)
+	if err != nil {
+		clientErr := sub.updateClientAboutErr(ctx, client.playerID, "Failed to join the audience")
+		return errors.Join(clientErr, err)
+	}
+
+	var buf bytes.Buffer
+	err = component.Render(sub.getContextWithLocale(ctx, member.Locale), &buf)
+	if err != nil {
+		return err
+	}
+
+	return sub.websocket.Publish(ctx, client.playerID, buf.Bytes())
+}
+
+func (s *SubmitAudienceVote) Handle(ctx context.Context, client *Client, sub *Subscriber) error {
+	telemetry.AddPlayerActionAttributes(ctx, client.playerID.String(), "submit_audience_vote", false, false)
+
+	err := sub.audienceService.SubmitVote(ctx, client.playerID, s.VotedPlayerNickname, time.Now())
+	if err != nil {
+		errStr := "Failed to submit vote."
+		if errors.Is(err, service.ErrNotRevoteCandidate) {
+			errStr = "You can only vote for the tied players."
+		}
+		clientErr := sub.updateClientAboutErr(ctx, client.playerID, errStr)
+		return errors.Join(clientErr, err)
+	}
+
+	t := Toast{Message: "Vote Submitted", Type: "success"}
+	toastJSON, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	return sub.websocket.Publish(ctx, client.playerID, toastJSON)
+}
+
+// getAudienceView gets the screen for the current state of the game, as someone watching it. The audience sees the
+// same state as the players but can't answer questions.
+func (s Subscriber) getAudienceView(ctx context.Context, member service.AudienceMember) (templ.Component, error) {
+	var component templ.Component
+	gameState, err := s.roundService.GetGameState(ctx, member.HostPlayerID)
+	if err != nil {
+		return component, err
+	}
+
+	switch gameState {
+	case db.FibbingITQuestion:
+		question, err := s.getGameQuestionState(ctx, member.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		component = sections.AudienceQuestion(question)
+	case db.FibbingItVoting:
+		voting, err := s.roundService.GetVotingState(ctx, member.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		component = sections.AudienceVoting(voting)
+	case db.FibbingItReveal:
+		reveal, err := s.roundService.GetRevealState(ctx, member.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		component = sections.Reveal(reveal)
+	case db.FibbingItScoring:
+		score, err := s.roundService.GetScoreState(ctx, s.getScoring(), member.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		component = sections.Score(score, service.PlayerWithScoring{}, getMaxScore(score.Players))
+	case db.FibbingItWinner:
+		state, err := s.roundService.GetWinnerState(ctx, member.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		component = sections.Winner(state, service.PlayerWithScoring{}, getMaxScore(state.Players))
+	default:
+		return component, fmt.Errorf("unknown game state: %s", gameState)
+	}
+
+	return component, nil
+}
+
+// getGameQuestionState gets the question state for every player in the game, rather than just for the host.
+func (s Subscriber) getGameQuestionState(ctx context.Context, hostPlayerID uuid.UUID) (service.QuestionState, error) {
+	hostState, err := s.roundService.GetQuestionState(ctx, hostPlayerID)
+	if err != nil {
+		return service.QuestionState{}, err
+	}
+
+	return s.roundService.GetQuestionStateByGameStateID(ctx, hostState.GameStateID)
+}
+
+// updateAudience sends the same view to everyone watching the game, rendered in each of their locales.
+func (s *Subscriber) updateAudience(ctx context.Context, gameStateID uuid.UUID, component templ.Component) error {
+	audience, err := s.audienceService.GetAudience(ctx, gameStateID)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range audience {
+		var buf bytes.Buffer
+		err := component.Render(s.getContextWithLocale(ctx, member.Locale), &buf)
+		if err != nil {
+			return err
+		}
+
+		err = s.websocket.Publish(ctx, member.ID, buf.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getMaxScore(players []service.PlayerWithScoring) int {
+	maxScore := 0
+	for _, player := range players {
+		if player.Score > maxScore {
+			maxScore = player.Score
+		}
+	}
+	return maxScore
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/redis/go-redis/v9"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
 )
 
 type Client struct {
@@ -12,6 +14,8 @@ type Client struct {
 	connection   net.Conn
 	playerID     uuid.UUID
 	connectionID string
+	// account is only set for signed in players.
+	account *service.Account
 }
 
 func newClient(conn net.Conn, playerID uuid.UUID, ch <-chan *redis.Message, connectionID string) *Client {
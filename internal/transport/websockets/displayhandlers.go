@@ -0,0 +1,158 @@
+package websockets
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/a-h/templ"
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/service"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+	"gitlab.com/hmajid2301/banterbus/internal/telemetry"
+	"gitlab.com/hmajid2301/banterbus/internal/views/sections"
+)
+
+type DisplayServicer interface {
+	Connect(ctx context.Context, roomCode string, displayID uuid.UUID) (service.Display, error)
+	GetDisplay(ctx context.Context, displayID uuid.UUID) (service.Display, error)
+	GetDisplaysInLobby(ctx context.Context, roomCode string) ([]service.Display, error)
+	GetDisplays(ctx context.Context, gameStateID uuid.UUID) ([]service.Display, error)
+}
+
+func (c *ConnectDisplay) Handle(ctx context.Context, client *Client, sub *Subscriber) error {
+	telemetry.AddGameContextToSpan(ctx, telemetry.GameContext{
+		PlayerID: &client.playerID,
+		RoomCode: c.RoomCode,
+	})
+
+	display, err := sub.displayService.Connect(ctx, c.RoomCode, client.playerID)
+	if err != nil {
+		telemetry.RecordBusinessLogicError(ctx, "connect_display", err.Error(), telemetry.GameContext{
+			PlayerID: &client.playerID,
+			RoomCode: c.RoomCode,
+		})
+		errStr := "Failed to connect display to room"
+		if errors.Is(err, service.ErrRoomNotActive) {
+			errStr = "Room has already finished"
+		} else if errors.Is(err, service.ErrDisplayIsPlayer) {
+			errStr = "Players cannot connect as a display"
+		}
+		clientErr := sub.updateClientAboutErr(ctx, client.playerID, errStr)
+		return errors.Join(clientErr, err)
+	}
+
+	buf, err := sub.renderDisplay(ctx, display)
+	if err != nil {
+		clientErr := sub.updateClientAboutErr(ctx, client.playerID, "Failed to connect display to room")
+		return errors.Join(clientErr, err)
+	}
+
+	return sub.websocket.Publish(ctx, client.playerID, buf.Bytes())
+}
+
+func (s Subscriber) renderDisplay(ctx context.Context, display service.Display) (bytes.Buffer, error) {
+	var buf bytes.Buffer
+	component, err := s.getDisplayView(ctx, display)
+	if err != nil {
+		return buf, err
+	}
+
+	err = component.Render(s.getContextWithLocale(ctx, display.Locale), &buf)
+	return buf, err
+}
+
+// getDisplayView gets the big screen version of the room's current state.
+func (s Subscriber) getDisplayView(ctx context.Context, display service.Display) (templ.Component, error) {
+	var component templ.Component
+	switch display.RoomState {
+	case db.Created:
+		lobby, err := s.lobbyService.GetLobby(ctx, display.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		return sections.DisplayLobby(lobby), nil
+	case db.Playing, db.Paused:
+	default:
+		return component, fmt.Errorf("cannot show display for room state: %s", display.RoomState)
+	}
+
+	gameState, err := s.roundService.GetGameState(ctx, display.HostPlayerID)
+	if err != nil {
+		return component, err
+	}
+
+	switch gameState {
+	case db.FibbingITQuestion:
+		question, err := s.getGameQuestionState(ctx, display.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		component = sections.DisplayQuestion(question)
+	case db.FibbingItVoting:
+		voting, err := s.roundService.GetVotingState(ctx, display.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		component = sections.DisplayVoting(voting)
+	case db.FibbingItReveal:
+		reveal, err := s.roundService.GetRevealState(ctx, display.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		component = sections.DisplayReveal(reveal)
+	case db.FibbingItScoring:
+		score, err := s.roundService.GetScoreState(ctx, s.getScoring(), display.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		component = sections.DisplayScore(score, getMaxScore(score.Players))
+	case db.FibbingItWinner:
+		state, err := s.roundService.GetWinnerState(ctx, display.HostPlayerID)
+		if err != nil {
+			return component, err
+		}
+		component = sections.DisplayWinner(state, getMaxScore(state.Players))
+	default:
+		return component, fmt.Errorf("unknown game state: %s", gameState)
+	}
+
+	return component, nil
+}
+
+func (s *Subscriber) updateDisplaysInLobby(ctx context.Context, lobby service.Lobby) error {
+	displays, err := s.displayService.GetDisplaysInLobby(ctx, lobby.Code)
+	if err != nil {
+		return err
+	}
+
+	return s.updateDisplays(ctx, displays, sections.DisplayLobby(lobby))
+}
+
+func (s *Subscriber) updateDisplaysInGame(ctx context.Context, gameStateID uuid.UUID, component templ.Component) error {
+	displays, err := s.displayService.GetDisplays(ctx, gameStateID)
+	if err != nil {
+		return err
+	}
+
+	return s.updateDisplays(ctx, displays, component)
+}
+
+func (s *Subscriber) updateDisplays(ctx context.Context, displays []service.Display, component templ.Component) error {
+	for _, display := range displays {
+		var buf bytes.Buffer
+		err := component.Render(s.getContextWithLocale(ctx, display.Locale), &buf)
+		if err != nil {
+			return err
+		}
+
+		err = s.websocket.Publish(ctx, display.ID, buf.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
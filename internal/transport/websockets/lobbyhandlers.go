@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -31,9 +32,20 @@ type LobbyServicer interface {
 		playerID uuid.UUID,
 		playerNicknameToKick string,
 	) (service.Lobby, uuid.UUID, error)
+	TransferHost(ctx context.Context, roomCode string, playerID uuid.UUID, newHostNickname string) (uuid.UUID, error)
+	MigrateHost(ctx context.Context, playerID uuid.UUID) (uuid.UUID, error)
+	Rematch(ctx context.Context, playerID uuid.UUID) (service.Lobby, error)
 	HandlePlayerDisconnect(ctx context.Context, playerID uuid.UUID) error
 	GetLobby(ctx context.Context, playerID uuid.UUID) (service.Lobby, error)
 	GetRoomState(ctx context.Context, playerID uuid.UUID) (db.RoomState, error)
+	GetRoomSettings(ctx context.Context, roomCode string) (service.RoomSettings, error)
+	GetLobbyRules(ctx context.Context, roomCode string) (service.LobbyRules, error)
+	UpdateRoomSettings(
+		ctx context.Context,
+		roomCode string,
+		playerID uuid.UUID,
+		settings service.RoomSettings,
+	) (service.RoomSettings, error)
 }
 
 func (c *CreateRoom) Handle(ctx context.Context, client *Client, sub *Subscriber) error {
@@ -58,6 +70,7 @@ func (c *CreateRoom) Handle(ctx context.Context, client *Client, sub *Subscriber
 	}
 
 	client.playerID = result.NewPlayerID
+	sub.linkAccount(ctx, client, &result.Lobby)
 
 	telemetry.AddGameContextToSpan(ctx, telemetry.GameContext{
 		PlayerID: &result.NewPlayerID,
@@ -124,6 +137,7 @@ func (j *JoinLobby) Handle(ctx context.Context, client *Client, sub *Subscriber)
 	}
 
 	client.playerID = result.NewPlayerID
+	sub.linkAccount(ctx, client, &result.Lobby)
 
 	sub.logger.InfoContext(ctx, "player successfully joined lobby",
 		slog.String("player_id", result.NewPlayerID.String()),
@@ -147,10 +161,17 @@ func (s *StartGame) Handle(ctx context.Context, client *Client, sub *Subscriber)
 
 	telemetry.AddPlayerActionAttributes(ctx, client.playerID.String(), "start_game", true, true)
 
-	deadline := time.Now().UTC().Add(sub.config.Timings.ShowQuestionScreenFor)
+	settings, err := sub.lobbyService.GetRoomSettings(ctx, s.RoomCode)
+	if err != nil {
+		clientErr := sub.updateClientAboutErr(ctx, client.playerID, "Failed to start game")
+		return errors.Join(clientErr, err)
+	}
+
+	showQuestionFor := durationOr(settings.Timings.ShowQuestionScreenFor, sub.config.Timings.ShowQuestionScreenFor)
+	deadline := time.Now().UTC().Add(showQuestionFor)
 	telemetry.AddTimingAttributes(ctx, "question_deadline",
-		sub.config.Timings.ShowQuestionScreenFor.String(),
-		sub.config.Timings.ShowQuestionScreenFor.String(), false)
+		showQuestionFor.String(),
+		showQuestionFor.String(), false)
 
 	questionState, err := sub.lobbyService.Start(ctx, s.RoomCode, client.playerID, deadline)
 	if err != nil {
@@ -159,8 +180,16 @@ func (s *StartGame) Handle(ctx context.Context, client *Client, sub *Subscriber)
 			RoomCode: s.RoomCode,
 		})
 		errStr := "Failed to start game"
-		if errors.Is(err, service.ErrNotEnoughPlayers) {
-			errStr = fmt.Sprintf("At least %d players are needed to start the game", service.MinimumPlayers)
+		if errors.Is(err, service.ErrNotEnoughPlayers) || errors.Is(err, service.ErrTooManyPlayers) {
+			rules, rulesErr := sub.lobbyService.GetLobbyRules(ctx, s.RoomCode)
+			switch {
+			case rulesErr != nil:
+				err = errors.Join(err, rulesErr)
+			case errors.Is(err, service.ErrNotEnoughPlayers):
+				errStr = fmt.Sprintf("At least %d players are needed to start the game", rules.MinimumPlayers)
+			default:
+				errStr = fmt.Sprintf("At most %d players can play this game", rules.MaximumPlayers)
+			}
 		}
 		clientErr := sub.updateClientAboutErr(ctx, client.playerID, errStr)
 		return errors.Join(clientErr, err)
@@ -178,7 +207,7 @@ func (s *StartGame) Handle(ctx context.Context, client *Client, sub *Subscriber)
 
 	stateCtx := telemetry.PropagateContext(ctx)
 
-	deps, err := sub.NewStateDependencies()
+	deps, err := sub.NewStateDependencies(ctx, questionState.GameStateID)
 	if err != nil {
 		sub.logger.ErrorContext(ctx, "failed to build state dependencies",
 			slog.Any("error", err),
@@ -221,3 +250,129 @@ func (k *KickPlayer) Handle(ctx context.Context, client *Client, sub *Subscriber
 	err = sub.updateClientAboutErr(ctx, playerToKickID, "you have been kicked from the room")
 	return err
 }
+
+func (t *TransferHost) Handle(ctx context.Context, client *Client, sub *Subscriber) error {
+	telemetry.AddGameContextToSpan(ctx, telemetry.GameContext{
+		PlayerID: &client.playerID,
+		RoomCode: t.RoomCode,
+	})
+
+	newHostID, err := sub.lobbyService.TransferHost(ctx, t.RoomCode, client.playerID, t.PlayerNickname)
+	if err != nil {
+		errStr := "Failed to transfer host"
+		if errors.Is(err, service.ErrNotHost) {
+			errStr = "Only the host can transfer host"
+		} else if errors.Is(err, service.ErrNewHostInactive) {
+			errStr = "Cannot make a disconnected player the host"
+		}
+		clientErr := sub.updateClientAboutErr(ctx, client.playerID, errStr)
+		return errors.Join(clientErr, err)
+	}
+
+	return sub.updateClientsAboutHost(ctx, newHostID)
+}
+
+func (p *PlayAgain) Handle(ctx context.Context, client *Client, sub *Subscriber) error {
+	telemetry.AddGameContextToSpan(ctx, telemetry.GameContext{
+		PlayerID: &client.playerID,
+	})
+
+	telemetry.AddPlayerActionAttributes(ctx, client.playerID.String(), "play_again", true, false)
+
+	// INFO: The host can rematch from the winner screen, so stop its timer first. Otherwise it would try to finish
+	// the old game after we have already moved everyone into the new room.
+	winnerState, err := sub.roundService.GetWinnerState(ctx, client.playerID)
+	if err == nil && winnerState.HostPlayerID == client.playerID {
+		sub.stopStateMachine(ctx, winnerState.GameStateID)
+	}
+
+	lobby, err := sub.lobbyService.Rematch(ctx, client.playerID)
+	if err != nil {
+		errStr := "Failed to start a new game"
+		if errors.Is(err, service.ErrNotHost) {
+			errStr = "Only the host can start a new game"
+		} else if errors.Is(err, service.ErrGameNotOver) {
+			errStr = "The game has not finished yet"
+		}
+		clientErr := sub.updateClientAboutErr(ctx, client.playerID, errStr)
+		return errors.Join(clientErr, err)
+	}
+
+	telemetry.AddRoomStateAttributes(ctx, "Created", lobby.Code, len(lobby.Players))
+
+	return sub.updateClientsAboutLobby(ctx, lobby)
+}
+
+func (u *UpdateRoomSettings) Handle(ctx context.Context, client *Client, sub *Subscriber) error {
+	telemetry.AddGameContextToSpan(ctx, telemetry.GameContext{
+		PlayerID: &client.playerID,
+		RoomCode: u.RoomCode,
+	})
+
+	telemetry.AddPlayerActionAttributes(ctx, client.playerID.String(), "update_room_settings", true, false)
+
+	settings := service.RoomSettings{
+		MaxRounds:  u.MaxRounds,
+		RoundTypes: splitList(u.RoundTypes),
+		Timings: service.Timings{
+			ShowQuestionScreenFor: time.Duration(u.QuestionSeconds) * time.Second,
+			ShowVotingScreenFor:   time.Duration(u.VotingSeconds) * time.Second,
+			ShowRevealScreenFor:   time.Duration(u.RevealSeconds) * time.Second,
+			ShowRevoteScreenFor:   time.Duration(u.RevoteSeconds) * time.Second,
+			ShowScoreScreenFor:    time.Duration(u.ScoreSeconds) * time.Second,
+			ShowWinnerScreenFor:   time.Duration(u.WinnerSeconds) * time.Second,
+		},
+		Scorers:         []string{},
+		RevealRule:      service.RevealRuleUnanimous,
+		TieBreak:        service.TieBreakNone,
+		Fibbers:         u.Fibbers,
+		FibberSelection: service.FibberSelectionFair,
+		FibberRotation:  service.FibberRotationRoundType,
+		SeriesGames:     u.SeriesGames,
+		RecentGames:     u.RecentGames,
+	}
+	if u.Scorers != "" {
+		settings.Scorers = splitList(u.Scorers)
+	}
+	if u.RevealRule != "" {
+		settings.RevealRule = u.RevealRule
+	}
+	if u.FibberSelection != "" {
+		settings.FibberSelection = u.FibberSelection
+	}
+	if u.FibberRotation != "" {
+		settings.FibberRotation = u.FibberRotation
+	}
+	// INFO: Ties can only happen with the plurality rule, so the form may still send the old tie break after the
+	// host switches to another rule.
+	if u.TieBreak != "" && settings.RevealRule == service.RevealRulePlurality {
+		settings.TieBreak = u.TieBreak
+	}
+	_, err := sub.lobbyService.UpdateRoomSettings(ctx, u.RoomCode, client.playerID, settings)
+	if err != nil {
+		errStr := "Failed to update room settings"
+		if errors.Is(err, service.ErrInvalidRoomSettings) {
+			errStr = err.Error()
+		}
+		clientErr := sub.updateClientAboutErr(ctx, client.playerID, errStr)
+		return errors.Join(clientErr, err)
+	}
+
+	lobby, err := sub.lobbyService.GetLobby(ctx, client.playerID)
+	if err != nil {
+		clientErr := sub.updateClientAboutErr(ctx, client.playerID, "Failed to get lobby")
+		return errors.Join(clientErr, err)
+	}
+
+	err = sub.updateClientsAboutLobby(ctx, lobby)
+	return err
+}
+
+// splitList splits the comma separated values sent by the room settings form, i.e. "free_form, multiple_choice".
+func splitList(values string) []string {
+	items := strings.Split(values, ",")
+	for i, item := range items {
+		items[i] = strings.TrimSpace(item)
+	}
+	return items
+}
@@ -179,6 +179,138 @@ func (_c *MockLobbyServicer_GetLobby_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// GetLobbyRules provides a mock function for the type MockLobbyServicer
+func (_mock *MockLobbyServicer) GetLobbyRules(ctx context.Context, roomCode string) (service.LobbyRules, error) {
+	ret := _mock.Called(ctx, roomCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLobbyRules")
+	}
+
+	var r0 service.LobbyRules
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (service.LobbyRules, error)); ok {
+		return returnFunc(ctx, roomCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) service.LobbyRules); ok {
+		r0 = returnFunc(ctx, roomCode)
+	} else {
+		r0 = ret.Get(0).(service.LobbyRules)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, roomCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyServicer_GetLobbyRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLobbyRules'
+type MockLobbyServicer_GetLobbyRules_Call struct {
+	*mock.Call
+}
+
+// GetLobbyRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomCode string
+func (_e *MockLobbyServicer_Expecter) GetLobbyRules(ctx interface{}, roomCode interface{}) *MockLobbyServicer_GetLobbyRules_Call {
+	return &MockLobbyServicer_GetLobbyRules_Call{Call: _e.mock.On("GetLobbyRules", ctx, roomCode)}
+}
+
+func (_c *MockLobbyServicer_GetLobbyRules_Call) Run(run func(ctx context.Context, roomCode string)) *MockLobbyServicer_GetLobbyRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyServicer_GetLobbyRules_Call) Return(lobbyRules service.LobbyRules, err error) *MockLobbyServicer_GetLobbyRules_Call {
+	_c.Call.Return(lobbyRules, err)
+	return _c
+}
+
+func (_c *MockLobbyServicer_GetLobbyRules_Call) RunAndReturn(run func(ctx context.Context, roomCode string) (service.LobbyRules, error)) *MockLobbyServicer_GetLobbyRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoomSettings provides a mock function for the type MockLobbyServicer
+func (_mock *MockLobbyServicer) GetRoomSettings(ctx context.Context, roomCode string) (service.RoomSettings, error) {
+	ret := _mock.Called(ctx, roomCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoomSettings")
+	}
+
+	var r0 service.RoomSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (service.RoomSettings, error)); ok {
+		return returnFunc(ctx, roomCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) service.RoomSettings); ok {
+		r0 = returnFunc(ctx, roomCode)
+	} else {
+		r0 = ret.Get(0).(service.RoomSettings)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, roomCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyServicer_GetRoomSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoomSettings'
+type MockLobbyServicer_GetRoomSettings_Call struct {
+	*mock.Call
+}
+
+// GetRoomSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomCode string
+func (_e *MockLobbyServicer_Expecter) GetRoomSettings(ctx interface{}, roomCode interface{}) *MockLobbyServicer_GetRoomSettings_Call {
+	return &MockLobbyServicer_GetRoomSettings_Call{Call: _e.mock.On("GetRoomSettings", ctx, roomCode)}
+}
+
+func (_c *MockLobbyServicer_GetRoomSettings_Call) Run(run func(ctx context.Context, roomCode string)) *MockLobbyServicer_GetRoomSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyServicer_GetRoomSettings_Call) Return(roomSettings service.RoomSettings, err error) *MockLobbyServicer_GetRoomSettings_Call {
+	_c.Call.Return(roomSettings, err)
+	return _c
+}
+
+func (_c *MockLobbyServicer_GetRoomSettings_Call) RunAndReturn(run func(ctx context.Context, roomCode string) (service.RoomSettings, error)) *MockLobbyServicer_GetRoomSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRoomState provides a mock function for the type MockLobbyServicer
 func (_mock *MockLobbyServicer) GetRoomState(ctx context.Context, playerID uuid.UUID) (db.RoomState, error) {
 	ret := _mock.Called(ctx, playerID)
@@ -466,6 +598,140 @@ func (_c *MockLobbyServicer_KickPlayer_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// MigrateHost provides a mock function for the type MockLobbyServicer
+func (_mock *MockLobbyServicer) MigrateHost(ctx context.Context, playerID uuid.UUID) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, playerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateHost")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, playerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) uuid.UUID); ok {
+		r0 = returnFunc(ctx, playerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, playerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyServicer_MigrateHost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateHost'
+type MockLobbyServicer_MigrateHost_Call struct {
+	*mock.Call
+}
+
+// MigrateHost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - playerID uuid.UUID
+func (_e *MockLobbyServicer_Expecter) MigrateHost(ctx interface{}, playerID interface{}) *MockLobbyServicer_MigrateHost_Call {
+	return &MockLobbyServicer_MigrateHost_Call{Call: _e.mock.On("MigrateHost", ctx, playerID)}
+}
+
+func (_c *MockLobbyServicer_MigrateHost_Call) Run(run func(ctx context.Context, playerID uuid.UUID)) *MockLobbyServicer_MigrateHost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyServicer_MigrateHost_Call) Return(uUID uuid.UUID, err error) *MockLobbyServicer_MigrateHost_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *MockLobbyServicer_MigrateHost_Call) RunAndReturn(run func(ctx context.Context, playerID uuid.UUID) (uuid.UUID, error)) *MockLobbyServicer_MigrateHost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rematch provides a mock function for the type MockLobbyServicer
+func (_mock *MockLobbyServicer) Rematch(ctx context.Context, playerID uuid.UUID) (service.Lobby, error) {
+	ret := _mock.Called(ctx, playerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rematch")
+	}
+
+	var r0 service.Lobby
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (service.Lobby, error)); ok {
+		return returnFunc(ctx, playerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) service.Lobby); ok {
+		r0 = returnFunc(ctx, playerID)
+	} else {
+		r0 = ret.Get(0).(service.Lobby)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, playerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyServicer_Rematch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rematch'
+type MockLobbyServicer_Rematch_Call struct {
+	*mock.Call
+}
+
+// Rematch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - playerID uuid.UUID
+func (_e *MockLobbyServicer_Expecter) Rematch(ctx interface{}, playerID interface{}) *MockLobbyServicer_Rematch_Call {
+	return &MockLobbyServicer_Rematch_Call{Call: _e.mock.On("Rematch", ctx, playerID)}
+}
+
+func (_c *MockLobbyServicer_Rematch_Call) Run(run func(ctx context.Context, playerID uuid.UUID)) *MockLobbyServicer_Rematch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyServicer_Rematch_Call) Return(lobby service.Lobby, err error) *MockLobbyServicer_Rematch_Call {
+	_c.Call.Return(lobby, err)
+	return _c
+}
+
+func (_c *MockLobbyServicer_Rematch_Call) RunAndReturn(run func(ctx context.Context, playerID uuid.UUID) (service.Lobby, error)) *MockLobbyServicer_Rematch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function for the type MockLobbyServicer
 func (_mock *MockLobbyServicer) Start(ctx context.Context, roomCode string, playerID uuid.UUID, deadline time.Time) (service.QuestionState, error) {
 	ret := _mock.Called(ctx, roomCode, playerID, deadline)
@@ -543,3 +809,161 @@ func (_c *MockLobbyServicer_Start_Call) RunAndReturn(run func(ctx context.Contex
 	_c.Call.Return(run)
 	return _c
 }
+
+// TransferHost provides a mock function for the type MockLobbyServicer
+func (_mock *MockLobbyServicer) TransferHost(ctx context.Context, roomCode string, playerID uuid.UUID, newHostNickname string) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, roomCode, playerID, newHostNickname)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferHost")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, string) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, roomCode, playerID, newHostNickname)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, string) uuid.UUID); ok {
+		r0 = returnFunc(ctx, roomCode, playerID, newHostNickname)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, roomCode, playerID, newHostNickname)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyServicer_TransferHost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferHost'
+type MockLobbyServicer_TransferHost_Call struct {
+	*mock.Call
+}
+
+// TransferHost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomCode string
+//   - playerID uuid.UUID
+//   - newHostNickname string
+func (_e *MockLobbyServicer_Expecter) TransferHost(ctx interface{}, roomCode interface{}, playerID interface{}, newHostNickname interface{}) *MockLobbyServicer_TransferHost_Call {
+	return &MockLobbyServicer_TransferHost_Call{Call: _e.mock.On("TransferHost", ctx, roomCode, playerID, newHostNickname)}
+}
+
+func (_c *MockLobbyServicer_TransferHost_Call) Run(run func(ctx context.Context, roomCode string, playerID uuid.UUID, newHostNickname string)) *MockLobbyServicer_TransferHost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyServicer_TransferHost_Call) Return(uUID uuid.UUID, err error) *MockLobbyServicer_TransferHost_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *MockLobbyServicer_TransferHost_Call) RunAndReturn(run func(ctx context.Context, roomCode string, playerID uuid.UUID, newHostNickname string) (uuid.UUID, error)) *MockLobbyServicer_TransferHost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRoomSettings provides a mock function for the type MockLobbyServicer
+func (_mock *MockLobbyServicer) UpdateRoomSettings(ctx context.Context, roomCode string, playerID uuid.UUID, settings service.RoomSettings) (service.RoomSettings, error) {
+	ret := _mock.Called(ctx, roomCode, playerID, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRoomSettings")
+	}
+
+	var r0 service.RoomSettings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, service.RoomSettings) (service.RoomSettings, error)); ok {
+		return returnFunc(ctx, roomCode, playerID, settings)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, service.RoomSettings) service.RoomSettings); ok {
+		r0 = returnFunc(ctx, roomCode, playerID, settings)
+	} else {
+		r0 = ret.Get(0).(service.RoomSettings)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, service.RoomSettings) error); ok {
+		r1 = returnFunc(ctx, roomCode, playerID, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLobbyServicer_UpdateRoomSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRoomSettings'
+type MockLobbyServicer_UpdateRoomSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateRoomSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roomCode string
+//   - playerID uuid.UUID
+//   - settings service.RoomSettings
+func (_e *MockLobbyServicer_Expecter) UpdateRoomSettings(ctx interface{}, roomCode interface{}, playerID interface{}, settings interface{}) *MockLobbyServicer_UpdateRoomSettings_Call {
+	return &MockLobbyServicer_UpdateRoomSettings_Call{Call: _e.mock.On("UpdateRoomSettings", ctx, roomCode, playerID, settings)}
+}
+
+func (_c *MockLobbyServicer_UpdateRoomSettings_Call) Run(run func(ctx context.Context, roomCode string, playerID uuid.UUID, settings service.RoomSettings)) *MockLobbyServicer_UpdateRoomSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 service.RoomSettings
+		if args[3] != nil {
+			arg3 = args[3].(service.RoomSettings)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLobbyServicer_UpdateRoomSettings_Call) Return(roomSettings service.RoomSettings, err error) *MockLobbyServicer_UpdateRoomSettings_Call {
+	_c.Call.Return(roomSettings, err)
+	return _c
+}
+
+func (_c *MockLobbyServicer_UpdateRoomSettings_Call) RunAndReturn(run func(ctx context.Context, roomCode string, playerID uuid.UUID, settings service.RoomSettings) (service.RoomSettings, error)) *MockLobbyServicer_UpdateRoomSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
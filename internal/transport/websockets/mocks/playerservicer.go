@@ -6,6 +6,7 @@ package websockets
 
 import (
 	"context"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 	mock "github.com/stretchr/testify/mock"
@@ -172,6 +173,135 @@ func (_c *MockPlayerServicer_GetPlayerByID_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// MarkActive provides a mock function for the type MockPlayerServicer
+func (_mock *MockPlayerServicer) MarkActive(ctx context.Context, playerID uuid.UUID) error {
+	ret := _mock.Called(ctx, playerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkActive")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, playerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPlayerServicer_MarkActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkActive'
+type MockPlayerServicer_MarkActive_Call struct {
+	*mock.Call
+}
+
+// MarkActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - playerID uuid.UUID
+func (_e *MockPlayerServicer_Expecter) MarkActive(ctx interface{}, playerID interface{}) *MockPlayerServicer_MarkActive_Call {
+	return &MockPlayerServicer_MarkActive_Call{Call: _e.mock.On("MarkActive", ctx, playerID)}
+}
+
+func (_c *MockPlayerServicer_MarkActive_Call) Run(run func(ctx context.Context, playerID uuid.UUID)) *MockPlayerServicer_MarkActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlayerServicer_MarkActive_Call) Return(err error) *MockPlayerServicer_MarkActive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPlayerServicer_MarkActive_Call) RunAndReturn(run func(ctx context.Context, playerID uuid.UUID) error) *MockPlayerServicer_MarkActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkInactive provides a mock function for the type MockPlayerServicer
+func (_mock *MockPlayerServicer) MarkInactive(ctx context.Context, playerID uuid.UUID, disconnectedBefore time.Time) (bool, error) {
+	ret := _mock.Called(ctx, playerID, disconnectedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkInactive")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, playerID, disconnectedBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) bool); ok {
+		r0 = returnFunc(ctx, playerID, disconnectedBefore)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, playerID, disconnectedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPlayerServicer_MarkInactive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkInactive'
+type MockPlayerServicer_MarkInactive_Call struct {
+	*mock.Call
+}
+
+// MarkInactive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - playerID uuid.UUID
+//   - disconnectedBefore time.Time
+func (_e *MockPlayerServicer_Expecter) MarkInactive(ctx interface{}, playerID interface{}, disconnectedBefore interface{}) *MockPlayerServicer_MarkInactive_Call {
+	return &MockPlayerServicer_MarkInactive_Call{Call: _e.mock.On("MarkInactive", ctx, playerID, disconnectedBefore)}
+}
+
+func (_c *MockPlayerServicer_MarkInactive_Call) Run(run func(ctx context.Context, playerID uuid.UUID, disconnectedBefore time.Time)) *MockPlayerServicer_MarkInactive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPlayerServicer_MarkInactive_Call) Return(b bool, err error) *MockPlayerServicer_MarkInactive_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockPlayerServicer_MarkInactive_Call) RunAndReturn(run func(ctx context.Context, playerID uuid.UUID, disconnectedBefore time.Time) (bool, error)) *MockPlayerServicer_MarkInactive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TogglePlayerIsReady provides a mock function for the type MockPlayerServicer
 func (_mock *MockPlayerServicer) TogglePlayerIsReady(ctx context.Context, playerID uuid.UUID) (service.Lobby, error) {
 	ret := _mock.Called(ctx, playerID)
@@ -562,6 +562,72 @@ func (_c *MockRoundServicer_GetQuestionState_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// GetQuestionStateByGameStateID provides a mock function for the type MockRoundServicer
+func (_mock *MockRoundServicer) GetQuestionStateByGameStateID(ctx context.Context, gameStateID uuid.UUID) (service.QuestionState, error) {
+	ret := _mock.Called(ctx, gameStateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuestionStateByGameStateID")
+	}
+
+	var r0 service.QuestionState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (service.QuestionState, error)); ok {
+		return returnFunc(ctx, gameStateID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) service.QuestionState); ok {
+		r0 = returnFunc(ctx, gameStateID)
+	} else {
+		r0 = ret.Get(0).(service.QuestionState)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, gameStateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundServicer_GetQuestionStateByGameStateID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionStateByGameStateID'
+type MockRoundServicer_GetQuestionStateByGameStateID_Call struct {
+	*mock.Call
+}
+
+// GetQuestionStateByGameStateID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameStateID uuid.UUID
+func (_e *MockRoundServicer_Expecter) GetQuestionStateByGameStateID(ctx interface{}, gameStateID interface{}) *MockRoundServicer_GetQuestionStateByGameStateID_Call {
+	return &MockRoundServicer_GetQuestionStateByGameStateID_Call{Call: _e.mock.On("GetQuestionStateByGameStateID", ctx, gameStateID)}
+}
+
+func (_c *MockRoundServicer_GetQuestionStateByGameStateID_Call) Run(run func(ctx context.Context, gameStateID uuid.UUID)) *MockRoundServicer_GetQuestionStateByGameStateID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundServicer_GetQuestionStateByGameStateID_Call) Return(questionState service.QuestionState, err error) *MockRoundServicer_GetQuestionStateByGameStateID_Call {
+	_c.Call.Return(questionState, err)
+	return _c
+}
+
+func (_c *MockRoundServicer_GetQuestionStateByGameStateID_Call) RunAndReturn(run func(ctx context.Context, gameStateID uuid.UUID) (service.QuestionState, error)) *MockRoundServicer_GetQuestionStateByGameStateID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRevealState provides a mock function for the type MockRoundServicer
 func (_mock *MockRoundServicer) GetRevealState(ctx context.Context, playerID uuid.UUID) (service.RevealRoleState, error) {
 	ret := _mock.Called(ctx, playerID)
@@ -700,6 +766,140 @@ func (_c *MockRoundServicer_GetScoreState_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// GetTiedPlayers provides a mock function for the type MockRoundServicer
+func (_mock *MockRoundServicer) GetTiedPlayers(ctx context.Context, gameStateID uuid.UUID) ([]uuid.UUID, error) {
+	ret := _mock.Called(ctx, gameStateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTiedPlayers")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]uuid.UUID, error)); ok {
+		return returnFunc(ctx, gameStateID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
+		r0 = returnFunc(ctx, gameStateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, gameStateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundServicer_GetTiedPlayers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTiedPlayers'
+type MockRoundServicer_GetTiedPlayers_Call struct {
+	*mock.Call
+}
+
+// GetTiedPlayers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameStateID uuid.UUID
+func (_e *MockRoundServicer_Expecter) GetTiedPlayers(ctx interface{}, gameStateID interface{}) *MockRoundServicer_GetTiedPlayers_Call {
+	return &MockRoundServicer_GetTiedPlayers_Call{Call: _e.mock.On("GetTiedPlayers", ctx, gameStateID)}
+}
+
+func (_c *MockRoundServicer_GetTiedPlayers_Call) Run(run func(ctx context.Context, gameStateID uuid.UUID)) *MockRoundServicer_GetTiedPlayers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundServicer_GetTiedPlayers_Call) Return(uUIDs []uuid.UUID, err error) *MockRoundServicer_GetTiedPlayers_Call {
+	_c.Call.Return(uUIDs, err)
+	return _c
+}
+
+func (_c *MockRoundServicer_GetTiedPlayers_Call) RunAndReturn(run func(ctx context.Context, gameStateID uuid.UUID) ([]uuid.UUID, error)) *MockRoundServicer_GetTiedPlayers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTimings provides a mock function for the type MockRoundServicer
+func (_mock *MockRoundServicer) GetTimings(ctx context.Context, gameStateID uuid.UUID) (service.Timings, error) {
+	ret := _mock.Called(ctx, gameStateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimings")
+	}
+
+	var r0 service.Timings
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (service.Timings, error)); ok {
+		return returnFunc(ctx, gameStateID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) service.Timings); ok {
+		r0 = returnFunc(ctx, gameStateID)
+	} else {
+		r0 = ret.Get(0).(service.Timings)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, gameStateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundServicer_GetTimings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimings'
+type MockRoundServicer_GetTimings_Call struct {
+	*mock.Call
+}
+
+// GetTimings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameStateID uuid.UUID
+func (_e *MockRoundServicer_Expecter) GetTimings(ctx interface{}, gameStateID interface{}) *MockRoundServicer_GetTimings_Call {
+	return &MockRoundServicer_GetTimings_Call{Call: _e.mock.On("GetTimings", ctx, gameStateID)}
+}
+
+func (_c *MockRoundServicer_GetTimings_Call) Run(run func(ctx context.Context, gameStateID uuid.UUID)) *MockRoundServicer_GetTimings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundServicer_GetTimings_Call) Return(timings service.Timings, err error) *MockRoundServicer_GetTimings_Call {
+	_c.Call.Return(timings, err)
+	return _c
+}
+
+func (_c *MockRoundServicer_GetTimings_Call) RunAndReturn(run func(ctx context.Context, gameStateID uuid.UUID) (service.Timings, error)) *MockRoundServicer_GetTimings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetVotingState provides a mock function for the type MockRoundServicer
 func (_mock *MockRoundServicer) GetVotingState(ctx context.Context, playerID uuid.UUID) (service.VotingState, error) {
 	ret := _mock.Called(ctx, playerID)
@@ -1405,6 +1605,84 @@ func (_c *MockRoundServicer_UpdateStateToReveal_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// UpdateStateToRevote provides a mock function for the type MockRoundServicer
+func (_mock *MockRoundServicer) UpdateStateToRevote(ctx context.Context, gameStateID uuid.UUID, candidates []uuid.UUID, deadline time.Time) (service.VotingState, error) {
+	ret := _mock.Called(ctx, gameStateID, candidates, deadline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStateToRevote")
+	}
+
+	var r0 service.VotingState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID, time.Time) (service.VotingState, error)); ok {
+		return returnFunc(ctx, gameStateID, candidates, deadline)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID, time.Time) service.VotingState); ok {
+		r0 = returnFunc(ctx, gameStateID, candidates, deadline)
+	} else {
+		r0 = ret.Get(0).(service.VotingState)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, gameStateID, candidates, deadline)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRoundServicer_UpdateStateToRevote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStateToRevote'
+type MockRoundServicer_UpdateStateToRevote_Call struct {
+	*mock.Call
+}
+
+// UpdateStateToRevote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameStateID uuid.UUID
+//   - candidates []uuid.UUID
+//   - deadline time.Time
+func (_e *MockRoundServicer_Expecter) UpdateStateToRevote(ctx interface{}, gameStateID interface{}, candidates interface{}, deadline interface{}) *MockRoundServicer_UpdateStateToRevote_Call {
+	return &MockRoundServicer_UpdateStateToRevote_Call{Call: _e.mock.On("UpdateStateToRevote", ctx, gameStateID, candidates, deadline)}
+}
+
+func (_c *MockRoundServicer_UpdateStateToRevote_Call) Run(run func(ctx context.Context, gameStateID uuid.UUID, candidates []uuid.UUID, deadline time.Time)) *MockRoundServicer_UpdateStateToRevote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 []uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].([]uuid.UUID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRoundServicer_UpdateStateToRevote_Call) Return(votingState service.VotingState, err error) *MockRoundServicer_UpdateStateToRevote_Call {
+	_c.Call.Return(votingState, err)
+	return _c
+}
+
+func (_c *MockRoundServicer_UpdateStateToRevote_Call) RunAndReturn(run func(ctx context.Context, gameStateID uuid.UUID, candidates []uuid.UUID, deadline time.Time) (service.VotingState, error)) *MockRoundServicer_UpdateStateToRevote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateToScore provides a mock function for the type MockRoundServicer
 func (_mock *MockRoundServicer) UpdateStateToScore(ctx context.Context, gameStateID uuid.UUID, deadline time.Time, scoring service.Scoring) (service.ScoreState, error) {
 	ret := _mock.Called(ctx, gameStateID, deadline, scoring)
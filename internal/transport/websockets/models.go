@@ -43,6 +43,43 @@ func (s *StartGame) Validate() error {
 	return nil
 }
 
+type UpdateRoomSettings struct {
+	RoomCode        string `json:"room_code"`
+	MaxRounds       int    `json:"max_rounds,string"`
+	RoundTypes      string `json:"round_types"`
+	QuestionSeconds int    `json:"question_seconds,string"`
+	VotingSeconds   int    `json:"voting_seconds,string"`
+	RevealSeconds   int    `json:"reveal_seconds,string"`
+	RevoteSeconds   int    `json:"revote_seconds,string"`
+	ScoreSeconds    int    `json:"score_seconds,string"`
+	WinnerSeconds   int    `json:"winner_seconds,string"`
+	Scorers         string `json:"scorers"`
+	RevealRule      string `json:"reveal_rule"`
+	TieBreak        string `json:"tie_break"`
+	Fibbers         int    `json:"fibbers,string"`
+	FibberSelection string `json:"fibber_selection"`
+	FibberRotation  string `json:"fibber_rotation"`
+	SeriesGames     int    `json:"series_games,string"`
+	RecentGames     int    `json:"recent_games,string"`
+}
+
+func (u *UpdateRoomSettings) Validate() error {
+	if u.RoomCode == "" {
+		return errors.New("room_code is required")
+	}
+	if u.MaxRounds <= 0 {
+		return errors.New("max_rounds must be greater than 0")
+	}
+	if u.RoundTypes == "" {
+		return errors.New("round_types is required")
+	}
+	if u.QuestionSeconds <= 0 || u.VotingSeconds <= 0 || u.RevealSeconds <= 0 || u.RevoteSeconds <= 0 ||
+		u.ScoreSeconds <= 0 || u.WinnerSeconds <= 0 {
+		return errors.New("timers must be greater than 0")
+	}
+	return nil
+}
+
 type UpdateNickname struct {
 	PlayerNickname string `json:"player_nickname"`
 }
@@ -84,6 +121,29 @@ func (k *KickPlayer) Validate() error {
 	return nil
 }
 
+type TransferHost struct {
+	RoomCode       string `json:"room_code"`
+	PlayerNickname string `json:"player_nickname"`
+}
+
+func (t *TransferHost) Validate() error {
+	if t.RoomCode == "" {
+		return errors.New("room_code is required")
+	}
+
+	if t.PlayerNickname == "" {
+		return errors.New("player_nickname is required")
+	}
+	return nil
+}
+
+type PlayAgain struct {
+}
+
+func (p *PlayAgain) Validate() error {
+	return nil
+}
+
 type SubmitAnswer struct {
 	Answer string
 }
@@ -134,3 +194,41 @@ type ResumeGame struct {
 func (r *ResumeGame) Validate() error {
 	return nil
 }
+
+type JoinAudience struct {
+	PlayerNickname string `json:"player_nickname"`
+	RoomCode       string `json:"room_code"`
+}
+
+func (j *JoinAudience) Validate() error {
+	if j.RoomCode == "" || len(j.RoomCode) > 10 {
+		return errors.New("room_code is required and must be <= 10 characters")
+	}
+	if j.PlayerNickname == "" || len(j.PlayerNickname) > 30 {
+		return errors.New("player_nickname is required and must be <= 30 characters")
+	}
+	return nil
+}
+
+type SubmitAudienceVote struct {
+	VotedPlayerNickname string `json:"voted_player_nickname"`
+}
+
+func (s *SubmitAudienceVote) Validate() error {
+	if s.VotedPlayerNickname == "" {
+		return errors.New("player nickname is required")
+	}
+
+	return nil
+}
+
+type ConnectDisplay struct {
+	RoomCode string `json:"room_code"`
+}
+
+func (c *ConnectDisplay) Validate() error {
+	if c.RoomCode == "" || len(c.RoomCode) > 10 {
+		return errors.New("room_code is required and must be <= 10 characters")
+	}
+	return nil
+}
@@ -212,6 +212,149 @@ func TestKickPlayerValidation(t *testing.T) {
 	})
 }
 
+func TestTransferHostValidation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully validate valid transfer host", func(t *testing.T) {
+		t.Parallel()
+		transfer := websockets.TransferHost{
+			RoomCode:       "ABC12",
+			PlayerNickname: "NewHost",
+		}
+
+		err := transfer.Validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should reject empty room code", func(t *testing.T) {
+		t.Parallel()
+		transfer := websockets.TransferHost{
+			RoomCode:       "",
+			PlayerNickname: "NewHost",
+		}
+
+		err := transfer.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "room_code is required")
+	})
+
+	t.Run("Should reject empty player nickname", func(t *testing.T) {
+		t.Parallel()
+		transfer := websockets.TransferHost{
+			RoomCode:       "ABC12",
+			PlayerNickname: "",
+		}
+
+		err := transfer.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "player_nickname is required")
+	})
+}
+
+func TestUpdateRoomSettingsValidation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully validate valid room settings", func(t *testing.T) {
+		t.Parallel()
+		settings := websockets.UpdateRoomSettings{
+			RoomCode:        "ABC12",
+			MaxRounds:       5,
+			RoundTypes:      "free_form,most_likely",
+			QuestionSeconds: 15,
+			VotingSeconds:   60,
+			RevealSeconds:   15,
+			RevoteSeconds:   20,
+			ScoreSeconds:    15,
+			WinnerSeconds:   15,
+		}
+
+		err := settings.Validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should reject empty room code", func(t *testing.T) {
+		t.Parallel()
+		settings := websockets.UpdateRoomSettings{
+			RoomCode:        "",
+			MaxRounds:       5,
+			RoundTypes:      "free_form,most_likely",
+			QuestionSeconds: 15,
+			VotingSeconds:   60,
+			RevealSeconds:   15,
+			RevoteSeconds:   20,
+			ScoreSeconds:    15,
+			WinnerSeconds:   15,
+		}
+
+		err := settings.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "room_code is required")
+	})
+
+	t.Run("Should reject max rounds of zero", func(t *testing.T) {
+		t.Parallel()
+		settings := websockets.UpdateRoomSettings{
+			RoomCode:        "ABC12",
+			MaxRounds:       0,
+			RoundTypes:      "free_form,most_likely",
+			QuestionSeconds: 15,
+			VotingSeconds:   60,
+			RevealSeconds:   15,
+			RevoteSeconds:   20,
+			ScoreSeconds:    15,
+			WinnerSeconds:   15,
+		}
+
+		err := settings.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "max_rounds must be greater than 0")
+	})
+
+	t.Run("Should reject empty round types", func(t *testing.T) {
+		t.Parallel()
+		settings := websockets.UpdateRoomSettings{
+			RoomCode:  "ABC12",
+			MaxRounds: 5,
+		}
+
+		err := settings.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "round_types is required")
+	})
+
+	t.Run("Should reject missing revote and winner timers", func(t *testing.T) {
+		t.Parallel()
+		settings := websockets.UpdateRoomSettings{
+			RoomCode:        "ABC12",
+			MaxRounds:       5,
+			RoundTypes:      "free_form,most_likely",
+			QuestionSeconds: 15,
+			VotingSeconds:   60,
+			RevealSeconds:   15,
+			ScoreSeconds:    15,
+		}
+
+		err := settings.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "timers must be greater than 0")
+	})
+
+	t.Run("Should reject missing timers", func(t *testing.T) {
+		t.Parallel()
+		settings := websockets.UpdateRoomSettings{
+			RoomCode:        "ABC12",
+			MaxRounds:       5,
+			RoundTypes:      "free_form,most_likely",
+			QuestionSeconds: 15,
+			VotingSeconds:   60,
+		}
+
+		err := settings.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "timers must be greater than 0")
+	})
+}
+
 func TestSubmitAnswerValidation(t *testing.T) {
 	t.Parallel()
 
@@ -253,3 +396,84 @@ func TestSubmitVoteValidation(t *testing.T) {
 		assert.Contains(t, err.Error(), "player nickname is required")
 	})
 }
+
+func TestJoinAudienceValidation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully validate valid join audience", func(t *testing.T) {
+		t.Parallel()
+		join := websockets.JoinAudience{
+			PlayerNickname: "Watcher",
+			RoomCode:       "ABC12",
+		}
+
+		err := join.Validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should reject empty room code", func(t *testing.T) {
+		t.Parallel()
+		join := websockets.JoinAudience{
+			PlayerNickname: "Watcher",
+			RoomCode:       "",
+		}
+
+		err := join.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "room_code is required")
+	})
+
+	t.Run("Should reject empty player nickname", func(t *testing.T) {
+		t.Parallel()
+		join := websockets.JoinAudience{
+			PlayerNickname: "",
+			RoomCode:       "ABC12",
+		}
+
+		err := join.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "player_nickname is required")
+	})
+}
+
+func TestSubmitAudienceVoteValidation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully validate valid submit audience vote", func(t *testing.T) {
+		t.Parallel()
+		vote := websockets.SubmitAudienceVote{VotedPlayerNickname: "PlayerToVoteFor"}
+
+		err := vote.Validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should reject empty voted player nickname", func(t *testing.T) {
+		t.Parallel()
+		vote := websockets.SubmitAudienceVote{VotedPlayerNickname: ""}
+
+		err := vote.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "player nickname is required")
+	})
+}
+
+func TestConnectDisplayValidation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Should successfully validate valid connect display", func(t *testing.T) {
+		t.Parallel()
+		display := websockets.ConnectDisplay{RoomCode: "ABC12"}
+
+		err := display.Validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should reject empty room code", func(t *testing.T) {
+		t.Parallel()
+		display := websockets.ConnectDisplay{RoomCode: ""}
+
+		err := display.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "room_code is required")
+	})
+}
@@ -3,6 +3,7 @@ package websockets
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 
@@ -17,6 +18,8 @@ type PlayerServicer interface {
 	TogglePlayerIsReady(ctx context.Context, playerID uuid.UUID) (service.Lobby, error)
 	UpdateLocale(ctx context.Context, playerID uuid.UUID, locale string) error
 	GetPlayerByID(ctx context.Context, playerID uuid.UUID) (db.Player, error)
+	MarkInactive(ctx context.Context, playerID uuid.UUID, disconnectedBefore time.Time) (bool, error)
+	MarkActive(ctx context.Context, playerID uuid.UUID) error
 }
 
 func (u *UpdateNickname) Handle(ctx context.Context, client *Client, sub *Subscriber) error {
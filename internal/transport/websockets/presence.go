@@ -0,0 +1,154 @@
+package websockets
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"gitlab.com/hmajid2301/banterbus/internal/statemachine"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
+	"gitlab.com/hmajid2301/banterbus/internal/views/sections"
+)
+
+// watchDisconnectedPlayer marks a player who dropped out of a game as inactive if they haven't reconnected by the end
+// of the grace period, so the rest of the room isn't left waiting on them.
+func (s *Subscriber) watchDisconnectedPlayer(ctx context.Context, playerID uuid.UUID) {
+	gracePeriod := s.config.Timings.DisconnectGracePeriod
+	time.AfterFunc(gracePeriod, func() {
+		disconnectedBefore := time.Now().UTC().Add(-gracePeriod)
+		err := s.handlePlayerInactive(ctx, playerID, disconnectedBefore)
+		if err != nil {
+			s.logger.WarnContext(ctx, "failed to handle inactive player",
+				slog.String("player_id", playerID.String()),
+				slog.Any("error", err))
+		}
+	})
+}
+
+func (s *Subscriber) handlePlayerInactive(ctx context.Context, playerID uuid.UUID, disconnectedBefore time.Time) error {
+	inactive, err := s.playerService.MarkInactive(ctx, playerID, disconnectedBefore)
+	if err != nil || !inactive {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "player marked as inactive", slog.String("player_id", playerID.String()))
+	newHostID, err := s.lobbyService.MigrateHost(ctx, playerID)
+	if err != nil {
+		return err
+	}
+
+	if newHostID != uuid.Nil {
+		s.logger.InfoContext(ctx, "host handed over from inactive player",
+			slog.String("player_id", playerID.String()),
+			slog.String("new_host_id", newHostID.String()))
+		err = s.updateClientsAboutHost(ctx, newHostID)
+		if err != nil {
+			return err
+		}
+	}
+
+	gameState, err := s.updateClientsAboutPresence(ctx, playerID)
+	if err != nil {
+		return err
+	}
+
+	// INFO: The inactive player may have been the only one everyone else was waiting on.
+	switch gameState {
+	case db.FibbingITQuestion:
+		question, err := s.roundService.GetQuestionState(ctx, playerID)
+		if err != nil {
+			return err
+		}
+
+		allReady, err := s.roundService.AreAllPlayersAnswerReady(ctx, question.GameStateID)
+		if err != nil || !allReady || question.IsPaused {
+			return err
+		}
+
+		deps, err := s.NewStateDependencies(ctx, question.GameStateID)
+		if err != nil {
+			return err
+		}
+
+		votingState, err := statemachine.NewVotingState(question.GameStateID, deps)
+		if err != nil {
+			return err
+		}
+		go votingState.Start(ctx)
+	case db.FibbingItVoting:
+		voting, err := s.roundService.GetVotingState(ctx, playerID)
+		if err != nil {
+			return err
+		}
+
+		allReady, err := s.roundService.AreAllPlayersVotingReady(ctx, voting.GameStateID)
+		if err != nil || !allReady || voting.IsPaused {
+			return err
+		}
+
+		deps, err := s.NewStateDependencies(ctx, voting.GameStateID)
+		if err != nil {
+			return err
+		}
+
+		revealState, err := statemachine.NewRevealState(voting.GameStateID, deps)
+		if err != nil {
+			return err
+		}
+		s.StartStateMachine(ctx, voting.GameStateID, revealState)
+	}
+
+	return nil
+}
+
+// restorePlayer marks a player who reconnected to a game as active again.
+func (s *Subscriber) restorePlayer(ctx context.Context, playerID uuid.UUID) error {
+	err := s.playerService.MarkActive(ctx, playerID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.updateClientsAboutPresence(ctx, playerID)
+	return err
+}
+
+// updateClientsAboutPresence shows everyone else in the game which players are inactive.
+func (s *Subscriber) updateClientsAboutPresence(ctx context.Context, playerID uuid.UUID) (db.FibbingItGameState, error) {
+	gameState, err := s.roundService.GetGameState(ctx, playerID)
+	if err != nil {
+		return gameState, err
+	}
+
+	switch gameState {
+	case db.FibbingITQuestion:
+		question, err := s.getGameQuestionState(ctx, playerID)
+		if err != nil {
+			return gameState, err
+		}
+
+		// INFO: Players can't see each other while answering, so only spectators need to be updated. This also avoids
+		// re-rendering the question for players who are in the middle of typing an answer.
+		err = s.updateAudience(ctx, question.GameStateID, sections.AudienceQuestion(question))
+		if err != nil {
+			return gameState, err
+		}
+		err = s.updateDisplaysInGame(ctx, question.GameStateID, sections.DisplayQuestion(question))
+		if err != nil {
+			return gameState, err
+		}
+	case db.FibbingItVoting:
+		voting, err := s.roundService.GetVotingState(ctx, playerID)
+		if err != nil {
+			return gameState, err
+		}
+
+		err = s.UpdateClientsAboutVoting(ctx, voting)
+		if err != nil {
+			return gameState, err
+		}
+	}
+
+	return gameState, nil
+}
@@ -64,6 +64,16 @@ func (s Subscriber) Reconnect(ctx context.Context, playerID uuid.UUID) (bytes.Bu
 			PlayerID: &playerID,
 		})
 		if errors.Is(err, service.ErrPlayerNotInGame) {
+			member, audienceErr := s.audienceService.GetAudienceMember(ctx, playerID)
+			if audienceErr == nil {
+				return s.reconnectAudienceMember(ctx, member)
+			}
+
+			display, displayErr := s.displayService.GetDisplay(ctx, playerID)
+			if displayErr == nil {
+				return s.renderDisplay(ctx, display)
+			}
+
 			s.logger.WarnContext(ctx, "reconnection attempt for player not in any game",
 				slog.String("player_id", playerID.String()))
 			return buf, errors.New("You are not currently in any game. Please join a game first.")
@@ -79,6 +89,15 @@ func (s Subscriber) Reconnect(ctx context.Context, playerID uuid.UUID) (bytes.Bu
 		return buf, err
 	}
 
+	if roomState == db.Playing {
+		err = s.restorePlayer(ctx, playerID)
+		if err != nil {
+			s.logger.WarnContext(ctx, "failed to restore reconnected player",
+				slog.String("player_id", playerID.String()),
+				slog.Any("error", err))
+		}
+	}
+
 	if buf.Len() > 0 {
 		bufPreview := buf.String()
 		if len(bufPreview) > 500 {
@@ -118,7 +137,13 @@ func (s Subscriber) reconnectOnRoomState(
 			}
 		}
 
-		component = sections.Lobby(lobby.Code, lobby.Players, mePlayer, s.rules)
+		settings, err := s.lobbyService.GetRoomSettings(ctx, lobby.Code)
+		if err != nil {
+			clientErr := s.updateClientAboutErr(ctx, playerID, "Failed to reconnect to game")
+			return buf, errors.Join(clientErr, err)
+		}
+
+		component = sections.Lobby(lobby.Code, lobby.Players, mePlayer, settings, s.rules)
 	case db.Playing:
 		component, err = s.reconnectToPlayingGame(ctx, playerID)
 		if err != nil {
@@ -191,12 +216,7 @@ func (s Subscriber) reconnectToPlayingGame(ctx context.Context, playerID uuid.UU
 		}
 		component = sections.Reveal(reveal)
 	case db.FibbingItScoring:
-		scoring := service.Scoring{
-			GuessedFibber:      s.config.Scoring.GuessFibber,
-			FibberEvadeCapture: s.config.Scoring.FibberEvadeCapture,
-		}
-
-		score, err := s.roundService.GetScoreState(ctx, scoring, playerID)
+		score, err := s.roundService.GetScoreState(ctx, s.getScoring(), playerID)
 		if err != nil {
 			clientErr := s.updateClientAboutErr(ctx, playerID, "Failed to reconnect to game")
 			return component, errors.Join(clientErr, err)
@@ -221,16 +241,31 @@ func (s Subscriber) reconnectToPlayingGame(ctx context.Context, playerID uuid.UU
 			return component, errors.Join(clientErr, err)
 		}
 
+		var currentPlayer service.PlayerWithScoring
 		maxScore := 0
 		for _, player := range state.Players {
+			if player.ID == playerID {
+				currentPlayer = player
+			}
 			if player.Score > maxScore {
 				maxScore = player.Score
 			}
 		}
-		component = sections.Winner(state, maxScore)
+		component = sections.Winner(state, currentPlayer, maxScore)
 	default:
 		return component, fmt.Errorf("unknown game state: %s", gameState)
 	}
 
 	return component, nil
 }
+
+func (s Subscriber) reconnectAudienceMember(ctx context.Context, member service.AudienceMember) (bytes.Buffer, error) {
+	var buf bytes.Buffer
+	component, err := s.getAudienceView(ctx, member)
+	if err != nil {
+		return buf, fmt.Errorf("Failed to reconnect to game: %v", err)
+	}
+
+	err = component.Render(s.getContextWithLocale(ctx, member.Locale), &buf)
+	return buf, err
+}
@@ -18,6 +18,7 @@ import (
 type RoundServicer interface {
 	GetGameState(ctx context.Context, playerID uuid.UUID) (db.FibbingItGameState, error)
 	GetGameStateByID(ctx context.Context, gameStateID uuid.UUID) (db.FibbingItGameState, error)
+	GetTimings(ctx context.Context, gameStateID uuid.UUID) (service.Timings, error)
 	SubmitAnswer(ctx context.Context, playerID uuid.UUID, answer string, submittedAt time.Time) error
 	SubmitVote(
 		ctx context.Context,
@@ -31,6 +32,13 @@ type RoundServicer interface {
 	GetVotingState(ctx context.Context, playerID uuid.UUID) (service.VotingState, error)
 	ToggleVotingIsReady(ctx context.Context, playerID uuid.UUID, submittedAt time.Time) (bool, error)
 	AreAllPlayersVotingReady(ctx context.Context, gameStateID uuid.UUID) (bool, error)
+	GetTiedPlayers(ctx context.Context, gameStateID uuid.UUID) ([]uuid.UUID, error)
+	UpdateStateToRevote(
+		ctx context.Context,
+		gameStateID uuid.UUID,
+		candidates []uuid.UUID,
+		deadline time.Time,
+	) (service.VotingState, error)
 	UpdateStateToReveal(ctx context.Context, gameStateID uuid.UUID, deadline time.Time) (service.RevealRoleState, error)
 	GetRevealState(ctx context.Context, playerID uuid.UUID) (service.RevealRoleState, error)
 	UpdateStateToScore(
@@ -47,6 +55,7 @@ type RoundServicer interface {
 		nextRound bool,
 	) (service.QuestionState, error)
 	GetQuestionState(ctx context.Context, playerID uuid.UUID) (service.QuestionState, error)
+	GetQuestionStateByGameStateID(ctx context.Context, gameStateID uuid.UUID) (service.QuestionState, error)
 	UpdateStateToWinner(ctx context.Context, gameStateID uuid.UUID, deadline time.Time) (service.WinnerState, error)
 	GetWinnerState(ctx context.Context, playerID uuid.UUID) (service.WinnerState, error)
 	FinishGame(ctx context.Context, gameStateID uuid.UUID) error
@@ -127,7 +136,7 @@ func (t *ToggleAnswerIsReady) Handle(ctx context.Context, client *Client, sub *S
 			return err
 		}
 
-		deps, err := sub.NewStateDependencies()
+		deps, err := sub.NewStateDependencies(ctx, questionState.GameStateID)
 		if err != nil {
 			sub.logger.ErrorContext(ctx, "failed to build state dependencies",
 				slog.Any("error", err),
@@ -154,6 +163,9 @@ func (s *SubmitVote) Handle(ctx context.Context, client *Client, sub *Subscriber
 	votingState, err := sub.roundService.SubmitVote(ctx, client.playerID, s.VotedPlayerNickname, time.Now())
 	if err != nil {
 		errStr := "Failed to submit vote."
+		if errors.Is(err, service.ErrNotRevoteCandidate) {
+			errStr = "You can only vote for the tied players."
+		}
 		clientErr := sub.updateClientAboutErr(ctx, client.playerID, errStr)
 		return errors.Join(clientErr, err)
 	}
@@ -197,7 +209,7 @@ func (t *ToggleVotingIsReady) Handle(ctx context.Context, client *Client, sub *S
 	}
 
 	if allReady {
-		deps, err := sub.NewStateDependencies()
+		deps, err := sub.NewStateDependencies(ctx, votingState.GameStateID)
 		if err != nil {
 			sub.logger.ErrorContext(ctx, "failed to build state dependencies",
 				slog.Any("error", err),
@@ -214,7 +226,9 @@ func (t *ToggleVotingIsReady) Handle(ctx context.Context, client *Client, sub *S
 			clientErr := sub.updateClientAboutErr(ctx, client.playerID, "Failed to create reveal state")
 			return errors.Join(clientErr, err)
 		}
-		go revealState.Start(ctx)
+		// INFO: A revote keeps the game in the voting state, so this also ends a revote early. Starting reveal
+		// through the manager stops the voting or revote timer, so it doesn't start a second reveal later.
+		sub.StartStateMachine(ctx, votingState.GameStateID, revealState)
 	}
 
 	return nil
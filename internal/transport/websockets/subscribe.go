@@ -37,6 +37,9 @@ type Subscriber struct {
 	lobbyService    LobbyServicer
 	playerService   PlayerServicer
 	roundService    RoundServicer
+	audienceService AudienceServicer
+	displayService  DisplayServicer
+	accountService  AccountServicer
 	logger          *slog.Logger
 	handlerRegistry *HandlerRegistry
 	websocket       Websocketer
@@ -87,6 +90,9 @@ func NewSubscriber(
 	lobbyService LobbyServicer,
 	playerService PlayerServicer,
 	roundService RoundServicer,
+	audienceService AudienceServicer,
+	displayService DisplayServicer,
+	accountService AccountServicer,
 	logger *slog.Logger,
 	websocket Websocketer,
 	config config.Config,
@@ -105,6 +111,9 @@ func NewSubscriber(
 		lobbyService:    lobbyService,
 		playerService:   playerService,
 		roundService:    roundService,
+		audienceService: audienceService,
+		displayService:  displayService,
+		accountService:  accountService,
 		logger:          logger,
 		handlerRegistry: registry,
 		websocket:       websocket,
@@ -122,6 +131,12 @@ func (s *Subscriber) registerHandlers() {
 	s.handlerRegistry.Register("join_lobby", WSHandlerAdapter(func() WSHandler { return &JoinLobby{} }))
 	s.handlerRegistry.Register("start_game", WSHandlerAdapter(func() WSHandler { return &StartGame{} }))
 	s.handlerRegistry.Register("kick_player", WSHandlerAdapter(func() WSHandler { return &KickPlayer{} }))
+	s.handlerRegistry.Register("transfer_host", WSHandlerAdapter(func() WSHandler { return &TransferHost{} }))
+	s.handlerRegistry.Register("play_again", WSHandlerAdapter(func() WSHandler { return &PlayAgain{} }))
+	s.handlerRegistry.Register(
+		"update_room_settings",
+		WSHandlerAdapter(func() WSHandler { return &UpdateRoomSettings{} }),
+	)
 	s.handlerRegistry.Register(
 		"update_player_nickname",
 		WSHandlerAdapter(func() WSHandler { return &UpdateNickname{} }),
@@ -147,6 +162,13 @@ func (s *Subscriber) registerHandlers() {
 	)
 	s.handlerRegistry.Register("pause_game", WSHandlerAdapter(func() WSHandler { return &PauseGame{} }))
 	s.handlerRegistry.Register("resume_game", WSHandlerAdapter(func() WSHandler { return &ResumeGame{} }))
+
+	s.handlerRegistry.Register("join_audience", WSHandlerAdapter(func() WSHandler { return &JoinAudience{} }))
+	s.handlerRegistry.Register(
+		"submit_audience_vote",
+		WSHandlerAdapter(func() WSHandler { return &SubmitAudienceVote{} }),
+	)
+	s.handlerRegistry.Register("connect_display", WSHandlerAdapter(func() WSHandler { return &ConnectDisplay{} }))
 }
 
 func (s *Subscriber) Subscribe(r *http.Request, w http.ResponseWriter) (err error) {
@@ -186,6 +208,11 @@ func (s *Subscriber) Subscribe(r *http.Request, w http.ResponseWriter) (err erro
 		locale = cookie.Value
 	}
 
+	account := s.signIn(ctx)
+	if account != nil && account.Locale != "" {
+		locale = account.Locale
+	}
+
 	span.AddEvent("add_locale")
 	ctx, err = ctxi18n.WithLocale(ctx, locale)
 	if err != nil {
@@ -278,6 +305,7 @@ func (s *Subscriber) Subscribe(r *http.Request, w http.ResponseWriter) (err erro
 
 	subscribeCh := s.websocket.Subscribe(ctx, playerID)
 	client := newClient(connection, playerID, subscribeCh, connectionID)
+	client.account = account
 
 	// INFO: Send the reconnection message to the client if they should reconnect.
 	if component.Len() > 0 {
@@ -290,11 +318,15 @@ func (s *Subscriber) Subscribe(r *http.Request, w http.ResponseWriter) (err erro
 		)
 		cancel()
 
-		err = s.lobbyService.HandlePlayerDisconnect(ctx, playerID)
+		// INFO: The connection's context is cancelled by now, but the player still needs to be cleaned up.
+		disconnectCtx := context.WithoutCancel(ctx)
+		err = s.lobbyService.HandlePlayerDisconnect(disconnectCtx, playerID)
 		if err != nil {
 			s.logger.WarnContext(ctx, "failed to handle player disconnect",
 				slog.String("player_id", playerID.String()),
 				slog.Any("error", err))
+		} else {
+			s.watchDisconnectedPlayer(disconnectCtx, playerID)
 		}
 
 		err = s.websocket.Close(playerID)
@@ -641,28 +673,54 @@ func translateValidationError(ctx context.Context, errMsg string) string {
 	}
 }
 
-func (s *Subscriber) NewStateDependencies() (*statemachine.StateDependencies, error) {
+// NewStateDependencies uses the timings the host picked for the game, falling back to the server config for any
+// phase that was not set.
+func (s *Subscriber) NewStateDependencies(
+	ctx context.Context,
+	gameStateID uuid.UUID,
+) (*statemachine.StateDependencies, error) {
 	timings := s.config.Timings
 
+	roomTimings, err := s.roundService.GetTimings(ctx, gameStateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game timings: %w", err)
+	}
+
 	return &statemachine.StateDependencies{
 		RoundService:  s.roundService,
 		ClientUpdater: s,
 		Transitioner:  s,
 		Logger:        s.logger,
 		Timings: statemachine.Timings{
-			ShowQuestionScreenFor: timings.ShowQuestionScreenFor,
-			ShowVotingScreenFor:   timings.ShowVotingScreenFor,
-			ShowRevealScreenFor:   timings.ShowRevealScreenFor,
-			ShowScoreScreenFor:    timings.ShowScoreScreenFor,
-			ShowWinnerScreenFor:   timings.ShowWinnerScreenFor,
-		},
-		Scoring: service.Scoring{
-			GuessedFibber:      s.config.Scoring.GuessFibber,
-			FibberEvadeCapture: s.config.Scoring.FibberEvadeCapture,
+			ShowQuestionScreenFor: durationOr(roomTimings.ShowQuestionScreenFor, timings.ShowQuestionScreenFor),
+			ShowVotingScreenFor:   durationOr(roomTimings.ShowVotingScreenFor, timings.ShowVotingScreenFor),
+			ShowRevealScreenFor:   durationOr(roomTimings.ShowRevealScreenFor, timings.ShowRevealScreenFor),
+			ShowRevoteScreenFor:   durationOr(roomTimings.ShowRevoteScreenFor, timings.ShowRevoteScreenFor),
+			ShowScoreScreenFor:    durationOr(roomTimings.ShowScoreScreenFor, timings.ShowScoreScreenFor),
+			ShowWinnerScreenFor:   durationOr(roomTimings.ShowWinnerScreenFor, timings.ShowWinnerScreenFor),
 		},
+		Scoring: s.getScoring(),
 	}, nil
 }
 
+func (s *Subscriber) getScoring() service.Scoring {
+	return service.Scoring{
+		GuessedFibber:      s.config.Scoring.GuessFibber,
+		FibberEvadeCapture: s.config.Scoring.FibberEvadeCapture,
+		SpeedBonus:         s.config.Scoring.SpeedBonus,
+		StreakBonus:        s.config.Scoring.StreakBonus,
+		CatchUpPercent:     s.config.Scoring.CatchUpPercent,
+		DodgedVote:         s.config.Scoring.DodgedVote,
+	}
+}
+
+func durationOr(duration time.Duration, fallback time.Duration) time.Duration {
+	if duration <= 0 {
+		return fallback
+	}
+	return duration
+}
+
 func (s *Subscriber) Publish(ctx context.Context, playerID uuid.UUID, message []byte) error {
 	return s.websocket.Publish(ctx, playerID, message)
 }
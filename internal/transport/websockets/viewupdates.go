@@ -15,6 +15,7 @@ import (
 
 	"gitlab.com/hmajid2301/banterbus/internal/service"
 	"gitlab.com/hmajid2301/banterbus/internal/statemachine"
+	"gitlab.com/hmajid2301/banterbus/internal/store/db"
 	"gitlab.com/hmajid2301/banterbus/internal/views/sections"
 )
 
@@ -58,12 +59,29 @@ func (s *Subscriber) getContextWithPlayerLocale(ctx context.Context, playerID uu
 	return ctx
 }
 
+func (s *Subscriber) getContextWithLocale(ctx context.Context, locale string) context.Context {
+	if locale != "" {
+		localeCtx, err := ctxi18n.WithLocale(ctx, locale)
+		if err == nil {
+			return localeCtx
+		}
+	}
+
+	ctx, _ = ctxi18n.WithLocale(ctx, s.config.App.DefaultLocale.String())
+	return ctx
+}
+
 func (s *Subscriber) updateClientsAboutLobby(ctx context.Context, lobby service.Lobby) error {
+	settings, err := s.lobbyService.GetRoomSettings(ctx, lobby.Code)
+	if err != nil {
+		return err
+	}
+
 	for _, player := range lobby.Players {
 		playerCtx := s.getContextWithPlayerLocale(ctx, player.ID)
 
 		var buf bytes.Buffer
-		component := sections.Lobby(lobby.Code, lobby.Players, player, s.rules)
+		component := sections.Lobby(lobby.Code, lobby.Players, player, settings, s.rules)
 		err := component.Render(playerCtx, &buf)
 		if err != nil {
 			return err
@@ -75,6 +93,63 @@ func (s *Subscriber) updateClientsAboutLobby(ctx context.Context, lobby service.
 		}
 	}
 
+	return s.updateDisplaysInLobby(ctx, lobby)
+}
+
+// updateClientsAboutHost lets everyone know who the new host is, in a game their view is rendered again so the host
+// controls move over to the new host.
+func (s *Subscriber) updateClientsAboutHost(ctx context.Context, hostPlayerID uuid.UUID) error {
+	lobby, err := s.lobbyService.GetLobby(ctx, hostPlayerID)
+	if err != nil {
+		return err
+	}
+
+	roomState, err := s.lobbyService.GetRoomState(ctx, hostPlayerID)
+	if err != nil {
+		return err
+	}
+
+	if roomState == db.Created {
+		return s.updateClientsAboutLobby(ctx, lobby)
+	}
+
+	var hostNickname string
+	for _, player := range lobby.Players {
+		if player.IsHost {
+			hostNickname = player.Nickname
+		}
+	}
+
+	t := Toast{Message: fmt.Sprintf("%s is now the host", hostNickname), Type: "success"}
+	toastJSON, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	for _, player := range lobby.Players {
+		playerCtx := s.getContextWithPlayerLocale(ctx, player.ID)
+		component, err := s.reconnectToPlayingGame(playerCtx, player.ID)
+		if err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		err = component.Render(playerCtx, &buf)
+		if err != nil {
+			return err
+		}
+
+		err = s.websocket.Publish(ctx, player.ID, buf.Bytes())
+		if err != nil {
+			return err
+		}
+
+		err = s.websocket.Publish(ctx, player.ID, toastJSON)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -125,7 +200,12 @@ func (s *Subscriber) UpdateClientsAboutQuestion(
 		}
 	}
 
-	return nil
+	err := s.updateAudience(ctx, gameState.GameStateID, sections.AudienceQuestion(gameState))
+	if err != nil {
+		return err
+	}
+
+	return s.updateDisplaysInGame(ctx, gameState.GameStateID, sections.DisplayQuestion(gameState))
 }
 
 func (s *Subscriber) UpdateClientsAboutVoting(ctx context.Context, votingState service.VotingState) error {
@@ -145,7 +225,12 @@ func (s *Subscriber) UpdateClientsAboutVoting(ctx context.Context, votingState s
 		}
 	}
 
-	return nil
+	err := s.updateAudience(ctx, votingState.GameStateID, sections.AudienceVoting(votingState))
+	if err != nil {
+		return err
+	}
+
+	return s.updateDisplaysInGame(ctx, votingState.GameStateID, sections.DisplayVoting(votingState))
 }
 
 func (s *Subscriber) UpdateClientsAboutReveal(ctx context.Context, revealState service.RevealRoleState) error {
@@ -165,7 +250,12 @@ func (s *Subscriber) UpdateClientsAboutReveal(ctx context.Context, revealState s
 		}
 	}
 
-	return nil
+	err := s.updateAudience(ctx, revealState.GameStateID, sections.Reveal(revealState))
+	if err != nil {
+		return err
+	}
+
+	return s.updateDisplaysInGame(ctx, revealState.GameStateID, sections.DisplayReveal(revealState))
 }
 
 func (s *Subscriber) UpdateClientsAboutScore(ctx context.Context, scoreState service.ScoreState) error {
@@ -192,7 +282,12 @@ func (s *Subscriber) UpdateClientsAboutScore(ctx context.Context, scoreState ser
 		}
 	}
 
-	return nil
+	err := s.updateAudience(ctx, scoreState.GameStateID, sections.Score(scoreState, service.PlayerWithScoring{}, maxScore))
+	if err != nil {
+		return err
+	}
+
+	return s.updateDisplaysInGame(ctx, scoreState.GameStateID, sections.DisplayScore(scoreState, maxScore))
 }
 
 func (s *Subscriber) UpdateClientsAboutWinner(ctx context.Context, winnerState service.WinnerState) error {
@@ -207,7 +302,7 @@ func (s *Subscriber) UpdateClientsAboutWinner(ctx context.Context, winnerState s
 		playerCtx := s.getContextWithPlayerLocale(ctx, player.ID)
 
 		var buf bytes.Buffer
-		component := sections.Winner(winnerState, maxScore)
+		component := sections.Winner(winnerState, player, maxScore)
 		err := component.Render(playerCtx, &buf)
 		if err != nil {
 			return err
@@ -219,7 +314,12 @@ func (s *Subscriber) UpdateClientsAboutWinner(ctx context.Context, winnerState s
 		}
 	}
 
-	return nil
+	err := s.updateAudience(ctx, winnerState.GameStateID, sections.Winner(winnerState, service.PlayerWithScoring{}, maxScore))
+	if err != nil {
+		return err
+	}
+
+	return s.updateDisplaysInGame(ctx, winnerState.GameStateID, sections.DisplayWinner(winnerState, maxScore))
 }
 
 func (s *Subscriber) updateClientsAboutPause(ctx context.Context, pauseStatus service.PauseStatus, gameStateID uuid.UUID) error {
@@ -274,7 +374,7 @@ func (s *Subscriber) restartStateMachineAfterResume(ctx context.Context, gameSta
 		return nil
 	}
 
-	deps, err := s.NewStateDependencies()
+	deps, err := s.NewStateDependencies(ctx, gameStateID)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to build state dependencies",
 			slog.Any("error", err),
@@ -14,7 +14,7 @@ import (
 	"gitlab.com/hmajid2301/banterbus/internal/views/layouts"
 )
 
-func Join(languages map[string]string, environment string, roomCode string) templ.Component {
+func Join(languages map[string]string, environment string, roomCode string, nickname string) templ.Component {
 	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
 		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
 		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
@@ -81,6 +81,7 @@ func Join(languages map[string]string, environment string, roomCode string) temp
 				components.TextInputProps{
 					LabelName:   i18n.T(ctx, "home.nickname_label"),
 					InputName:   "player_nickname",
+					Value:       nickname,
 					Placeholder: i18n.T(ctx, "home.nickname_placeholder"),
 				},
 				templ.Attributes{"required": true, "autofocus": true},
@@ -123,7 +124,7 @@ func Join(languages map[string]string, environment string, roomCode string) temp
 				var templ_7745c5c3_Var6 string
 				templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs(i18n.T(ctx, "home.join_button_label"))
 				if templ_7745c5c3_Err != nil {
-					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/pages/join.templ`, Line: 39, Col: 50}
+					return templ.Error{Err: templ_7745c5c3_Err, FileName: `internal/views/pages/join.templ`, Line: 40, Col: 50}
 				}
 				_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
 				if templ_7745c5c3_Err != nil {
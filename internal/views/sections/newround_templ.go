@@ -129,6 +129,10 @@ func getRoundTypeName(ctx context.Context, roundType string) string {
 		return i18n.T(ctx, "roundtype.multiple_choice")
 	case "most_likely":
 		return i18n.T(ctx, "roundtype.most_likely")
+	case "numeric":
+		return i18n.T(ctx, "roundtype.numeric")
+	case "ranking":
+		return i18n.T(ctx, "roundtype.ranking")
 	default:
 		return roundType
 	}
@@ -92,10 +92,31 @@ func mainLogic() error {
 	}
 
 	userRandomizer := randomizer.NewUserRandomizer()
-	lobbyService := service.NewLobbyService(database, userRandomizer, conf.App.DefaultLocale.String())
+	lobbyService := service.NewLobbyService(
+		database,
+		userRandomizer,
+		conf.App.DefaultLocale.String(),
+		service.RoomSettings{
+			MaxRounds:  conf.App.MaxRounds,
+			RoundTypes: service.RoundTypes(),
+			Timings: service.Timings{
+				ShowQuestionScreenFor: conf.Timings.ShowQuestionScreenFor,
+				ShowVotingScreenFor:   conf.Timings.ShowVotingScreenFor,
+				ShowRevealScreenFor:   conf.Timings.ShowRevealScreenFor,
+				ShowRevoteScreenFor:   conf.Timings.ShowRevoteScreenFor,
+				ShowScoreScreenFor:    conf.Timings.ShowScoreScreenFor,
+				ShowWinnerScreenFor:   conf.Timings.ShowWinnerScreenFor,
+			},
+		},
+	)
 	playerService := service.NewPlayerService(database, userRandomizer)
 	roundService := service.NewRoundService(database, userRandomizer, conf.App.DefaultLocale.String())
+	audienceService := service.NewAudienceService(database, userRandomizer, conf.App.DefaultLocale.String())
+	displayService := service.NewDisplayService(database, conf.App.DefaultLocale.String())
 	questionService := service.NewQuestionService(database, userRandomizer, conf.App.DefaultLocale.String())
+	recapService := service.NewRecapService(database, conf.App.DefaultLocale.String())
+	accountService := service.NewAccountService(database, userRandomizer)
+	leaderboardService := service.NewLeaderboardService(database)
 
 	fsys, err := fs.Sub(staticFiles, "static")
 	if err != nil {
@@ -112,7 +133,19 @@ func mainLogic() error {
 		return fmt.Errorf("failed to convert rules MD to HTML: %w", err)
 	}
 
-	subscriber := websockets.NewSubscriber(lobbyService, playerService, roundService, logger, &redisClient, conf, rules, shutdownCtx)
+	subscriber := websockets.NewSubscriber(
+		lobbyService,
+		playerService,
+		roundService,
+		audienceService,
+		displayService,
+		accountService,
+		logger,
+		&redisClient,
+		conf,
+		rules,
+		shutdownCtx,
+	)
 
 	recoveryManager := recovery.NewManager(database, subscriber, subscriber, roundService, logger)
 	go func() {
@@ -146,7 +179,17 @@ func mainLogic() error {
 	if k != nil {
 		keyFunc = k.Keyfunc
 	}
-	server := transporthttp.NewServer(subscriber, logger, http.FS(fsys), keyFunc, questionService, serverConfig)
+	server := transporthttp.NewServer(
+		subscriber,
+		logger,
+		http.FS(fsys),
+		keyFunc,
+		questionService,
+		recapService,
+		accountService,
+		leaderboardService,
+		serverConfig,
+	)
 
 	go func() {
 		logger.InfoContext(